@@ -0,0 +1,9 @@
+// Package openapi embeds the OpenAPI document generated by
+// protoc-gen-openapiv2 from the igm.v1 and igm.v2 protos. Regenerate it with
+// scripts/proto-gen.sh.
+package openapi
+
+import _ "embed"
+
+//go:embed igm.swagger.json
+var Spec []byte
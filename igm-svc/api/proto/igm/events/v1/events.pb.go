@@ -0,0 +1,885 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: api/proto/igm/events/v1/events.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DomainEvent is the envelope for every issue lifecycle event. Delivery is
+// at-least-once: consumers must drop events whose event_id they have seen.
+type DomainEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` //e.g. igm.issue.created
+	SchemaVersion int32                  `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` //RFC3339
+	IssueId       string                 `protobuf:"bytes,5,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*DomainEvent_IssueCreated
+	//	*DomainEvent_IssueUpdated
+	//	*DomainEvent_IssueEscalated
+	//	*DomainEvent_IssueResolved
+	//	*DomainEvent_IssueClosed
+	//	*DomainEvent_RespondentActionReceived
+	//	*DomainEvent_IssueStatusRequested
+	//	*DomainEvent_IssueSlaBreached
+	Payload       isDomainEvent_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DomainEvent) Reset() {
+	*x = DomainEvent{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DomainEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainEvent) ProtoMessage() {}
+
+func (x *DomainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainEvent.ProtoReflect.Descriptor instead.
+func (*DomainEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DomainEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *DomainEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetPayload() isDomainEvent_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetIssueCreated() *IssueCreated {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_IssueCreated); ok {
+			return x.IssueCreated
+		}
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetIssueUpdated() *IssueUpdated {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_IssueUpdated); ok {
+			return x.IssueUpdated
+		}
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetIssueEscalated() *IssueEscalated {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_IssueEscalated); ok {
+			return x.IssueEscalated
+		}
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetIssueResolved() *IssueResolved {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_IssueResolved); ok {
+			return x.IssueResolved
+		}
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetIssueClosed() *IssueClosed {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_IssueClosed); ok {
+			return x.IssueClosed
+		}
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetRespondentActionReceived() *RespondentActionReceived {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_RespondentActionReceived); ok {
+			return x.RespondentActionReceived
+		}
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetIssueStatusRequested() *IssueStatusRequested {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_IssueStatusRequested); ok {
+			return x.IssueStatusRequested
+		}
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetIssueSlaBreached() *IssueSLABreached {
+	if x != nil {
+		if x, ok := x.Payload.(*DomainEvent_IssueSlaBreached); ok {
+			return x.IssueSlaBreached
+		}
+	}
+	return nil
+}
+
+type isDomainEvent_Payload interface {
+	isDomainEvent_Payload()
+}
+
+type DomainEvent_IssueCreated struct {
+	IssueCreated *IssueCreated `protobuf:"bytes,20,opt,name=issue_created,json=issueCreated,proto3,oneof"`
+}
+
+type DomainEvent_IssueUpdated struct {
+	IssueUpdated *IssueUpdated `protobuf:"bytes,21,opt,name=issue_updated,json=issueUpdated,proto3,oneof"`
+}
+
+type DomainEvent_IssueEscalated struct {
+	IssueEscalated *IssueEscalated `protobuf:"bytes,22,opt,name=issue_escalated,json=issueEscalated,proto3,oneof"`
+}
+
+type DomainEvent_IssueResolved struct {
+	IssueResolved *IssueResolved `protobuf:"bytes,23,opt,name=issue_resolved,json=issueResolved,proto3,oneof"`
+}
+
+type DomainEvent_IssueClosed struct {
+	IssueClosed *IssueClosed `protobuf:"bytes,24,opt,name=issue_closed,json=issueClosed,proto3,oneof"`
+}
+
+type DomainEvent_RespondentActionReceived struct {
+	RespondentActionReceived *RespondentActionReceived `protobuf:"bytes,25,opt,name=respondent_action_received,json=respondentActionReceived,proto3,oneof"`
+}
+
+type DomainEvent_IssueStatusRequested struct {
+	IssueStatusRequested *IssueStatusRequested `protobuf:"bytes,26,opt,name=issue_status_requested,json=issueStatusRequested,proto3,oneof"`
+}
+
+type DomainEvent_IssueSlaBreached struct {
+	IssueSlaBreached *IssueSLABreached `protobuf:"bytes,27,opt,name=issue_sla_breached,json=issueSlaBreached,proto3,oneof"`
+}
+
+func (*DomainEvent_IssueCreated) isDomainEvent_Payload() {}
+
+func (*DomainEvent_IssueUpdated) isDomainEvent_Payload() {}
+
+func (*DomainEvent_IssueEscalated) isDomainEvent_Payload() {}
+
+func (*DomainEvent_IssueResolved) isDomainEvent_Payload() {}
+
+func (*DomainEvent_IssueClosed) isDomainEvent_Payload() {}
+
+func (*DomainEvent_RespondentActionReceived) isDomainEvent_Payload() {}
+
+func (*DomainEvent_IssueStatusRequested) isDomainEvent_Payload() {}
+
+func (*DomainEvent_IssueSlaBreached) isDomainEvent_Payload() {}
+
+type IssueCreated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	SubCategory   string                 `protobuf:"bytes,2,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
+	IssueType     string                 `protobuf:"bytes,3,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	BppId         string                 `protobuf:"bytes,4,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
+	RespondBy     string                 `protobuf:"bytes,5,opt,name=respond_by,json=respondBy,proto3" json:"respond_by,omitempty"`
+	ResolveBy     string                 `protobuf:"bytes,6,opt,name=resolve_by,json=resolveBy,proto3" json:"resolve_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueCreated) Reset() {
+	*x = IssueCreated{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueCreated) ProtoMessage() {}
+
+func (x *IssueCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueCreated.ProtoReflect.Descriptor instead.
+func (*IssueCreated) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IssueCreated) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *IssueCreated) GetSubCategory() string {
+	if x != nil {
+		return x.SubCategory
+	}
+	return ""
+}
+
+func (x *IssueCreated) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *IssueCreated) GetBppId() string {
+	if x != nil {
+		return x.BppId
+	}
+	return ""
+}
+
+func (x *IssueCreated) GetRespondBy() string {
+	if x != nil {
+		return x.RespondBy
+	}
+	return ""
+}
+
+func (x *IssueCreated) GetResolveBy() string {
+	if x != nil {
+		return x.ResolveBy
+	}
+	return ""
+}
+
+type IssueUpdated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IssueType     string                 `protobuf:"bytes,2,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueUpdated) Reset() {
+	*x = IssueUpdated{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueUpdated) ProtoMessage() {}
+
+func (x *IssueUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueUpdated.ProtoReflect.Descriptor instead.
+func (*IssueUpdated) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IssueUpdated) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IssueUpdated) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+type IssueEscalated struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromIssueType string                 `protobuf:"bytes,1,opt,name=from_issue_type,json=fromIssueType,proto3" json:"from_issue_type,omitempty"`
+	IssueType     string                 `protobuf:"bytes,2,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` //COMPLAINANT, RESOLUTION_REJECTED, SLA_BREACH
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueEscalated) Reset() {
+	*x = IssueEscalated{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueEscalated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueEscalated) ProtoMessage() {}
+
+func (x *IssueEscalated) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueEscalated.ProtoReflect.Descriptor instead.
+func (*IssueEscalated) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *IssueEscalated) GetFromIssueType() string {
+	if x != nil {
+		return x.FromIssueType
+	}
+	return ""
+}
+
+func (x *IssueEscalated) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *IssueEscalated) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IssueEscalated) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type IssueResolved struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShortDesc       string                 `protobuf:"bytes,1,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	ActionTriggered string                 `protobuf:"bytes,2,opt,name=action_triggered,json=actionTriggered,proto3" json:"action_triggered,omitempty"` //REFUND, REPLACEMENT, RETURN, CANCEL, NO-ACTION
+	RefundAmount    string                 `protobuf:"bytes,3,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *IssueResolved) Reset() {
+	*x = IssueResolved{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueResolved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueResolved) ProtoMessage() {}
+
+func (x *IssueResolved) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueResolved.ProtoReflect.Descriptor instead.
+func (*IssueResolved) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *IssueResolved) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *IssueResolved) GetActionTriggered() string {
+	if x != nil {
+		return x.ActionTriggered
+	}
+	return ""
+}
+
+func (x *IssueResolved) GetRefundAmount() string {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return ""
+}
+
+type IssueClosed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        string                 `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` //COMPLAINANT, RESOLUTION_ACCEPTED, AUTO_CLOSE
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueClosed) Reset() {
+	*x = IssueClosed{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueClosed) ProtoMessage() {}
+
+func (x *IssueClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueClosed.ProtoReflect.Descriptor instead.
+func (*IssueClosed) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IssueClosed) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *IssueClosed) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type RespondentActionReceived struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RespondentAction string                 `protobuf:"bytes,1,opt,name=respondent_action,json=respondentAction,proto3" json:"respondent_action,omitempty"`
+	ShortDesc        string                 `protobuf:"bytes,2,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	CascadedLevel    int32                  `protobuf:"varint,3,opt,name=cascaded_level,json=cascadedLevel,proto3" json:"cascaded_level,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RespondentActionReceived) Reset() {
+	*x = RespondentActionReceived{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondentActionReceived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondentActionReceived) ProtoMessage() {}
+
+func (x *RespondentActionReceived) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondentActionReceived.ProtoReflect.Descriptor instead.
+func (*RespondentActionReceived) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RespondentActionReceived) GetRespondentAction() string {
+	if x != nil {
+		return x.RespondentAction
+	}
+	return ""
+}
+
+func (x *RespondentActionReceived) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *RespondentActionReceived) GetCascadedLevel() int32 {
+	if x != nil {
+		return x.CascadedLevel
+	}
+	return 0
+}
+
+type IssueStatusRequested struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BppId         string                 `protobuf:"bytes,1,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueStatusRequested) Reset() {
+	*x = IssueStatusRequested{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueStatusRequested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueStatusRequested) ProtoMessage() {}
+
+func (x *IssueStatusRequested) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueStatusRequested.ProtoReflect.Descriptor instead.
+func (*IssueStatusRequested) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IssueStatusRequested) GetBppId() string {
+	if x != nil {
+		return x.BppId
+	}
+	return ""
+}
+
+type IssueSLABreached struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Breach        string                 `protobuf:"bytes,1,opt,name=breach,proto3" json:"breach,omitempty"`     //RESPONSE, RESOLUTION
+	Deadline      string                 `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"` //RFC3339
+	Escalated     bool                   `protobuf:"varint,3,opt,name=escalated,proto3" json:"escalated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSLABreached) Reset() {
+	*x = IssueSLABreached{}
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSLABreached) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSLABreached) ProtoMessage() {}
+
+func (x *IssueSLABreached) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_events_v1_events_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSLABreached.ProtoReflect.Descriptor instead.
+func (*IssueSLABreached) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_events_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IssueSLABreached) GetBreach() string {
+	if x != nil {
+		return x.Breach
+	}
+	return ""
+}
+
+func (x *IssueSLABreached) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *IssueSLABreached) GetEscalated() bool {
+	if x != nil {
+		return x.Escalated
+	}
+	return false
+}
+
+var File_api_proto_igm_events_v1_events_proto protoreflect.FileDescriptor
+
+const file_api_proto_igm_events_v1_events_proto_rawDesc = "" +
+	"\n" +
+	"$api/proto/igm/events/v1/events.proto\x12\rigm.events.v1\"\x81\a\n" +
+	"\vDomainEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\tR\teventType\x12%\n" +
+	"\x0eschema_version\x18\x03 \x01(\x05R\rschemaVersion\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\x12\x19\n" +
+	"\bissue_id\x18\x05 \x01(\tR\aissueId\x12%\n" +
+	"\x0etransaction_id\x18\x06 \x01(\tR\rtransactionId\x12\x19\n" +
+	"\border_id\x18\a \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\b \x01(\tR\x06userId\x12B\n" +
+	"\rissue_created\x18\x14 \x01(\v2\x1b.igm.events.v1.IssueCreatedH\x00R\fissueCreated\x12B\n" +
+	"\rissue_updated\x18\x15 \x01(\v2\x1b.igm.events.v1.IssueUpdatedH\x00R\fissueUpdated\x12H\n" +
+	"\x0fissue_escalated\x18\x16 \x01(\v2\x1d.igm.events.v1.IssueEscalatedH\x00R\x0eissueEscalated\x12E\n" +
+	"\x0eissue_resolved\x18\x17 \x01(\v2\x1c.igm.events.v1.IssueResolvedH\x00R\rissueResolved\x12?\n" +
+	"\fissue_closed\x18\x18 \x01(\v2\x1a.igm.events.v1.IssueClosedH\x00R\vissueClosed\x12g\n" +
+	"\x1arespondent_action_received\x18\x19 \x01(\v2'.igm.events.v1.RespondentActionReceivedH\x00R\x18respondentActionReceived\x12[\n" +
+	"\x16issue_status_requested\x18\x1a \x01(\v2#.igm.events.v1.IssueStatusRequestedH\x00R\x14issueStatusRequested\x12O\n" +
+	"\x12issue_sla_breached\x18\x1b \x01(\v2\x1f.igm.events.v1.IssueSLABreachedH\x00R\x10issueSlaBreachedB\t\n" +
+	"\apayload\"\xc1\x01\n" +
+	"\fIssueCreated\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12!\n" +
+	"\fsub_category\x18\x02 \x01(\tR\vsubCategory\x12\x1d\n" +
+	"\n" +
+	"issue_type\x18\x03 \x01(\tR\tissueType\x12\x15\n" +
+	"\x06bpp_id\x18\x04 \x01(\tR\x05bppId\x12\x1d\n" +
+	"\n" +
+	"respond_by\x18\x05 \x01(\tR\trespondBy\x12\x1d\n" +
+	"\n" +
+	"resolve_by\x18\x06 \x01(\tR\tresolveBy\"E\n" +
+	"\fIssueUpdated\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"issue_type\x18\x02 \x01(\tR\tissueType\"\x87\x01\n" +
+	"\x0eIssueEscalated\x12&\n" +
+	"\x0ffrom_issue_type\x18\x01 \x01(\tR\rfromIssueType\x12\x1d\n" +
+	"\n" +
+	"issue_type\x18\x02 \x01(\tR\tissueType\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\"~\n" +
+	"\rIssueResolved\x12\x1d\n" +
+	"\n" +
+	"short_desc\x18\x01 \x01(\tR\tshortDesc\x12)\n" +
+	"\x10action_triggered\x18\x02 \x01(\tR\x0factionTriggered\x12#\n" +
+	"\rrefund_amount\x18\x03 \x01(\tR\frefundAmount\"=\n" +
+	"\vIssueClosed\x12\x16\n" +
+	"\x06rating\x18\x01 \x01(\tR\x06rating\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\"\x8d\x01\n" +
+	"\x18RespondentActionReceived\x12+\n" +
+	"\x11respondent_action\x18\x01 \x01(\tR\x10respondentAction\x12\x1d\n" +
+	"\n" +
+	"short_desc\x18\x02 \x01(\tR\tshortDesc\x12%\n" +
+	"\x0ecascaded_level\x18\x03 \x01(\x05R\rcascadedLevel\"-\n" +
+	"\x14IssueStatusRequested\x12\x15\n" +
+	"\x06bpp_id\x18\x01 \x01(\tR\x05bppId\"d\n" +
+	"\x10IssueSLABreached\x12\x16\n" +
+	"\x06breach\x18\x01 \x01(\tR\x06breach\x12\x1a\n" +
+	"\bdeadline\x18\x02 \x01(\tR\bdeadline\x12\x1c\n" +
+	"\tescalated\x18\x03 \x01(\bR\tescalatedB*Z(igm-svc/api/proto/igm/events/v1;eventsv1b\x06proto3"
+
+var (
+	file_api_proto_igm_events_v1_events_proto_rawDescOnce sync.Once
+	file_api_proto_igm_events_v1_events_proto_rawDescData []byte
+)
+
+func file_api_proto_igm_events_v1_events_proto_rawDescGZIP() []byte {
+	file_api_proto_igm_events_v1_events_proto_rawDescOnce.Do(func() {
+		file_api_proto_igm_events_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_igm_events_v1_events_proto_rawDesc), len(file_api_proto_igm_events_v1_events_proto_rawDesc)))
+	})
+	return file_api_proto_igm_events_v1_events_proto_rawDescData
+}
+
+var file_api_proto_igm_events_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_proto_igm_events_v1_events_proto_goTypes = []any{
+	(*DomainEvent)(nil),              // 0: igm.events.v1.DomainEvent
+	(*IssueCreated)(nil),             // 1: igm.events.v1.IssueCreated
+	(*IssueUpdated)(nil),             // 2: igm.events.v1.IssueUpdated
+	(*IssueEscalated)(nil),           // 3: igm.events.v1.IssueEscalated
+	(*IssueResolved)(nil),            // 4: igm.events.v1.IssueResolved
+	(*IssueClosed)(nil),              // 5: igm.events.v1.IssueClosed
+	(*RespondentActionReceived)(nil), // 6: igm.events.v1.RespondentActionReceived
+	(*IssueStatusRequested)(nil),     // 7: igm.events.v1.IssueStatusRequested
+	(*IssueSLABreached)(nil),         // 8: igm.events.v1.IssueSLABreached
+}
+var file_api_proto_igm_events_v1_events_proto_depIdxs = []int32{
+	1, // 0: igm.events.v1.DomainEvent.issue_created:type_name -> igm.events.v1.IssueCreated
+	2, // 1: igm.events.v1.DomainEvent.issue_updated:type_name -> igm.events.v1.IssueUpdated
+	3, // 2: igm.events.v1.DomainEvent.issue_escalated:type_name -> igm.events.v1.IssueEscalated
+	4, // 3: igm.events.v1.DomainEvent.issue_resolved:type_name -> igm.events.v1.IssueResolved
+	5, // 4: igm.events.v1.DomainEvent.issue_closed:type_name -> igm.events.v1.IssueClosed
+	6, // 5: igm.events.v1.DomainEvent.respondent_action_received:type_name -> igm.events.v1.RespondentActionReceived
+	7, // 6: igm.events.v1.DomainEvent.issue_status_requested:type_name -> igm.events.v1.IssueStatusRequested
+	8, // 7: igm.events.v1.DomainEvent.issue_sla_breached:type_name -> igm.events.v1.IssueSLABreached
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_igm_events_v1_events_proto_init() }
+func file_api_proto_igm_events_v1_events_proto_init() {
+	if File_api_proto_igm_events_v1_events_proto != nil {
+		return
+	}
+	file_api_proto_igm_events_v1_events_proto_msgTypes[0].OneofWrappers = []any{
+		(*DomainEvent_IssueCreated)(nil),
+		(*DomainEvent_IssueUpdated)(nil),
+		(*DomainEvent_IssueEscalated)(nil),
+		(*DomainEvent_IssueResolved)(nil),
+		(*DomainEvent_IssueClosed)(nil),
+		(*DomainEvent_RespondentActionReceived)(nil),
+		(*DomainEvent_IssueStatusRequested)(nil),
+		(*DomainEvent_IssueSlaBreached)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_igm_events_v1_events_proto_rawDesc), len(file_api_proto_igm_events_v1_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_proto_igm_events_v1_events_proto_goTypes,
+		DependencyIndexes: file_api_proto_igm_events_v1_events_proto_depIdxs,
+		MessageInfos:      file_api_proto_igm_events_v1_events_proto_msgTypes,
+	}.Build()
+	File_api_proto_igm_events_v1_events_proto = out.File
+	file_api_proto_igm_events_v1_events_proto_goTypes = nil
+	file_api_proto_igm_events_v1_events_proto_depIdxs = nil
+}
@@ -7,6 +7,9 @@
 package igmb
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -34,6 +37,7 @@ type CreateIssueRequest struct {
 	ImageUrls       []string               `protobuf:"bytes,8,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
 	AdditionalDesc  *AdditionalDescription `protobuf:"bytes,9,opt,name=additional_desc,json=additionalDesc,proto3" json:"additional_desc,omitempty"`
 	Items           []*IssueItem           `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
+	AttachmentIds   []string               `protobuf:"bytes,11,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"` //uploaded images, sent as signed image_urls
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -138,10 +142,18 @@ func (x *CreateIssueRequest) GetItems() []*IssueItem {
 	return nil
 }
 
+func (x *CreateIssueRequest) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
 type AdditionalDescription struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	AttachmentId  string                 `protobuf:"bytes,3,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"` //instead of url and content_type
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -190,6 +202,13 @@ func (x *AdditionalDescription) GetContentType() string {
 	return ""
 }
 
+func (x *AdditionalDescription) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
 type IssueItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -656,29 +675,31 @@ func (x *CloseIssueResponse) GetOndcMessage() string {
 	return ""
 }
 
-// ++++++++ get issue ++++++++++
-type GetIssueRequest struct {
+// ++++++++ resolution acceptance ++++++++++
+type AcceptResolutionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Rating        string                 `protobuf:"bytes,3,opt,name=rating,proto3" json:"rating,omitempty"` //THUMBS-UP or THUMBS-DOWN
+	ShortDesc     string                 `protobuf:"bytes,4,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueRequest) Reset() {
-	*x = GetIssueRequest{}
+func (x *AcceptResolutionRequest) Reset() {
+	*x = AcceptResolutionRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueRequest) String() string {
+func (x *AcceptResolutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueRequest) ProtoMessage() {}
+func (*AcceptResolutionRequest) ProtoMessage() {}
 
-func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
+func (x *AcceptResolutionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -690,46 +711,64 @@ func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueRequest.ProtoReflect.Descriptor instead.
-func (*GetIssueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptResolutionRequest.ProtoReflect.Descriptor instead.
+func (*AcceptResolutionRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetIssueRequest) GetUserId() string {
+func (x *AcceptResolutionRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetIssueRequest) GetIssueId() string {
+func (x *AcceptResolutionRequest) GetIssueId() string {
 	if x != nil {
 		return x.IssueId
 	}
 	return ""
 }
 
-type GetIssueResponse struct {
+func (x *AcceptResolutionRequest) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *AcceptResolutionRequest) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+type AcceptResolutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ClosedAt      string                 `protobuf:"bytes,3,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,4,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,5,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIssueResponse) Reset() {
-	*x = GetIssueResponse{}
+func (x *AcceptResolutionResponse) Reset() {
+	*x = AcceptResolutionResponse{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIssueResponse) String() string {
+func (x *AcceptResolutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIssueResponse) ProtoMessage() {}
+func (*AcceptResolutionResponse) ProtoMessage() {}
 
-func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
+func (x *AcceptResolutionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -741,42 +780,70 @@ func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIssueResponse.ProtoReflect.Descriptor instead.
-func (*GetIssueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptResolutionResponse.ProtoReflect.Descriptor instead.
+func (*AcceptResolutionResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetIssueResponse) GetIssue() *Issue {
+func (x *AcceptResolutionResponse) GetIssueId() string {
 	if x != nil {
-		return x.Issue
+		return x.IssueId
 	}
-	return nil
+	return ""
 }
 
-// ++++++ list issues ++++++
-type ListIssueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AcceptResolutionResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *ListIssueRequest) Reset() {
-	*x = ListIssueRequest{}
+func (x *AcceptResolutionResponse) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *AcceptResolutionResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *AcceptResolutionResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+type RejectResolutionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId             string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Reason              string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	EscalateToGrievance bool                   `protobuf:"varint,4,opt,name=escalate_to_grievance,json=escalateToGrievance,proto3" json:"escalate_to_grievance,omitempty"` //upgrade ISSUE to GRIEVANCE
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RejectResolutionRequest) Reset() {
+	*x = RejectResolutionRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIssueRequest) String() string {
+func (x *RejectResolutionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIssueRequest) ProtoMessage() {}
+func (*RejectResolutionRequest) ProtoMessage() {}
 
-func (x *ListIssueRequest) ProtoReflect() protoreflect.Message {
+func (x *RejectResolutionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -788,54 +855,65 @@ func (x *ListIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIssueRequest.ProtoReflect.Descriptor instead.
-func (*ListIssueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectResolutionRequest.ProtoReflect.Descriptor instead.
+func (*RejectResolutionRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ListIssueRequest) GetUserId() string {
+func (x *RejectResolutionRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ListIssueRequest) GetPage() int32 {
+func (x *RejectResolutionRequest) GetIssueId() string {
 	if x != nil {
-		return x.Page
+		return x.IssueId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListIssueRequest) GetPageSize() int32 {
+func (x *RejectResolutionRequest) GetReason() string {
 	if x != nil {
-		return x.PageSize
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type ListIssueByOrderRequest struct {
+func (x *RejectResolutionRequest) GetEscalateToGrievance() bool {
+	if x != nil {
+		return x.EscalateToGrievance
+	}
+	return false
+}
+
+type RejectResolutionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	IssueType     string                 `protobuf:"bytes,3,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,5,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,6,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIssueByOrderRequest) Reset() {
-	*x = ListIssueByOrderRequest{}
+func (x *RejectResolutionResponse) Reset() {
+	*x = RejectResolutionResponse{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIssueByOrderRequest) String() string {
+func (x *RejectResolutionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIssueByOrderRequest) ProtoMessage() {}
+func (*RejectResolutionResponse) ProtoMessage() {}
 
-func (x *ListIssueByOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *RejectResolutionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -847,49 +925,80 @@ func (x *ListIssueByOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIssueByOrderRequest.ProtoReflect.Descriptor instead.
-func (*ListIssueByOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectResolutionResponse.ProtoReflect.Descriptor instead.
+func (*RejectResolutionResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListIssueByOrderRequest) GetUserId() string {
+func (x *RejectResolutionResponse) GetIssueId() string {
 	if x != nil {
-		return x.UserId
+		return x.IssueId
 	}
 	return ""
 }
 
-func (x *ListIssueByOrderRequest) GetOrderId() string {
+func (x *RejectResolutionResponse) GetStatus() string {
 	if x != nil {
-		return x.OrderId
+		return x.Status
 	}
 	return ""
 }
 
-type ListIssueResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RejectResolutionResponse) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
 }
 
-func (x *ListIssueResponse) Reset() {
-	*x = ListIssueResponse{}
+func (x *RejectResolutionResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *RejectResolutionResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *RejectResolutionResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+// ++++++++ dispute / ODR ++++++++++
+type OdrProvider struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortDesc            string                 `protobuf:"bytes,3,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	Uri                  string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	PricingModel         string                 `protobuf:"bytes,5,opt,name=pricing_model,json=pricingModel,proto3" json:"pricing_model,omitempty"`
+	ProposedByRespondent bool                   `protobuf:"varint,6,opt,name=proposed_by_respondent,json=proposedByRespondent,proto3" json:"proposed_by_respondent,omitempty"` //listed in resolution_provider.selected_odrs
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *OdrProvider) Reset() {
+	*x = OdrProvider{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIssueResponse) String() string {
+func (x *OdrProvider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIssueResponse) ProtoMessage() {}
+func (*OdrProvider) ProtoMessage() {}
 
-func (x *ListIssueResponse) ProtoReflect() protoreflect.Message {
+func (x *OdrProvider) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -901,65 +1010,75 @@ func (x *ListIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIssueResponse.ProtoReflect.Descriptor instead.
-func (*ListIssueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OdrProvider.ProtoReflect.Descriptor instead.
+func (*OdrProvider) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListIssueResponse) GetIssues() []*Issue {
+func (x *OdrProvider) GetId() string {
 	if x != nil {
-		return x.Issues
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ListIssueResponse) GetTotalCount() int32 {
+func (x *OdrProvider) GetName() string {
 	if x != nil {
-		return x.TotalCount
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ListIssueResponse) GetPage() int32 {
+func (x *OdrProvider) GetShortDesc() string {
 	if x != nil {
-		return x.Page
+		return x.ShortDesc
 	}
-	return 0
+	return ""
 }
 
-func (x *ListIssueResponse) GetPageSize() int32 {
+func (x *OdrProvider) GetUri() string {
 	if x != nil {
-		return x.PageSize
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-type Context struct {
+func (x *OdrProvider) GetPricingModel() string {
+	if x != nil {
+		return x.PricingModel
+	}
+	return ""
+}
+
+func (x *OdrProvider) GetProposedByRespondent() bool {
+	if x != nil {
+		return x.ProposedByRespondent
+	}
+	return false
+}
+
+type ListOdrProvidersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	BapId         string                 `protobuf:"bytes,3,opt,name=bap_id,json=bapId,proto3" json:"bap_id,omitempty"`
-	BppId         string                 `protobuf:"bytes,4,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
-	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	MessageId     string                 `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Context) Reset() {
-	*x = Context{}
+func (x *ListOdrProvidersRequest) Reset() {
+	*x = ListOdrProvidersRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Context) String() string {
+func (x *ListOdrProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Context) ProtoMessage() {}
+func (*ListOdrProvidersRequest) ProtoMessage() {}
 
-func (x *Context) ProtoReflect() protoreflect.Message {
+func (x *ListOdrProvidersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -971,74 +1090,46 @@ func (x *Context) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Context.ProtoReflect.Descriptor instead.
-func (*Context) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOdrProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListOdrProvidersRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Context) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
-}
-
-func (x *Context) GetAction() string {
-	if x != nil {
-		return x.Action
-	}
-	return ""
-}
-
-func (x *Context) GetBapId() string {
-	if x != nil {
-		return x.BapId
-	}
-	return ""
-}
-
-func (x *Context) GetBppId() string {
-	if x != nil {
-		return x.BppId
-	}
-	return ""
-}
-
-func (x *Context) GetTransactionId() string {
+func (x *ListOdrProvidersRequest) GetUserId() string {
 	if x != nil {
-		return x.TransactionId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Context) GetMessageId() string {
+func (x *ListOdrProvidersRequest) GetIssueId() string {
 	if x != nil {
-		return x.MessageId
+		return x.IssueId
 	}
 	return ""
 }
 
-type Org struct {
+type ListOdrProvidersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Providers     []*OdrProvider         `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Org) Reset() {
-	*x = Org{}
+func (x *ListOdrProvidersResponse) Reset() {
+	*x = ListOdrProvidersResponse{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Org) String() string {
+func (x *ListOdrProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Org) ProtoMessage() {}
+func (*ListOdrProvidersResponse) ProtoMessage() {}
 
-func (x *Org) ProtoReflect() protoreflect.Message {
+func (x *ListOdrProvidersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1050,40 +1141,42 @@ func (x *Org) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Org.ProtoReflect.Descriptor instead.
-func (*Org) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListOdrProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListOdrProvidersResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Org) GetName() string {
+func (x *ListOdrProvidersResponse) GetProviders() []*OdrProvider {
 	if x != nil {
-		return x.Name
+		return x.Providers
 	}
-	return ""
+	return nil
 }
 
-type Contact struct {
+type SelectOdrRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	OdrId         string                 `protobuf:"bytes,3,opt,name=odr_id,json=odrId,proto3" json:"odr_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Contact) Reset() {
-	*x = Contact{}
+func (x *SelectOdrRequest) Reset() {
+	*x = SelectOdrRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Contact) String() string {
+func (x *SelectOdrRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Contact) ProtoMessage() {}
+func (*SelectOdrRequest) ProtoMessage() {}
 
-func (x *Contact) ProtoReflect() protoreflect.Message {
+func (x *SelectOdrRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,46 +1188,65 @@ func (x *Contact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
-func (*Contact) Descriptor() ([]byte, []int) {
+// Deprecated: Use SelectOdrRequest.ProtoReflect.Descriptor instead.
+func (*SelectOdrRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Contact) GetPhone() string {
+func (x *SelectOdrRequest) GetUserId() string {
 	if x != nil {
-		return x.Phone
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Contact) GetEmail() string {
+func (x *SelectOdrRequest) GetIssueId() string {
 	if x != nil {
-		return x.Email
+		return x.IssueId
 	}
 	return ""
 }
 
-type Person struct {
+func (x *SelectOdrRequest) GetOdrId() string {
+	if x != nil {
+		return x.OdrId
+	}
+	return ""
+}
+
+func (x *SelectOdrRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SelectOdrResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	IssueType     string                 `protobuf:"bytes,2,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	Odr           *OdrProvider           `protobuf:"bytes,3,opt,name=odr,proto3" json:"odr,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,5,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,6,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Person) Reset() {
-	*x = Person{}
+func (x *SelectOdrResponse) Reset() {
+	*x = SelectOdrResponse{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Person) String() string {
+func (x *SelectOdrResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Person) ProtoMessage() {}
+func (*SelectOdrResponse) ProtoMessage() {}
 
-func (x *Person) ProtoReflect() protoreflect.Message {
+func (x *SelectOdrResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1146,41 +1258,82 @@ func (x *Person) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Person.ProtoReflect.Descriptor instead.
-func (*Person) Descriptor() ([]byte, []int) {
+// Deprecated: Use SelectOdrResponse.ProtoReflect.Descriptor instead.
+func (*SelectOdrResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Person) GetName() string {
+func (x *SelectOdrResponse) GetIssueId() string {
 	if x != nil {
-		return x.Name
+		return x.IssueId
 	}
 	return ""
 }
 
-type UpdatedBy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Org           *Org                   `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
-	Contact       *Contact               `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
-	Person        *Person                `protobuf:"bytes,3,opt,name=person,proto3" json:"person,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SelectOdrResponse) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
 }
 
-func (x *UpdatedBy) Reset() {
-	*x = UpdatedBy{}
+func (x *SelectOdrResponse) GetOdr() *OdrProvider {
+	if x != nil {
+		return x.Odr
+	}
+	return nil
+}
+
+func (x *SelectOdrResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *SelectOdrResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *SelectOdrResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+// ++++++++ information requests ++++++++++
+type InfoMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Action         string                 `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"` //NEED-MORE-INFO or INFO_PROVIDED
+	Actor          string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`   //RESPONDENT or COMPLAINANT
+	ShortDesc      string                 `protobuf:"bytes,3,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	LongDesc       string                 `protobuf:"bytes,4,opt,name=long_desc,json=longDesc,proto3" json:"long_desc,omitempty"`
+	ImageUrls      []string               `protobuf:"bytes,5,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	AdditionalDesc *AdditionalDescription `protobuf:"bytes,6,opt,name=additional_desc,json=additionalDesc,proto3" json:"additional_desc,omitempty"`
+	UpdatedBy      string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InfoMessage) Reset() {
+	*x = InfoMessage{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatedBy) String() string {
+func (x *InfoMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatedBy) ProtoMessage() {}
+func (*InfoMessage) ProtoMessage() {}
 
-func (x *UpdatedBy) ProtoReflect() protoreflect.Message {
+func (x *InfoMessage) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1192,57 +1345,94 @@ func (x *UpdatedBy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatedBy.ProtoReflect.Descriptor instead.
-func (*UpdatedBy) Descriptor() ([]byte, []int) {
+// Deprecated: Use InfoMessage.ProtoReflect.Descriptor instead.
+func (*InfoMessage) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdatedBy) GetOrg() *Org {
+func (x *InfoMessage) GetAction() string {
 	if x != nil {
-		return x.Org
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdatedBy) GetContact() *Contact {
+func (x *InfoMessage) GetActor() string {
 	if x != nil {
-		return x.Contact
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *InfoMessage) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *InfoMessage) GetLongDesc() string {
+	if x != nil {
+		return x.LongDesc
+	}
+	return ""
+}
+
+func (x *InfoMessage) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
 	}
 	return nil
 }
 
-func (x *UpdatedBy) GetPerson() *Person {
+func (x *InfoMessage) GetAdditionalDesc() *AdditionalDescription {
 	if x != nil {
-		return x.Person
+		return x.AdditionalDesc
 	}
 	return nil
 }
 
-type RespondentAction struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	RespondentAction string                 `protobuf:"bytes,1,opt,name=respondent_action,json=respondentAction,proto3" json:"respondent_action,omitempty"`
-	ShortDesc        string                 `protobuf:"bytes,2,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
-	UpdatedAt        string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy        *UpdatedBy             `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	CascadedLevel    int32                  `protobuf:"varint,5,opt,name=cascaded_level,json=cascadedLevel,proto3" json:"cascaded_level,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *InfoMessage) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
 }
 
-func (x *RespondentAction) Reset() {
-	*x = RespondentAction{}
+func (x *InfoMessage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ProvideIssueInfoRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId        string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	ShortDesc      string                 `protobuf:"bytes,3,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	LongDesc       string                 `protobuf:"bytes,4,opt,name=long_desc,json=longDesc,proto3" json:"long_desc,omitempty"`
+	ImageUrls      []string               `protobuf:"bytes,5,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	AdditionalDesc *AdditionalDescription `protobuf:"bytes,6,opt,name=additional_desc,json=additionalDesc,proto3" json:"additional_desc,omitempty"`
+	AttachmentIds  []string               `protobuf:"bytes,7,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProvideIssueInfoRequest) Reset() {
+	*x = ProvideIssueInfoRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RespondentAction) String() string {
+func (x *ProvideIssueInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RespondentAction) ProtoMessage() {}
+func (*ProvideIssueInfoRequest) ProtoMessage() {}
 
-func (x *RespondentAction) ProtoReflect() protoreflect.Message {
+func (x *ProvideIssueInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1254,70 +1444,85 @@ func (x *RespondentAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RespondentAction.ProtoReflect.Descriptor instead.
-func (*RespondentAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProvideIssueInfoRequest.ProtoReflect.Descriptor instead.
+func (*ProvideIssueInfoRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RespondentAction) GetRespondentAction() string {
+func (x *ProvideIssueInfoRequest) GetUserId() string {
 	if x != nil {
-		return x.RespondentAction
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RespondentAction) GetShortDesc() string {
+func (x *ProvideIssueInfoRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ProvideIssueInfoRequest) GetShortDesc() string {
 	if x != nil {
 		return x.ShortDesc
 	}
 	return ""
 }
 
-func (x *RespondentAction) GetUpdatedAt() string {
+func (x *ProvideIssueInfoRequest) GetLongDesc() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.LongDesc
 	}
 	return ""
 }
 
-func (x *RespondentAction) GetUpdatedBy() *UpdatedBy {
+func (x *ProvideIssueInfoRequest) GetImageUrls() []string {
 	if x != nil {
-		return x.UpdatedBy
+		return x.ImageUrls
 	}
 	return nil
 }
 
-func (x *RespondentAction) GetCascadedLevel() int32 {
+func (x *ProvideIssueInfoRequest) GetAdditionalDesc() *AdditionalDescription {
 	if x != nil {
-		return x.CascadedLevel
+		return x.AdditionalDesc
 	}
-	return 0
+	return nil
 }
 
-type ComplainantAction struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ComplainantAction string                 `protobuf:"bytes,1,opt,name=complainant_action,json=complainantAction,proto3" json:"complainant_action,omitempty"`
-	ShortDesc         string                 `protobuf:"bytes,2,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
-	UpdatedAt         string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy         *UpdatedBy             `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ProvideIssueInfoRequest) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
 }
 
-func (x *ComplainantAction) Reset() {
-	*x = ComplainantAction{}
+type ProvideIssueInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Message       *InfoMessage           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,4,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,5,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProvideIssueInfoResponse) Reset() {
+	*x = ProvideIssueInfoResponse{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComplainantAction) String() string {
+func (x *ProvideIssueInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComplainantAction) ProtoMessage() {}
+func (*ProvideIssueInfoResponse) ProtoMessage() {}
 
-func (x *ComplainantAction) ProtoReflect() protoreflect.Message {
+func (x *ProvideIssueInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1329,61 +1534,68 @@ func (x *ComplainantAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComplainantAction.ProtoReflect.Descriptor instead.
-func (*ComplainantAction) Descriptor() ([]byte, []int) {
+// Deprecated: Use ProvideIssueInfoResponse.ProtoReflect.Descriptor instead.
+func (*ProvideIssueInfoResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ComplainantAction) GetComplainantAction() string {
+func (x *ProvideIssueInfoResponse) GetIssueId() string {
 	if x != nil {
-		return x.ComplainantAction
+		return x.IssueId
 	}
 	return ""
 }
 
-func (x *ComplainantAction) GetShortDesc() string {
+func (x *ProvideIssueInfoResponse) GetMessage() *InfoMessage {
 	if x != nil {
-		return x.ShortDesc
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-func (x *ComplainantAction) GetUpdatedAt() string {
+func (x *ProvideIssueInfoResponse) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *ComplainantAction) GetUpdatedBy() *UpdatedBy {
+func (x *ProvideIssueInfoResponse) GetOndcSent() bool {
 	if x != nil {
-		return x.UpdatedBy
+		return x.OndcSent
 	}
-	return nil
+	return false
 }
 
-type IssueActions struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ComplainantActions []*ComplainantAction   `protobuf:"bytes,1,rep,name=complainant_actions,json=complainantActions,proto3" json:"complainant_actions,omitempty"`
-	RespondentActions  []*RespondentAction    `protobuf:"bytes,2,rep,name=respondent_actions,json=respondentActions,proto3" json:"respondent_actions,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ProvideIssueInfoResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
 }
 
-func (x *IssueActions) Reset() {
-	*x = IssueActions{}
+type GetIssueInfoThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueInfoThreadRequest) Reset() {
+	*x = GetIssueInfoThreadRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueActions) String() string {
+func (x *GetIssueInfoThreadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueActions) ProtoMessage() {}
+func (*GetIssueInfoThreadRequest) ProtoMessage() {}
 
-func (x *IssueActions) ProtoReflect() protoreflect.Message {
+func (x *GetIssueInfoThreadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1395,48 +1607,48 @@ func (x *IssueActions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueActions.ProtoReflect.Descriptor instead.
-func (*IssueActions) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIssueInfoThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueInfoThreadRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *IssueActions) GetComplainantActions() []*ComplainantAction {
+func (x *GetIssueInfoThreadRequest) GetUserId() string {
 	if x != nil {
-		return x.ComplainantActions
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *IssueActions) GetRespondentActions() []*RespondentAction {
+func (x *GetIssueInfoThreadRequest) GetIssueId() string {
 	if x != nil {
-		return x.RespondentActions
+		return x.IssueId
 	}
-	return nil
+	return ""
 }
 
-type Organization struct {
+type GetIssueInfoThreadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Org           *Org                   `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
-	Person        *Person                `protobuf:"bytes,2,opt,name=person,proto3" json:"person,omitempty"`
-	Contact       *Contact               `protobuf:"bytes,3,opt,name=contact,proto3" json:"contact,omitempty"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	AwaitingInfo  bool                   `protobuf:"varint,2,opt,name=awaiting_info,json=awaitingInfo,proto3" json:"awaiting_info,omitempty"` //respondent is waiting on the complainant
+	Messages      []*InfoMessage         `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Organization) Reset() {
-	*x = Organization{}
+func (x *GetIssueInfoThreadResponse) Reset() {
+	*x = GetIssueInfoThreadResponse{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Organization) String() string {
+func (x *GetIssueInfoThreadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organization) ProtoMessage() {}
+func (*GetIssueInfoThreadResponse) ProtoMessage() {}
 
-func (x *Organization) ProtoReflect() protoreflect.Message {
+func (x *GetIssueInfoThreadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1448,54 +1660,61 @@ func (x *Organization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
-func (*Organization) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIssueInfoThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueInfoThreadResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Organization) GetOrg() *Org {
+func (x *GetIssueInfoThreadResponse) GetIssueId() string {
 	if x != nil {
-		return x.Org
+		return x.IssueId
 	}
-	return nil
+	return ""
 }
 
-func (x *Organization) GetPerson() *Person {
+func (x *GetIssueInfoThreadResponse) GetAwaitingInfo() bool {
 	if x != nil {
-		return x.Person
+		return x.AwaitingInfo
 	}
-	return nil
+	return false
 }
 
-func (x *Organization) GetContact() *Contact {
+func (x *GetIssueInfoThreadResponse) GetMessages() []*InfoMessage {
 	if x != nil {
-		return x.Contact
+		return x.Messages
 	}
 	return nil
 }
 
-type ResolutionProviderInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Organization  *Organization          `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ++++++++ notifications ++++++++++
+type NotificationPreferences struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SmsEnabled      bool                   `protobuf:"varint,2,opt,name=sms_enabled,json=smsEnabled,proto3" json:"sms_enabled,omitempty"`
+	EmailEnabled    bool                   `protobuf:"varint,3,opt,name=email_enabled,json=emailEnabled,proto3" json:"email_enabled,omitempty"`
+	PushEnabled     bool                   `protobuf:"varint,4,opt,name=push_enabled,json=pushEnabled,proto3" json:"push_enabled,omitempty"`
+	PushToken       string                 `protobuf:"bytes,5,opt,name=push_token,json=pushToken,proto3" json:"push_token,omitempty"`
+	QuietHoursStart string                 `protobuf:"bytes,6,opt,name=quiet_hours_start,json=quietHoursStart,proto3" json:"quiet_hours_start,omitempty"` //HH:MM in timezone, empty for none
+	QuietHoursEnd   string                 `protobuf:"bytes,7,opt,name=quiet_hours_end,json=quietHoursEnd,proto3" json:"quiet_hours_end,omitempty"`       //HH:MM in timezone
+	Timezone        string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`                                        //IANA name, e.g. Asia/Kolkata
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ResolutionProviderInfo) Reset() {
-	*x = ResolutionProviderInfo{}
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolutionProviderInfo) String() string {
+func (x *NotificationPreferences) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolutionProviderInfo) ProtoMessage() {}
+func (*NotificationPreferences) ProtoMessage() {}
 
-func (x *ResolutionProviderInfo) ProtoReflect() protoreflect.Message {
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1507,46 +1726,88 @@ func (x *ResolutionProviderInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolutionProviderInfo.ProtoReflect.Descriptor instead.
-func (*ResolutionProviderInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ResolutionProviderInfo) GetType() string {
+func (x *NotificationPreferences) GetUserId() string {
 	if x != nil {
-		return x.Type
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *ResolutionProviderInfo) GetOrganization() *Organization {
+func (x *NotificationPreferences) GetSmsEnabled() bool {
 	if x != nil {
-		return x.Organization
+		return x.SmsEnabled
 	}
-	return nil
+	return false
 }
 
-type ResolutionProvider struct {
-	state          protoimpl.MessageState  `protogen:"open.v1"`
-	RespondentInfo *ResolutionProviderInfo `protobuf:"bytes,1,opt,name=respondent_info,json=respondentInfo,proto3" json:"respondent_info,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *NotificationPreferences) GetEmailEnabled() bool {
+	if x != nil {
+		return x.EmailEnabled
+	}
+	return false
 }
 
-func (x *ResolutionProvider) Reset() {
-	*x = ResolutionProvider{}
+func (x *NotificationPreferences) GetPushEnabled() bool {
+	if x != nil {
+		return x.PushEnabled
+	}
+	return false
+}
+
+func (x *NotificationPreferences) GetPushToken() string {
+	if x != nil {
+		return x.PushToken
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetQuietHoursStart() string {
+	if x != nil {
+		return x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetQuietHoursEnd() string {
+	if x != nil {
+		return x.QuietHoursEnd
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolutionProvider) String() string {
+func (x *GetNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolutionProvider) ProtoMessage() {}
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *ResolutionProvider) ProtoReflect() protoreflect.Message {
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1558,42 +1819,39 @@ func (x *ResolutionProvider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolutionProvider.ProtoReflect.Descriptor instead.
-func (*ResolutionProvider) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ResolutionProvider) GetRespondentInfo() *ResolutionProviderInfo {
+func (x *GetNotificationPreferencesRequest) GetUserId() string {
 	if x != nil {
-		return x.RespondentInfo
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type Resolution struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ShortDesc       string                 `protobuf:"bytes,1,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
-	LongDesc        string                 `protobuf:"bytes,2,opt,name=long_desc,json=longDesc,proto3" json:"long_desc,omitempty"`
-	ActionTriggered string                 `protobuf:"bytes,3,opt,name=action_triggered,json=actionTriggered,proto3" json:"action_triggered,omitempty"`
-	RefundAmount    string                 `protobuf:"bytes,4,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type UpdateNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Resolution) Reset() {
-	*x = Resolution{}
+func (x *UpdateNotificationPreferencesRequest) Reset() {
+	*x = UpdateNotificationPreferencesRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Resolution) String() string {
+func (x *UpdateNotificationPreferencesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resolution) ProtoMessage() {}
+func (*UpdateNotificationPreferencesRequest) ProtoMessage() {}
 
-func (x *Resolution) ProtoReflect() protoreflect.Message {
+func (x *UpdateNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1605,69 +1863,51 @@ func (x *Resolution) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Resolution.ProtoReflect.Descriptor instead.
-func (*Resolution) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Resolution) GetShortDesc() string {
-	if x != nil {
-		return x.ShortDesc
-	}
-	return ""
-}
-
-func (x *Resolution) GetLongDesc() string {
-	if x != nil {
-		return x.LongDesc
-	}
-	return ""
-}
-
-func (x *Resolution) GetActionTriggered() string {
-	if x != nil {
-		return x.ActionTriggered
-	}
-	return ""
-}
-
-func (x *Resolution) GetRefundAmount() string {
+func (x *UpdateNotificationPreferencesRequest) GetPreferences() *NotificationPreferences {
 	if x != nil {
-		return x.RefundAmount
+		return x.Preferences
 	}
-	return ""
+	return nil
 }
 
-type IncomingIssue struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	IssueType          string                 `protobuf:"bytes,3,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
-	Category           string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
-	SubCategory        string                 `protobuf:"bytes,5,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
-	IssueActions       *IssueActions          `protobuf:"bytes,6,opt,name=issue_actions,json=issueActions,proto3" json:"issue_actions,omitempty"`
-	ResolutionProvider *ResolutionProvider    `protobuf:"bytes,7,opt,name=resolution_provider,json=resolutionProvider,proto3" json:"resolution_provider,omitempty"`
-	Resolution         *Resolution            `protobuf:"bytes,8,opt,name=resolution,proto3" json:"resolution,omitempty"`
-	CreatedAt          string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	EventId       string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`       //CREATED, RESPONDENT_PROCESSING, NEED_MORE_INFO, RESOLVED, AUTO_CLOSED, SLA_BREACHED
+	Channel       string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"` //SMS, EMAIL, PUSH
+	Recipient     string                 `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Subject       string                 `protobuf:"bytes,7,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body          string                 `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` //PENDING, SENT, DEFERRED, SKIPPED, FAILED
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	SendAfter     string                 `protobuf:"bytes,11,opt,name=send_after,json=sendAfter,proto3" json:"send_after,omitempty"`
+	SentAt        string                 `protobuf:"bytes,12,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncomingIssue) Reset() {
-	*x = IncomingIssue{}
+func (x *Notification) Reset() {
+	*x = Notification{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncomingIssue) String() string {
+func (x *Notification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncomingIssue) ProtoMessage() {}
+func (*Notification) ProtoMessage() {}
 
-func (x *IncomingIssue) ProtoReflect() protoreflect.Message {
+func (x *Notification) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1679,103 +1919,125 @@ func (x *IncomingIssue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncomingIssue.ProtoReflect.Descriptor instead.
-func (*IncomingIssue) Descriptor() ([]byte, []int) {
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *IncomingIssue) GetId() string {
+func (x *Notification) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
+	return 0
+}
+
+func (x *Notification) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
 	return ""
 }
 
-func (x *IncomingIssue) GetStatus() string {
+func (x *Notification) GetEventId() string {
 	if x != nil {
-		return x.Status
+		return x.EventId
 	}
 	return ""
 }
 
-func (x *IncomingIssue) GetIssueType() string {
+func (x *Notification) GetKind() string {
 	if x != nil {
-		return x.IssueType
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *IncomingIssue) GetCategory() string {
+func (x *Notification) GetChannel() string {
 	if x != nil {
-		return x.Category
+		return x.Channel
 	}
 	return ""
 }
 
-func (x *IncomingIssue) GetSubCategory() string {
+func (x *Notification) GetRecipient() string {
 	if x != nil {
-		return x.SubCategory
+		return x.Recipient
 	}
 	return ""
 }
 
-func (x *IncomingIssue) GetIssueActions() *IssueActions {
+func (x *Notification) GetSubject() string {
 	if x != nil {
-		return x.IssueActions
+		return x.Subject
 	}
-	return nil
+	return ""
 }
 
-func (x *IncomingIssue) GetResolutionProvider() *ResolutionProvider {
+func (x *Notification) GetBody() string {
 	if x != nil {
-		return x.ResolutionProvider
+		return x.Body
 	}
-	return nil
+	return ""
 }
 
-func (x *IncomingIssue) GetResolution() *Resolution {
+func (x *Notification) GetStatus() string {
 	if x != nil {
-		return x.Resolution
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *IncomingIssue) GetCreatedAt() string {
+func (x *Notification) GetError() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Error
 	}
 	return ""
 }
 
-func (x *IncomingIssue) GetUpdatedAt() string {
+func (x *Notification) GetSendAfter() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SendAfter
 	}
 	return ""
 }
 
-type OnIssuePayload struct {
+func (x *Notification) GetSentAt() string {
+	if x != nil {
+		return x.SentAt
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListNotificationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
-	Issue         *IncomingIssue         `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"` //optional
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnIssuePayload) Reset() {
-	*x = OnIssuePayload{}
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnIssuePayload) String() string {
+func (x *ListNotificationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnIssuePayload) ProtoMessage() {}
+func (*ListNotificationsRequest) ProtoMessage() {}
 
-func (x *OnIssuePayload) ProtoReflect() protoreflect.Message {
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1787,48 +2049,53 @@ func (x *OnIssuePayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnIssuePayload.ProtoReflect.Descriptor instead.
-func (*OnIssuePayload) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *OnIssuePayload) GetContext() *Context {
+func (x *ListNotificationsRequest) GetUserId() string {
 	if x != nil {
-		return x.Context
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *OnIssuePayload) GetIssue() *IncomingIssue {
+func (x *ListNotificationsRequest) GetIssueId() string {
 	if x != nil {
-		return x.Issue
+		return x.IssueId
 	}
-	return nil
+	return ""
 }
 
-type OnIssueRequest struct {
+func (x *ListNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNotificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	Payload       *OnIssuePayload        `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnIssueRequest) Reset() {
-	*x = OnIssueRequest{}
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnIssueRequest) String() string {
+func (x *ListNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnIssueRequest) ProtoMessage() {}
+func (*ListNotificationsResponse) ProtoMessage() {}
 
-func (x *OnIssueRequest) ProtoReflect() protoreflect.Message {
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1840,54 +2107,50 @@ func (x *OnIssueRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnIssueRequest.ProtoReflect.Descriptor instead.
-func (*OnIssueRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *OnIssueRequest) GetTransactionId() string {
-	if x != nil {
-		return x.TransactionId
-	}
-	return ""
-}
-
-func (x *OnIssueRequest) GetMessageId() string {
-	if x != nil {
-		return x.MessageId
-	}
-	return ""
-}
-
-func (x *OnIssueRequest) GetPayload() *OnIssuePayload {
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
 	if x != nil {
-		return x.Payload
+		return x.Notifications
 	}
 	return nil
 }
 
-type OnIssueResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OnIssueResponse) Reset() {
-	*x = OnIssueResponse{}
+// ++++++++ webhooks ++++++++++
+type WebhookSubscription struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url                 string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes          []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` //e.g. igm.issue.resolved or igm.issue.*, empty for all
+	Secret              string                 `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`                           //HMAC-SHA256 key, only returned by create
+	Active              bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	ConsecutiveFailures int32                  `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	DisabledAt          string                 `protobuf:"bytes,8,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	DisabledReason      string                 `protobuf:"bytes,9,opt,name=disabled_reason,json=disabledReason,proto3" json:"disabled_reason,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt           string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnIssueResponse) String() string {
+func (x *WebhookSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnIssueResponse) ProtoMessage() {}
+func (*WebhookSubscription) ProtoMessage() {}
 
-func (x *OnIssueResponse) ProtoReflect() protoreflect.Message {
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1899,49 +2162,112 @@ func (x *OnIssueResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnIssueResponse.ProtoReflect.Descriptor instead.
-func (*OnIssueResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *OnIssueResponse) GetStatus() string {
+func (x *WebhookSubscription) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OnIssueResponse) GetMessage() string {
+func (x *WebhookSubscription) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-type OnIssueStatusRequest struct {
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *WebhookSubscription) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetDisabledAt() string {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetDisabledReason() string {
+	if x != nil {
+		return x.DisabledReason
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateWebhookSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
-	IssueId       string                 `protobuf:"bytes,3,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	Payload       *OnIssuePayload        `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` //optional, generated when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnIssueStatusRequest) Reset() {
-	*x = OnIssueStatusRequest{}
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnIssueStatusRequest) String() string {
+func (x *CreateWebhookSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnIssueStatusRequest) ProtoMessage() {}
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
 
-func (x *OnIssueStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1953,62 +2279,4889 @@ func (x *OnIssueStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnIssueStatusRequest.ProtoReflect.Descriptor instead.
-func (*OnIssueStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *OnIssueStatusRequest) GetTransactionId() string {
+func (x *CreateWebhookSubscriptionRequest) GetName() string {
 	if x != nil {
-		return x.TransactionId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *OnIssueStatusRequest) GetMessageId() string {
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
 	if x != nil {
-		return x.MessageId
+		return x.Url
 	}
 	return ""
 }
 
-func (x *OnIssueStatusRequest) GetIssueId() string {
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
 	if x != nil {
-		return x.IssueId
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *OnIssueStatusRequest) GetPayload() *OnIssuePayload {
+type GetWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookSubscriptionRequest) Reset() {
+	*x = GetWebhookSubscriptionRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[30]
 	if x != nil {
-		return x.Payload
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListWebhookSubscriptionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
 	}
 	return nil
 }
 
-type OnIssueStatusResponse struct {
+type UpdateWebhookSubscriptionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,4,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"` //true re-enables a disabled subscription
+	RotateSecret  bool                   `protobuf:"varint,6,opt,name=rotate_secret,json=rotateSecret,proto3" json:"rotate_secret,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnIssueStatusResponse) Reset() {
-	*x = OnIssueStatusResponse{}
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[30]
+func (x *UpdateWebhookSubscriptionRequest) Reset() {
+	*x = UpdateWebhookSubscriptionRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *UpdateWebhookSubscriptionRequest) GetRotateSecret() bool {
+	if x != nil {
+		return x.RotateSecret
+	}
+	return false
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Deleted       bool                   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteWebhookSubscriptionResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteWebhookSubscriptionResponse) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` //PENDING, SUCCEEDED, DEAD
+	Attempts       int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastStatusCode int32                  `protobuf:"varint,7,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextAttemptAt  string                 `protobuf:"bytes,9,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	DeliveredAt    string                 `protobuf:"bytes,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WebhookDelivery) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() int32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` //optional
+	Limit          int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// ++++++++ attachments ++++++++++
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*UploadAttachmentRequest_Metadata
+	//	*UploadAttachmentRequest_Chunk
+	Payload       isUploadAttachmentRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UploadAttachmentRequest) GetPayload() isUploadAttachmentRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetMetadata() *AttachmentMetadata {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Metadata); ok {
+			return x.Metadata
+		}
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*UploadAttachmentRequest_Chunk); ok {
+			return x.Chunk
+		}
+	}
+	return nil
+}
+
+type isUploadAttachmentRequest_Payload interface {
+	isUploadAttachmentRequest_Payload()
+}
+
+type UploadAttachmentRequest_Metadata struct {
+	Metadata *AttachmentMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"` //first message only
+}
+
+type UploadAttachmentRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*UploadAttachmentRequest_Metadata) isUploadAttachmentRequest_Payload() {}
+
+func (*UploadAttachmentRequest_Chunk) isUploadAttachmentRequest_Payload() {}
+
+type AttachmentMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"` //image/jpeg, image/png or application/pdf
+	SizeBytes     int64                  `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentMetadata) Reset() {
+	*x = AttachmentMetadata{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentMetadata) ProtoMessage() {}
+
+func (x *AttachmentMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentMetadata.ProtoReflect.Descriptor instead.
+func (*AttachmentMetadata) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AttachmentMetadata) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentMetadata) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttachmentId  string                 `protobuf:"bytes,1,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"` //images only
+	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Url           string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"` //signed, valid until url_expires_at
+	UrlExpiresAt  string                 `protobuf:"bytes,9,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Attachment) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+func (x *Attachment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *Attachment) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Attachment) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Attachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Attachment) GetUrlExpiresAt() string {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return ""
+}
+
+func (x *Attachment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AttachmentId  string                 `protobuf:"bytes,2,opt,name=attachment_id,json=attachmentId,proto3" json:"attachment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetAttachmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAttachmentRequest) GetAttachmentId() string {
+	if x != nil {
+		return x.AttachmentId
+	}
+	return ""
+}
+
+// ++++++++ get issue ++++++++++
+type GetIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueRequest) Reset() {
+	*x = GetIssueRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueRequest) ProtoMessage() {}
+
+func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type GetIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueResponse) Reset() {
+	*x = GetIssueResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueResponse) ProtoMessage() {}
+
+func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+// ++++++++ issue timeline ++++++++++
+type GetIssueTimelineRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId         string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	IncludeInternal bool                   `protobuf:"varint,3,opt,name=include_internal,json=includeInternal,proto3" json:"include_internal,omitempty"` //support and service callers only: audit entries such as SLA breaches
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetIssueTimelineRequest) Reset() {
+	*x = GetIssueTimelineRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueTimelineRequest) ProtoMessage() {}
+
+func (x *GetIssueTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetIssueTimelineRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetIssueTimelineRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *GetIssueTimelineRequest) GetIncludeInternal() bool {
+	if x != nil {
+		return x.IncludeInternal
+	}
+	return false
+}
+
+type TimelineActor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"` //COMPLAINANT, RESPONDENT or SYSTEM
+	UpdatedBy     *UpdatedBy             `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CascadedLevel int32                  `protobuf:"varint,3,opt,name=cascaded_level,json=cascadedLevel,proto3" json:"cascaded_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineActor) Reset() {
+	*x = TimelineActor{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineActor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineActor) ProtoMessage() {}
+
+func (x *TimelineActor) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineActor.ProtoReflect.Descriptor instead.
+func (*TimelineActor) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TimelineActor) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TimelineActor) GetUpdatedBy() *UpdatedBy {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *TimelineActor) GetCascadedLevel() int32 {
+	if x != nil {
+		return x.CascadedLevel
+	}
+	return 0
+}
+
+type TimelineEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` //COMPLAINANT_ACTION, RESPONDENT_ACTION, RESOLUTION_UPDATED, STATUS_CALLBACK, STATUS_POLL
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ShortDesc     string                 `protobuf:"bytes,3,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	At            string                 `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	Actor         *TimelineActor         `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	Resolution    *Resolution            `protobuf:"bytes,6,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	MessageId     string                 `protobuf:"bytes,7,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Internal      bool                   `protobuf:"varint,8,opt,name=internal,proto3" json:"internal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEvent) ProtoMessage() {}
+
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TimelineEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetActor() *TimelineActor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+func (x *TimelineEvent) GetResolution() *Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *TimelineEvent) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetInternal() bool {
+	if x != nil {
+		return x.Internal
+	}
+	return false
+}
+
+type GetIssueTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Events        []*TimelineEvent       `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueTimelineResponse) Reset() {
+	*x = GetIssueTimelineResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueTimelineResponse) ProtoMessage() {}
+
+func (x *GetIssueTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetIssueTimelineResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *GetIssueTimelineResponse) GetEvents() []*TimelineEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// ++++++++ live updates ++++++++++
+type WatchIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	AfterCursor   string                 `protobuf:"bytes,3,opt,name=after_cursor,json=afterCursor,proto3" json:"after_cursor,omitempty"` //cursor of the last event received, empty for new events only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchIssueRequest) Reset() {
+	*x = WatchIssueRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchIssueRequest) ProtoMessage() {}
+
+func (x *WatchIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchIssueRequest.ProtoReflect.Descriptor instead.
+func (*WatchIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WatchIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WatchIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *WatchIssueRequest) GetAfterCursor() string {
+	if x != nil {
+		return x.AfterCursor
+	}
+	return ""
+}
+
+type WatchUserIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AfterCursor   string                 `protobuf:"bytes,2,opt,name=after_cursor,json=afterCursor,proto3" json:"after_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchUserIssuesRequest) Reset() {
+	*x = WatchUserIssuesRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchUserIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchUserIssuesRequest) ProtoMessage() {}
+
+func (x *WatchUserIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchUserIssuesRequest.ProtoReflect.Descriptor instead.
+func (*WatchUserIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WatchUserIssuesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *WatchUserIssuesRequest) GetAfterCursor() string {
+	if x != nil {
+		return x.AfterCursor
+	}
+	return ""
+}
+
+type IssueEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Cursor             string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	IssueId            string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	TransactionId      string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Action             string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` //issue, on_issue_status, sla_breached, info_provided, ...
+	Status             string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Timestamp          string                 `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RespondentActions  []*RespondentAction    `protobuf:"bytes,7,rep,name=respondent_actions,json=respondentActions,proto3" json:"respondent_actions,omitempty"`
+	ResolutionProvider *ResolutionProvider    `protobuf:"bytes,8,opt,name=resolution_provider,json=resolutionProvider,proto3" json:"resolution_provider,omitempty"`
+	Resolution         *Resolution            `protobuf:"bytes,9,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Attributes         map[string]string      `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` //remaining event fields
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *IssueEvent) Reset() {
+	*x = IssueEvent{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueEvent) ProtoMessage() {}
+
+func (x *IssueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueEvent.ProtoReflect.Descriptor instead.
+func (*IssueEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *IssueEvent) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *IssueEvent) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *IssueEvent) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *IssueEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *IssueEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IssueEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *IssueEvent) GetRespondentActions() []*RespondentAction {
+	if x != nil {
+		return x.RespondentActions
+	}
+	return nil
+}
+
+func (x *IssueEvent) GetResolutionProvider() *ResolutionProvider {
+	if x != nil {
+		return x.ResolutionProvider
+	}
+	return nil
+}
+
+func (x *IssueEvent) GetResolution() *Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *IssueEvent) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// ++++++ list issues ++++++
+type ListIssueRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Deprecated: Marked as deprecated in api/proto/igm/v1/issue.proto.
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"` //ignored when cursor is set, use cursor
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Category      string `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	IssueType     string `protobuf:"bytes,6,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	OrderId       string `protobuf:"bytes,7,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedFrom   string `protobuf:"bytes,8,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` //RFC3339, inclusive
+	CreatedTo     string `protobuf:"bytes,9,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       //RFC3339, exclusive
+	HasResolution *bool  `protobuf:"varint,10,opt,name=has_resolution,json=hasResolution,proto3,oneof" json:"has_resolution,omitempty"`
+	SortBy        string `protobuf:"bytes,11,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          //created_at (default) or updated_at
+	SortOrder     string `protobuf:"bytes,12,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` //DESC (default) or ASC
+	Cursor        string `protobuf:"bytes,13,opt,name=cursor,proto3" json:"cursor,omitempty"`                        //next_cursor of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueRequest) Reset() {
+	*x = ListIssueRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueRequest) ProtoMessage() {}
+
+func (x *ListIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in api/proto/igm/v1/issue.proto.
+func (x *ListIssueRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListIssueRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIssueRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetHasResolution() bool {
+	if x != nil && x.HasResolution != nil {
+		return *x.HasResolution
+	}
+	return false
+}
+
+func (x *ListIssueRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListIssueRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListIssueByOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueByOrderRequest) Reset() {
+	*x = ListIssueByOrderRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueByOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueByOrderRequest) ProtoMessage() {}
+
+func (x *ListIssueByOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueByOrderRequest.ProtoReflect.Descriptor instead.
+func (*ListIssueByOrderRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListIssueByOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListIssueByOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ListIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` //matching issues across all pages
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` //empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssueResponse) Reset() {
+	*x = ListIssueResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssueResponse) ProtoMessage() {}
+
+func (x *ListIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssueResponse.ProtoReflect.Descriptor instead.
+func (*ListIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListIssueResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ListIssueResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListIssueResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListIssueResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIssueResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// ++++++ full-text search ++++++
+type IssueSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` //users search their own issues; support may leave it empty to search all
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                 //web search syntax: "quoted phrase", OR, -excluded
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	IssueType     string                 `protobuf:"bytes,5,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	OrderId       string                 `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedFrom   string                 `protobuf:"bytes,7,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` //RFC3339, inclusive
+	CreatedTo     string                 `protobuf:"bytes,8,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       //RFC3339, exclusive
+	HasResolution *bool                  `protobuf:"varint,9,opt,name=has_resolution,json=hasResolution,proto3,oneof" json:"has_resolution,omitempty"`
+	Page          int32                  `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSearchRequest) Reset() {
+	*x = IssueSearchRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSearchRequest) ProtoMessage() {}
+
+func (x *IssueSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSearchRequest.ProtoReflect.Descriptor instead.
+func (*IssueSearchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *IssueSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *IssueSearchRequest) GetHasResolution() bool {
+	if x != nil && x.HasResolution != nil {
+		return *x.HasResolution
+	}
+	return false
+}
+
+func (x *IssueSearchRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *IssueSearchRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type IssueSearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Rank          float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"` //matched terms wrapped in <mark></mark>
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSearchHit) Reset() {
+	*x = IssueSearchHit{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSearchHit) ProtoMessage() {}
+
+func (x *IssueSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSearchHit.ProtoReflect.Descriptor instead.
+func (*IssueSearchHit) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *IssueSearchHit) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *IssueSearchHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *IssueSearchHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type IssueSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*IssueSearchHit      `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"` //best match first
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSearchResponse) Reset() {
+	*x = IssueSearchResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSearchResponse) ProtoMessage() {}
+
+func (x *IssueSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSearchResponse.ProtoReflect.Descriptor instead.
+func (*IssueSearchResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *IssueSearchResponse) GetHits() []*IssueSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *IssueSearchResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *IssueSearchResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *IssueSearchResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ++++++++ support ++++++++++
+type SearchIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	BppId         string                 `protobuf:"bytes,2,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	CreatedFrom   string                 `protobuf:"bytes,4,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`  //RFC3339, inclusive
+	CreatedTo     string                 `protobuf:"bytes,5,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`        //RFC3339, exclusive
+	SlaBreached   bool                   `protobuf:"varint,6,opt,name=sla_breached,json=slaBreached,proto3" json:"sla_breached,omitempty"` //only issues past their response or resolution deadline
+	AssignedTo    string                 `protobuf:"bytes,7,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	Page          int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIssuesRequest) Reset() {
+	*x = SearchIssuesRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIssuesRequest) ProtoMessage() {}
+
+func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIssuesRequest.ProtoReflect.Descriptor instead.
+func (*SearchIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchIssuesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetBppId() string {
+	if x != nil {
+		return x.BppId
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetSlaBreached() bool {
+	if x != nil {
+		return x.SlaBreached
+	}
+	return false
+}
+
+func (x *SearchIssuesRequest) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchIssuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*SupportIssue        `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIssuesResponse) Reset() {
+	*x = SearchIssuesResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIssuesResponse) ProtoMessage() {}
+
+func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIssuesResponse.ProtoReflect.Descriptor instead.
+func (*SearchIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SearchIssuesResponse) GetIssues() []*SupportIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *SearchIssuesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchIssuesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchIssuesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SupportIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	AssignedTo    string                 `protobuf:"bytes,2,opt,name=assigned_to,json=assignedTo,proto3" json:"assigned_to,omitempty"`
+	AssignedAt    string                 `protobuf:"bytes,3,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportIssue) Reset() {
+	*x = SupportIssue{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportIssue) ProtoMessage() {}
+
+func (x *SupportIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportIssue.ProtoReflect.Descriptor instead.
+func (*SupportIssue) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SupportIssue) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *SupportIssue) GetAssignedTo() string {
+	if x != nil {
+		return x.AssignedTo
+	}
+	return ""
+}
+
+func (x *SupportIssue) GetAssignedAt() string {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return ""
+}
+
+type GetIssueDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueDetailsRequest) Reset() {
+	*x = GetIssueDetailsRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueDetailsRequest) ProtoMessage() {}
+
+func (x *GetIssueDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetIssueDetailsRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type RawCallback struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	PayloadJson   string                 `protobuf:"bytes,3,opt,name=payload_json,json=payloadJson,proto3" json:"payload_json,omitempty"`
+	ReceivedAt    string                 `protobuf:"bytes,4,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RawCallback) Reset() {
+	*x = RawCallback{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RawCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawCallback) ProtoMessage() {}
+
+func (x *RawCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RawCallback.ProtoReflect.Descriptor instead.
+func (*RawCallback) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RawCallback) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RawCallback) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *RawCallback) GetPayloadJson() string {
+	if x != nil {
+		return x.PayloadJson
+	}
+	return ""
+}
+
+func (x *RawCallback) GetReceivedAt() string {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return ""
+}
+
+type InternalNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalNote) Reset() {
+	*x = InternalNote{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalNote) ProtoMessage() {}
+
+func (x *InternalNote) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalNote.ProtoReflect.Descriptor instead.
+func (*InternalNote) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *InternalNote) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InternalNote) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *InternalNote) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *InternalNote) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *InternalNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SupportAuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	ActorRole     string                 `protobuf:"bytes,4,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"` //SEARCH, VIEW_DETAILS, ADD_NOTE, REASSIGN, FORCE_STATUS
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,7,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportAuditEntry) Reset() {
+	*x = SupportAuditEntry{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportAuditEntry) ProtoMessage() {}
+
+func (x *SupportAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportAuditEntry.ProtoReflect.Descriptor instead.
+func (*SupportAuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SupportAuditEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SupportAuditEntry) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *SupportAuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *SupportAuditEntry) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *SupportAuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SupportAuditEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SupportAuditEntry) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *SupportAuditEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type IssueDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *SupportIssue          `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Timeline      []*TimelineEvent       `protobuf:"bytes,2,rep,name=timeline,proto3" json:"timeline,omitempty"` //includes internal entries
+	Callbacks     []*RawCallback         `protobuf:"bytes,3,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	Notes         []*InternalNote        `protobuf:"bytes,4,rep,name=notes,proto3" json:"notes,omitempty"`
+	AuditLog      []*SupportAuditEntry   `protobuf:"bytes,5,rep,name=audit_log,json=auditLog,proto3" json:"audit_log,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueDetails) Reset() {
+	*x = IssueDetails{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueDetails) ProtoMessage() {}
+
+func (x *IssueDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueDetails.ProtoReflect.Descriptor instead.
+func (*IssueDetails) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *IssueDetails) GetIssue() *SupportIssue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *IssueDetails) GetTimeline() []*TimelineEvent {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *IssueDetails) GetCallbacks() []*RawCallback {
+	if x != nil {
+		return x.Callbacks
+	}
+	return nil
+}
+
+func (x *IssueDetails) GetNotes() []*InternalNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *IssueDetails) GetAuditLog() []*SupportAuditEntry {
+	if x != nil {
+		return x.AuditLog
+	}
+	return nil
+}
+
+type AddInternalNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddInternalNoteRequest) Reset() {
+	*x = AddInternalNoteRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddInternalNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddInternalNoteRequest) ProtoMessage() {}
+
+func (x *AddInternalNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddInternalNoteRequest.ProtoReflect.Descriptor instead.
+func (*AddInternalNoteRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddInternalNoteRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *AddInternalNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ReassignIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Assignee      string                 `protobuf:"bytes,2,opt,name=assignee,proto3" json:"assignee,omitempty"` //empty unassigns
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignIssueRequest) Reset() {
+	*x = ReassignIssueRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignIssueRequest) ProtoMessage() {}
+
+func (x *ReassignIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignIssueRequest.ProtoReflect.Descriptor instead.
+func (*ReassignIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReassignIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ReassignIssueRequest) GetAssignee() string {
+	if x != nil {
+		return x.Assignee
+	}
+	return ""
+}
+
+func (x *ReassignIssueRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReassignIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *SupportIssue          `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignIssueResponse) Reset() {
+	*x = ReassignIssueResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignIssueResponse) ProtoMessage() {}
+
+func (x *ReassignIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignIssueResponse.ProtoReflect.Descriptor instead.
+func (*ReassignIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReassignIssueResponse) GetIssue() *SupportIssue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type ForceIssueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` //OPEN or CLOSED
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` //required
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceIssueStatusRequest) Reset() {
+	*x = ForceIssueStatusRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceIssueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceIssueStatusRequest) ProtoMessage() {}
+
+func (x *ForceIssueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceIssueStatusRequest.ProtoReflect.Descriptor instead.
+func (*ForceIssueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ForceIssueStatusRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ForceIssueStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ForceIssueStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ForceIssueStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *SupportIssue          `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceIssueStatusResponse) Reset() {
+	*x = ForceIssueStatusResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceIssueStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceIssueStatusResponse) ProtoMessage() {}
+
+func (x *ForceIssueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceIssueStatusResponse.ProtoReflect.Descriptor instead.
+func (*ForceIssueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ForceIssueStatusResponse) GetIssue() *SupportIssue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type ListSupportAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"` //optional
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`                    //optional
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportAuditLogRequest) Reset() {
+	*x = ListSupportAuditLogRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportAuditLogRequest) ProtoMessage() {}
+
+func (x *ListSupportAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*ListSupportAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListSupportAuditLogRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *ListSupportAuditLogRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListSupportAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSupportAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*SupportAuditEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSupportAuditLogResponse) Reset() {
+	*x = ListSupportAuditLogResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSupportAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSupportAuditLogResponse) ProtoMessage() {}
+
+func (x *ListSupportAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSupportAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*ListSupportAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListSupportAuditLogResponse) GetEntries() []*SupportAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Context struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	BapId         string                 `protobuf:"bytes,3,opt,name=bap_id,json=bapId,proto3" json:"bap_id,omitempty"`
+	BppId         string                 `protobuf:"bytes,4,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,5,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,6,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Context) Reset() {
+	*x = Context{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Context) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Context) ProtoMessage() {}
+
+func (x *Context) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Context.ProtoReflect.Descriptor instead.
+func (*Context) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Context) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Context) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *Context) GetBapId() string {
+	if x != nil {
+		return x.BapId
+	}
+	return ""
+}
+
+func (x *Context) GetBppId() string {
+	if x != nil {
+		return x.BppId
+	}
+	return ""
+}
+
+func (x *Context) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Context) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+type Org struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Org) Reset() {
+	*x = Org{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Org) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Org) ProtoMessage() {}
+
+func (x *Org) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Org.ProtoReflect.Descriptor instead.
+func (*Org) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Org) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Contact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Contact) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Contact) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type Person struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Person) Reset() {
+	*x = Person{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Person) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Person) ProtoMessage() {}
+
+func (x *Person) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Person.ProtoReflect.Descriptor instead.
+func (*Person) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Person) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdatedBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Org           *Org                   `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
+	Contact       *Contact               `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	Person        *Person                `protobuf:"bytes,3,opt,name=person,proto3" json:"person,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatedBy) Reset() {
+	*x = UpdatedBy{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatedBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatedBy) ProtoMessage() {}
+
+func (x *UpdatedBy) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatedBy.ProtoReflect.Descriptor instead.
+func (*UpdatedBy) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdatedBy) GetOrg() *Org {
+	if x != nil {
+		return x.Org
+	}
+	return nil
+}
+
+func (x *UpdatedBy) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *UpdatedBy) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+type RespondentAction struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RespondentAction string                 `protobuf:"bytes,1,opt,name=respondent_action,json=respondentAction,proto3" json:"respondent_action,omitempty"`
+	ShortDesc        string                 `protobuf:"bytes,2,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	UpdatedAt        string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy        *UpdatedBy             `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	CascadedLevel    int32                  `protobuf:"varint,5,opt,name=cascaded_level,json=cascadedLevel,proto3" json:"cascaded_level,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RespondentAction) Reset() {
+	*x = RespondentAction{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondentAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondentAction) ProtoMessage() {}
+
+func (x *RespondentAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondentAction.ProtoReflect.Descriptor instead.
+func (*RespondentAction) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RespondentAction) GetRespondentAction() string {
+	if x != nil {
+		return x.RespondentAction
+	}
+	return ""
+}
+
+func (x *RespondentAction) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *RespondentAction) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *RespondentAction) GetUpdatedBy() *UpdatedBy {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *RespondentAction) GetCascadedLevel() int32 {
+	if x != nil {
+		return x.CascadedLevel
+	}
+	return 0
+}
+
+type ComplainantAction struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ComplainantAction string                 `protobuf:"bytes,1,opt,name=complainant_action,json=complainantAction,proto3" json:"complainant_action,omitempty"`
+	ShortDesc         string                 `protobuf:"bytes,2,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	UpdatedAt         string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy         *UpdatedBy             `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ComplainantAction) Reset() {
+	*x = ComplainantAction{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComplainantAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplainantAction) ProtoMessage() {}
+
+func (x *ComplainantAction) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplainantAction.ProtoReflect.Descriptor instead.
+func (*ComplainantAction) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ComplainantAction) GetComplainantAction() string {
+	if x != nil {
+		return x.ComplainantAction
+	}
+	return ""
+}
+
+func (x *ComplainantAction) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *ComplainantAction) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ComplainantAction) GetUpdatedBy() *UpdatedBy {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+type IssueActions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ComplainantActions []*ComplainantAction   `protobuf:"bytes,1,rep,name=complainant_actions,json=complainantActions,proto3" json:"complainant_actions,omitempty"`
+	RespondentActions  []*RespondentAction    `protobuf:"bytes,2,rep,name=respondent_actions,json=respondentActions,proto3" json:"respondent_actions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *IssueActions) Reset() {
+	*x = IssueActions{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueActions) ProtoMessage() {}
+
+func (x *IssueActions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueActions.ProtoReflect.Descriptor instead.
+func (*IssueActions) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *IssueActions) GetComplainantActions() []*ComplainantAction {
+	if x != nil {
+		return x.ComplainantActions
+	}
+	return nil
+}
+
+func (x *IssueActions) GetRespondentActions() []*RespondentAction {
+	if x != nil {
+		return x.RespondentActions
+	}
+	return nil
+}
+
+type Organization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Org           *Org                   `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
+	Person        *Person                `protobuf:"bytes,2,opt,name=person,proto3" json:"person,omitempty"`
+	Contact       *Contact               `protobuf:"bytes,3,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Organization) GetOrg() *Org {
+	if x != nil {
+		return x.Org
+	}
+	return nil
+}
+
+func (x *Organization) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *Organization) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+type Price struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Price) Reset() {
+	*x = Price{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Price) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Price) ProtoMessage() {}
+
+func (x *Price) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Price.ProtoReflect.Descriptor instead.
+func (*Price) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *Price) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Price) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type PricingModel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         *Price                 `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	PricingInfo   string                 `protobuf:"bytes,2,opt,name=pricing_info,json=pricingInfo,proto3" json:"pricing_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricingModel) Reset() {
+	*x = PricingModel{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingModel) ProtoMessage() {}
+
+func (x *PricingModel) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingModel.ProtoReflect.Descriptor instead.
+func (*PricingModel) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PricingModel) GetPrice() *Price {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PricingModel) GetPricingInfo() string {
+	if x != nil {
+		return x.PricingInfo
+	}
+	return ""
+}
+
+type SelectedOdr struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ShortDesc     string                 `protobuf:"bytes,2,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	PricingModel  *PricingModel          `protobuf:"bytes,4,opt,name=pricing_model,json=pricingModel,proto3" json:"pricing_model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectedOdr) Reset() {
+	*x = SelectedOdr{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectedOdr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectedOdr) ProtoMessage() {}
+
+func (x *SelectedOdr) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectedOdr.ProtoReflect.Descriptor instead.
+func (*SelectedOdr) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SelectedOdr) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SelectedOdr) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *SelectedOdr) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SelectedOdr) GetPricingModel() *PricingModel {
+	if x != nil {
+		return x.PricingModel
+	}
+	return nil
+}
+
+type Gro struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Contact       *Contact               `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	GroType       string                 `protobuf:"bytes,3,opt,name=gro_type,json=groType,proto3" json:"gro_type,omitempty"` //INTERFACING-NP-GRO, TRANSACTION-COUNTERPARTY-NP-GRO, CASCADED-COUNTERPARTY-NP-GRO
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Gro) Reset() {
+	*x = Gro{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Gro) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Gro) ProtoMessage() {}
+
+func (x *Gro) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Gro.ProtoReflect.Descriptor instead.
+func (*Gro) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Gro) GetPerson() *Person {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *Gro) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *Gro) GetGroType() string {
+	if x != nil {
+		return x.GroType
+	}
+	return ""
+}
+
+type ResolutionSupport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatLink      string                 `protobuf:"bytes,1,opt,name=chat_link,json=chatLink,proto3" json:"chat_link,omitempty"`
+	Contact       *Contact               `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	SelectedOdrs  []*SelectedOdr         `protobuf:"bytes,3,rep,name=selected_odrs,json=selectedOdrs,proto3" json:"selected_odrs,omitempty"`
+	Gros          []*Gro                 `protobuf:"bytes,4,rep,name=gros,proto3" json:"gros,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolutionSupport) Reset() {
+	*x = ResolutionSupport{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolutionSupport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolutionSupport) ProtoMessage() {}
+
+func (x *ResolutionSupport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolutionSupport.ProtoReflect.Descriptor instead.
+func (*ResolutionSupport) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ResolutionSupport) GetChatLink() string {
+	if x != nil {
+		return x.ChatLink
+	}
+	return ""
+}
+
+func (x *ResolutionSupport) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *ResolutionSupport) GetSelectedOdrs() []*SelectedOdr {
+	if x != nil {
+		return x.SelectedOdrs
+	}
+	return nil
+}
+
+func (x *ResolutionSupport) GetGros() []*Gro {
+	if x != nil {
+		return x.Gros
+	}
+	return nil
+}
+
+type ResolutionProviderInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Organization      *Organization          `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	ResolutionSupport *ResolutionSupport     `protobuf:"bytes,3,opt,name=resolution_support,json=resolutionSupport,proto3" json:"resolution_support,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResolutionProviderInfo) Reset() {
+	*x = ResolutionProviderInfo{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolutionProviderInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolutionProviderInfo) ProtoMessage() {}
+
+func (x *ResolutionProviderInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolutionProviderInfo.ProtoReflect.Descriptor instead.
+func (*ResolutionProviderInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ResolutionProviderInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ResolutionProviderInfo) GetOrganization() *Organization {
+	if x != nil {
+		return x.Organization
+	}
+	return nil
+}
+
+func (x *ResolutionProviderInfo) GetResolutionSupport() *ResolutionSupport {
+	if x != nil {
+		return x.ResolutionSupport
+	}
+	return nil
+}
+
+type ResolutionProvider struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	RespondentInfo *ResolutionProviderInfo `protobuf:"bytes,1,opt,name=respondent_info,json=respondentInfo,proto3" json:"respondent_info,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ResolutionProvider) Reset() {
+	*x = ResolutionProvider{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolutionProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolutionProvider) ProtoMessage() {}
+
+func (x *ResolutionProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolutionProvider.ProtoReflect.Descriptor instead.
+func (*ResolutionProvider) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ResolutionProvider) GetRespondentInfo() *ResolutionProviderInfo {
+	if x != nil {
+		return x.RespondentInfo
+	}
+	return nil
+}
+
+type Resolution struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ShortDesc       string                 `protobuf:"bytes,1,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	LongDesc        string                 `protobuf:"bytes,2,opt,name=long_desc,json=longDesc,proto3" json:"long_desc,omitempty"`
+	ActionTriggered string                 `protobuf:"bytes,3,opt,name=action_triggered,json=actionTriggered,proto3" json:"action_triggered,omitempty"`
+	RefundAmount    string                 `protobuf:"bytes,4,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Resolution) Reset() {
+	*x = Resolution{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Resolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resolution) ProtoMessage() {}
+
+func (x *Resolution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Resolution.ProtoReflect.Descriptor instead.
+func (*Resolution) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Resolution) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+func (x *Resolution) GetLongDesc() string {
+	if x != nil {
+		return x.LongDesc
+	}
+	return ""
+}
+
+func (x *Resolution) GetActionTriggered() string {
+	if x != nil {
+		return x.ActionTriggered
+	}
+	return ""
+}
+
+func (x *Resolution) GetRefundAmount() string {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return ""
+}
+
+type IncomingIssue struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	IssueType          string                 `protobuf:"bytes,3,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	Category           string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	SubCategory        string                 `protobuf:"bytes,5,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
+	IssueActions       *IssueActions          `protobuf:"bytes,6,opt,name=issue_actions,json=issueActions,proto3" json:"issue_actions,omitempty"`
+	ResolutionProvider *ResolutionProvider    `protobuf:"bytes,7,opt,name=resolution_provider,json=resolutionProvider,proto3" json:"resolution_provider,omitempty"`
+	Resolution         *Resolution            `protobuf:"bytes,8,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *IncomingIssue) Reset() {
+	*x = IncomingIssue{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomingIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomingIssue) ProtoMessage() {}
+
+func (x *IncomingIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomingIssue.ProtoReflect.Descriptor instead.
+func (*IncomingIssue) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *IncomingIssue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IncomingIssue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IncomingIssue) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *IncomingIssue) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *IncomingIssue) GetSubCategory() string {
+	if x != nil {
+		return x.SubCategory
+	}
+	return ""
+}
+
+func (x *IncomingIssue) GetIssueActions() *IssueActions {
+	if x != nil {
+		return x.IssueActions
+	}
+	return nil
+}
+
+func (x *IncomingIssue) GetResolutionProvider() *ResolutionProvider {
+	if x != nil {
+		return x.ResolutionProvider
+	}
+	return nil
+}
+
+func (x *IncomingIssue) GetResolution() *Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *IncomingIssue) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *IncomingIssue) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type OnIssuePayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Context       *Context               `protobuf:"bytes,1,opt,name=context,proto3" json:"context,omitempty"`
+	Issue         *IncomingIssue         `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnIssuePayload) Reset() {
+	*x = OnIssuePayload{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnIssuePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnIssuePayload) ProtoMessage() {}
+
+func (x *OnIssuePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnIssuePayload.ProtoReflect.Descriptor instead.
+func (*OnIssuePayload) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *OnIssuePayload) GetContext() *Context {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *OnIssuePayload) GetIssue() *IncomingIssue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type OnIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	Payload       *OnIssuePayload        `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnIssueRequest) Reset() {
+	*x = OnIssueRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnIssueRequest) ProtoMessage() {}
+
+func (x *OnIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnIssueRequest.ProtoReflect.Descriptor instead.
+func (*OnIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *OnIssueRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *OnIssueRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *OnIssueRequest) GetPayload() *OnIssuePayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type OnIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnIssueResponse) Reset() {
+	*x = OnIssueResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnIssueResponse) ProtoMessage() {}
+
+func (x *OnIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnIssueResponse.ProtoReflect.Descriptor instead.
+func (*OnIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *OnIssueResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OnIssueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type OnIssueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	MessageId     string                 `protobuf:"bytes,2,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,3,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Payload       *OnIssuePayload        `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnIssueStatusRequest) Reset() {
+	*x = OnIssueStatusRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnIssueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnIssueStatusRequest) ProtoMessage() {}
+
+func (x *OnIssueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnIssueStatusRequest.ProtoReflect.Descriptor instead.
+func (*OnIssueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *OnIssueStatusRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *OnIssueStatusRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *OnIssueStatusRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *OnIssueStatusRequest) GetPayload() *OnIssuePayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type OnIssueStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnIssueStatusResponse) Reset() {
+	*x = OnIssueStatusResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnIssueStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnIssueStatusResponse) ProtoMessage() {}
+
+func (x *OnIssueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnIssueStatusResponse.ProtoReflect.Descriptor instead.
+func (*OnIssueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *OnIssueStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OnIssueStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type IssueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueStatusRequest) Reset() {
+	*x = IssueStatusRequest{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueStatusRequest) ProtoMessage() {}
+
+func (x *IssueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueStatusRequest.ProtoReflect.Descriptor instead.
+func (*IssueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *IssueStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *IssueStatusRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type IssueStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,4,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,5,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueStatusResponse) Reset() {
+	*x = IssueStatusResponse{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueStatusResponse) ProtoMessage() {}
+
+func (x *IssueStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueStatusResponse.ProtoReflect.Descriptor instead.
+func (*IssueStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *IssueStatusResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *IssueStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IssueStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *IssueStatusResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *IssueStatusResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+type Issue struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	IssueId                string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	OrderId                string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId                 string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId          string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Category               string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	SubCategory            string                 `protobuf:"bytes,6,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
+	IssueType              string                 `protobuf:"bytes,7,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
+	Status                 string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	DescriptionShort       string                 `protobuf:"bytes,9,opt,name=description_short,json=descriptionShort,proto3" json:"description_short,omitempty"`
+	DescriptionLong        string                 `protobuf:"bytes,10,opt,name=description_long,json=descriptionLong,proto3" json:"description_long,omitempty"`
+	ImageUrls              []string               `protobuf:"bytes,11,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	BppId                  string                 `protobuf:"bytes,12,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
+	BppUri                 string                 `protobuf:"bytes,13,opt,name=bpp_uri,json=bppUri,proto3" json:"bpp_uri,omitempty"`
+	CreatedAt              string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CascadedLevel          int32                  `protobuf:"varint,16,opt,name=cascaded_level,json=cascadedLevel,proto3" json:"cascaded_level,omitempty"`
+	CurrentRespondent      *RespondentParty       `protobuf:"bytes,17,opt,name=current_respondent,json=currentRespondent,proto3" json:"current_respondent,omitempty"` //escalations go to this party's GRO
+	RespondentChain        []*RespondentParty     `protobuf:"bytes,18,rep,name=respondent_chain,json=respondentChain,proto3" json:"respondent_chain,omitempty"`
+	RespondentStatus       string                 `protobuf:"bytes,19,opt,name=respondent_status,json=respondentStatus,proto3" json:"respondent_status,omitempty"`
+	Rating                 string                 `protobuf:"bytes,20,opt,name=rating,proto3" json:"rating,omitempty"`
+	Resolution             *Resolution            `protobuf:"bytes,21,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ResolutionProvider     *ResolutionProvider    `protobuf:"bytes,22,opt,name=resolution_provider,json=resolutionProvider,proto3" json:"resolution_provider,omitempty"`
+	ComplainantActions     []*ComplainantAction   `protobuf:"bytes,23,rep,name=complainant_actions,json=complainantActions,proto3" json:"complainant_actions,omitempty"`
+	RespondentActions      []*RespondentAction    `protobuf:"bytes,24,rep,name=respondent_actions,json=respondentActions,proto3" json:"respondent_actions,omitempty"`
+	Gro                    *Gro                   `protobuf:"bytes,25,opt,name=gro,proto3" json:"gro,omitempty"` //grievance redressal officer shown to the complainant
+	AdditionalDesc         *AdditionalDescription `protobuf:"bytes,26,opt,name=additional_desc,json=additionalDesc,proto3" json:"additional_desc,omitempty"`
+	ComplainantInfo        *ComplainantInfo       `protobuf:"bytes,27,opt,name=complainant_info,json=complainantInfo,proto3" json:"complainant_info,omitempty"`
+	OrderDetails           *OrderDetails          `protobuf:"bytes,28,opt,name=order_details,json=orderDetails,proto3" json:"order_details,omitempty"`
+	ExpectedResponseTime   string                 `protobuf:"bytes,29,opt,name=expected_response_time,json=expectedResponseTime,proto3" json:"expected_response_time,omitempty"` //ISO-8601 duration
+	ExpectedResolutionTime string                 `protobuf:"bytes,30,opt,name=expected_resolution_time,json=expectedResolutionTime,proto3" json:"expected_resolution_time,omitempty"`
+	RespondBy              string                 `protobuf:"bytes,31,opt,name=respond_by,json=respondBy,proto3" json:"respond_by,omitempty"`
+	ResolveBy              string                 `protobuf:"bytes,32,opt,name=resolve_by,json=resolveBy,proto3" json:"resolve_by,omitempty"`
+	ResponseBreachedAt     string                 `protobuf:"bytes,33,opt,name=response_breached_at,json=responseBreachedAt,proto3" json:"response_breached_at,omitempty"`
+	ResolutionBreachedAt   string                 `protobuf:"bytes,34,opt,name=resolution_breached_at,json=resolutionBreachedAt,proto3" json:"resolution_breached_at,omitempty"`
+	ResolvedAt             string                 `protobuf:"bytes,35,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	AutoClosedAt           string                 `protobuf:"bytes,36,opt,name=auto_closed_at,json=autoClosedAt,proto3" json:"auto_closed_at,omitempty"`
+	OdrProviderId          string                 `protobuf:"bytes,37,opt,name=odr_provider_id,json=odrProviderId,proto3" json:"odr_provider_id,omitempty"`
+	DisputeRaisedAt        string                 `protobuf:"bytes,38,opt,name=dispute_raised_at,json=disputeRaisedAt,proto3" json:"dispute_raised_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Issue) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *Issue) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Issue) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Issue) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Issue) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Issue) GetSubCategory() string {
+	if x != nil {
+		return x.SubCategory
+	}
+	return ""
+}
+
+func (x *Issue) GetIssueType() string {
+	if x != nil {
+		return x.IssueType
+	}
+	return ""
+}
+
+func (x *Issue) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Issue) GetDescriptionShort() string {
+	if x != nil {
+		return x.DescriptionShort
+	}
+	return ""
+}
+
+func (x *Issue) GetDescriptionLong() string {
+	if x != nil {
+		return x.DescriptionLong
+	}
+	return ""
+}
+
+func (x *Issue) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *Issue) GetBppId() string {
+	if x != nil {
+		return x.BppId
+	}
+	return ""
+}
+
+func (x *Issue) GetBppUri() string {
+	if x != nil {
+		return x.BppUri
+	}
+	return ""
+}
+
+func (x *Issue) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetCascadedLevel() int32 {
+	if x != nil {
+		return x.CascadedLevel
+	}
+	return 0
+}
+
+func (x *Issue) GetCurrentRespondent() *RespondentParty {
+	if x != nil {
+		return x.CurrentRespondent
+	}
+	return nil
+}
+
+func (x *Issue) GetRespondentChain() []*RespondentParty {
+	if x != nil {
+		return x.RespondentChain
+	}
+	return nil
+}
+
+func (x *Issue) GetRespondentStatus() string {
+	if x != nil {
+		return x.RespondentStatus
+	}
+	return ""
+}
+
+func (x *Issue) GetRating() string {
+	if x != nil {
+		return x.Rating
+	}
+	return ""
+}
+
+func (x *Issue) GetResolution() *Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *Issue) GetResolutionProvider() *ResolutionProvider {
+	if x != nil {
+		return x.ResolutionProvider
+	}
+	return nil
+}
+
+func (x *Issue) GetComplainantActions() []*ComplainantAction {
+	if x != nil {
+		return x.ComplainantActions
+	}
+	return nil
+}
+
+func (x *Issue) GetRespondentActions() []*RespondentAction {
+	if x != nil {
+		return x.RespondentActions
+	}
+	return nil
+}
+
+func (x *Issue) GetGro() *Gro {
+	if x != nil {
+		return x.Gro
+	}
+	return nil
+}
+
+func (x *Issue) GetAdditionalDesc() *AdditionalDescription {
+	if x != nil {
+		return x.AdditionalDesc
+	}
+	return nil
+}
+
+func (x *Issue) GetComplainantInfo() *ComplainantInfo {
+	if x != nil {
+		return x.ComplainantInfo
+	}
+	return nil
+}
+
+func (x *Issue) GetOrderDetails() *OrderDetails {
+	if x != nil {
+		return x.OrderDetails
+	}
+	return nil
+}
+
+func (x *Issue) GetExpectedResponseTime() string {
+	if x != nil {
+		return x.ExpectedResponseTime
+	}
+	return ""
+}
+
+func (x *Issue) GetExpectedResolutionTime() string {
+	if x != nil {
+		return x.ExpectedResolutionTime
+	}
+	return ""
+}
+
+func (x *Issue) GetRespondBy() string {
+	if x != nil {
+		return x.RespondBy
+	}
+	return ""
+}
+
+func (x *Issue) GetResolveBy() string {
+	if x != nil {
+		return x.ResolveBy
+	}
+	return ""
+}
+
+func (x *Issue) GetResponseBreachedAt() string {
+	if x != nil {
+		return x.ResponseBreachedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetResolutionBreachedAt() string {
+	if x != nil {
+		return x.ResolutionBreachedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetAutoClosedAt() string {
+	if x != nil {
+		return x.AutoClosedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetOdrProviderId() string {
+	if x != nil {
+		return x.OdrProviderId
+	}
+	return ""
+}
+
+func (x *Issue) GetDisputeRaisedAt() string {
+	if x != nil {
+		return x.DisputeRaisedAt
+	}
+	return ""
+}
+
+type ComplainantInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Person        *Person                `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`
+	Contact       *Contact               `protobuf:"bytes,2,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComplainantInfo) Reset() {
+	*x = ComplainantInfo{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnIssueStatusResponse) String() string {
+func (x *ComplainantInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnIssueStatusResponse) ProtoMessage() {}
+func (*ComplainantInfo) ProtoMessage() {}
 
-func (x *OnIssueStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[30]
+func (x *ComplainantInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,48 +7172,49 @@ func (x *OnIssueStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnIssueStatusResponse.ProtoReflect.Descriptor instead.
-func (*OnIssueStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ComplainantInfo.ProtoReflect.Descriptor instead.
+func (*ComplainantInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *OnIssueStatusResponse) GetStatus() string {
+func (x *ComplainantInfo) GetPerson() *Person {
 	if x != nil {
-		return x.Status
+		return x.Person
 	}
-	return ""
+	return nil
 }
 
-func (x *OnIssueStatusResponse) GetMessage() string {
+func (x *ComplainantInfo) GetContact() *Contact {
 	if x != nil {
-		return x.Message
+		return x.Contact
 	}
-	return ""
+	return nil
 }
 
-type IssueStatusRequest struct {
+type OrderDetails struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProviderId    string                 `protobuf:"bytes,2,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	Items         []*IssueItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueStatusRequest) Reset() {
-	*x = IssueStatusRequest{}
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[31]
+func (x *OrderDetails) Reset() {
+	*x = OrderDetails{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueStatusRequest) String() string {
+func (x *OrderDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueStatusRequest) ProtoMessage() {}
+func (*OrderDetails) ProtoMessage() {}
 
-func (x *IssueStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[31]
+func (x *OrderDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2071,51 +7225,58 @@ func (x *IssueStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueStatusRequest.ProtoReflect.Descriptor instead.
-func (*IssueStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use OrderDetails.ProtoReflect.Descriptor instead.
+func (*OrderDetails) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *IssueStatusRequest) GetUserId() string {
+func (x *OrderDetails) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *IssueStatusRequest) GetIssueId() string {
+func (x *OrderDetails) GetProviderId() string {
 	if x != nil {
-		return x.IssueId
+		return x.ProviderId
 	}
 	return ""
 }
 
-type IssueStatusResponse struct {
+func (x *OrderDetails) GetItems() []*IssueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RespondentParty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	OndcSent      bool                   `protobuf:"varint,4,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
-	OndcMessage   string                 `protobuf:"bytes,5,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	CascadedLevel int32                  `protobuf:"varint,1,opt,name=cascaded_level,json=cascadedLevel,proto3" json:"cascaded_level,omitempty"`
+	Organization  *Organization          `protobuf:"bytes,2,opt,name=organization,proto3" json:"organization,omitempty"`
+	Gro           *Gro                   `protobuf:"bytes,3,opt,name=gro,proto3" json:"gro,omitempty"`
+	LastAction    string                 `protobuf:"bytes,4,opt,name=last_action,json=lastAction,proto3" json:"last_action,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IssueStatusResponse) Reset() {
-	*x = IssueStatusResponse{}
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[32]
+func (x *RespondentParty) Reset() {
+	*x = RespondentParty{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IssueStatusResponse) String() string {
+func (x *RespondentParty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IssueStatusResponse) ProtoMessage() {}
+func (*RespondentParty) ProtoMessage() {}
 
-func (x *IssueStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[32]
+func (x *RespondentParty) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2126,82 +7287,73 @@ func (x *IssueStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IssueStatusResponse.ProtoReflect.Descriptor instead.
-func (*IssueStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RespondentParty.ProtoReflect.Descriptor instead.
+func (*RespondentParty) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *IssueStatusResponse) GetIssueId() string {
+func (x *RespondentParty) GetCascadedLevel() int32 {
 	if x != nil {
-		return x.IssueId
+		return x.CascadedLevel
 	}
-	return ""
+	return 0
 }
 
-func (x *IssueStatusResponse) GetStatus() string {
+func (x *RespondentParty) GetOrganization() *Organization {
 	if x != nil {
-		return x.Status
+		return x.Organization
 	}
-	return ""
+	return nil
 }
 
-func (x *IssueStatusResponse) GetMessage() string {
+func (x *RespondentParty) GetGro() *Gro {
 	if x != nil {
-		return x.Message
+		return x.Gro
 	}
-	return ""
+	return nil
 }
 
-func (x *IssueStatusResponse) GetOndcSent() bool {
+func (x *RespondentParty) GetLastAction() string {
 	if x != nil {
-		return x.OndcSent
+		return x.LastAction
 	}
-	return false
+	return ""
 }
 
-func (x *IssueStatusResponse) GetOndcMessage() string {
+func (x *RespondentParty) GetUpdatedAt() string {
 	if x != nil {
-		return x.OndcMessage
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-type Issue struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	IssueId          string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
-	OrderId          string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	TransactionId    string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Category         string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	SubCategory      string                 `protobuf:"bytes,6,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
-	IssueType        string                 `protobuf:"bytes,7,opt,name=issue_type,json=issueType,proto3" json:"issue_type,omitempty"`
-	Status           string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	DescriptionShort string                 `protobuf:"bytes,9,opt,name=description_short,json=descriptionShort,proto3" json:"description_short,omitempty"`
-	DescriptionLong  string                 `protobuf:"bytes,10,opt,name=description_long,json=descriptionLong,proto3" json:"description_long,omitempty"`
-	ImageUrls        []string               `protobuf:"bytes,11,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
-	BppId            string                 `protobuf:"bytes,12,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
-	BppUri           string                 `protobuf:"bytes,13,opt,name=bpp_uri,json=bppUri,proto3" json:"bpp_uri,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        string                 `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// ErrorBody is the JSON body the HTTP gateway returns for every error,
+// including routing and malformed JSON errors.
+type ErrorBody struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	HttpStatus      int32                       `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	Code            string                      `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` //gRPC code name, e.g. INVALID_ARGUMENT
+	Message         string                      `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	FieldViolations []*ErrorBody_FieldViolation `protobuf:"bytes,4,rep,name=field_violations,json=fieldViolations,proto3" json:"field_violations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Issue) Reset() {
-	*x = Issue{}
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[33]
+func (x *ErrorBody) Reset() {
+	*x = ErrorBody{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Issue) String() string {
+func (x *ErrorBody) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Issue) ProtoMessage() {}
+func (*ErrorBody) ProtoMessage() {}
 
-func (x *Issue) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[33]
+func (x *ErrorBody) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,112 +7364,87 @@ func (x *Issue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
-func (*Issue) Descriptor() ([]byte, []int) {
-	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *Issue) GetIssueId() string {
-	if x != nil {
-		return x.IssueId
-	}
-	return ""
-}
-
-func (x *Issue) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *Issue) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ErrorBody.ProtoReflect.Descriptor instead.
+func (*ErrorBody) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *Issue) GetTransactionId() string {
+func (x *ErrorBody) GetHttpStatus() int32 {
 	if x != nil {
-		return x.TransactionId
+		return x.HttpStatus
 	}
-	return ""
+	return 0
 }
 
-func (x *Issue) GetCategory() string {
+func (x *ErrorBody) GetCode() string {
 	if x != nil {
-		return x.Category
+		return x.Code
 	}
 	return ""
 }
 
-func (x *Issue) GetSubCategory() string {
+func (x *ErrorBody) GetMessage() string {
 	if x != nil {
-		return x.SubCategory
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Issue) GetIssueType() string {
+func (x *ErrorBody) GetFieldViolations() []*ErrorBody_FieldViolation {
 	if x != nil {
-		return x.IssueType
+		return x.FieldViolations
 	}
-	return ""
+	return nil
 }
 
-func (x *Issue) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type ErrorBody_FieldViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Issue) GetDescriptionShort() string {
-	if x != nil {
-		return x.DescriptionShort
-	}
-	return ""
+func (x *ErrorBody_FieldViolation) Reset() {
+	*x = ErrorBody_FieldViolation{}
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Issue) GetDescriptionLong() string {
-	if x != nil {
-		return x.DescriptionLong
-	}
-	return ""
+func (x *ErrorBody_FieldViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Issue) GetImageUrls() []string {
-	if x != nil {
-		return x.ImageUrls
-	}
-	return nil
-}
+func (*ErrorBody_FieldViolation) ProtoMessage() {}
 
-func (x *Issue) GetBppId() string {
+func (x *ErrorBody_FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v1_issue_proto_msgTypes[105]
 	if x != nil {
-		return x.BppId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Issue) GetBppUri() string {
-	if x != nil {
-		return x.BppUri
-	}
-	return ""
+// Deprecated: Use ErrorBody_FieldViolation.ProtoReflect.Descriptor instead.
+func (*ErrorBody_FieldViolation) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v1_issue_proto_rawDescGZIP(), []int{102, 0}
 }
 
-func (x *Issue) GetCreatedAt() string {
+func (x *ErrorBody_FieldViolation) GetField() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Field
 	}
 	return ""
 }
 
-func (x *Issue) GetUpdatedAt() string {
+func (x *ErrorBody_FieldViolation) GetDescription() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Description
 	}
 	return ""
 }
@@ -2326,27 +7453,35 @@ var File_api_proto_igm_v1_issue_proto protoreflect.FileDescriptor
 
 const file_api_proto_igm_v1_issue_proto_rawDesc = "" +
 	"\n" +
-	"\x1capi/proto/igm/v1/issue.proto\x12\x06igm.v1\"\x83\x03\n" +
-	"\x12CreateIssueRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\x12!\n" +
-	"\fsub_category\x18\x04 \x01(\tR\vsubCategory\x12\x1d\n" +
+	"\x1capi/proto/igm/v1/issue.proto\x12\x06igm.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xa6\x04\n" +
+	"\x12CreateIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\border_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x12#\n" +
+	"\bcategory\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bcategory\x12,\n" +
+	"\fsub_category\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18 R\vsubCategory\x12&\n" +
 	"\n" +
-	"issue_type\x18\x05 \x01(\tR\tissueType\x12 \n" +
-	"\vdescription\x18\x06 \x01(\tR\vdescription\x12)\n" +
-	"\x10long_description\x18\a \x01(\tR\x0flongDescription\x12\x1d\n" +
+	"issue_type\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\tissueType\x12,\n" +
+	"\vdescription\x18\x06 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x123\n" +
+	"\x10long_description\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x0flongDescription\x12.\n" +
 	"\n" +
-	"image_urls\x18\b \x03(\tR\timageUrls\x12F\n" +
-	"\x0fadditional_desc\x18\t \x01(\v2\x1d.igm.v1.AdditionalDescriptionR\x0eadditionalDesc\x12'\n" +
+	"image_urls\x18\b \x03(\tB\x0f\xbaH\f\x92\x01\t\x10\n" +
+	"\"\x05r\x03\x88\x01\x01R\timageUrls\x12F\n" +
+	"\x0fadditional_desc\x18\t \x01(\v2\x1d.igm.v1.AdditionalDescriptionR\x0eadditionalDesc\x123\n" +
 	"\x05items\x18\n" +
-	" \x03(\v2\x11.igm.v1.IssueItemR\x05items\"L\n" +
-	"\x15AdditionalDescription\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url\x12!\n" +
-	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\"7\n" +
-	"\tIssueItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xe9\x01\n" +
+	" \x03(\v2\x11.igm.v1.IssueItemB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\x05items\x128\n" +
+	"\x0eattachment_ids\x18\v \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\n" +
+	"\x18\x01\"\x05r\x03\xb0\x01\x01R\rattachmentIds\"\x95\x01\n" +
+	"\x15AdditionalDescription\x12\x1d\n" +
+	"\x03url\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\x88\x01\x01R\x03url\x12+\n" +
+	"\fcontent_type\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x01R\vcontentType\x120\n" +
+	"\rattachment_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\fattachmentId\"L\n" +
+	"\tIssueItem\x12\x1a\n" +
+	"\x02id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x02id\x12#\n" +
+	"\bquantity\x18\x02 \x01(\x05B\a\xbaH\x04\x1a\x02 \x00R\bquantity\"\xe9\x01\n" +
 	"\x13CreateIssueResponse\x12\x19\n" +
 	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x16\n" +
@@ -2355,53 +7490,489 @@ const file_api_proto_igm_v1_issue_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1b\n" +
 	"\tondc_sent\x18\x06 \x01(\bR\bondcSent\x12!\n" +
-	"\fondc_message\x18\a \x01(\tR\vondcMessage\"\xdd\x01\n" +
-	"\x12UpdateIssueRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x19\n" +
-	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x1d\n" +
+	"\fondc_message\x18\a \x01(\tR\vondcMessage\"\x95\x02\n" +
+	"\x12UpdateIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12%\n" +
+	"\border_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x12\x1d\n" +
 	"\n" +
-	"issue_type\x18\x04 \x01(\tR\tissueType\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x12A\n" +
-	"\x1dcomplainant_action_short_desc\x18\x06 \x01(\tR\x1acomplainantActionShortDesc\"\xa7\x01\n" +
+	"issue_type\x18\x04 \x01(\tR\tissueType\x12\x1f\n" +
+	"\x06status\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06status\x12K\n" +
+	"\x1dcomplainant_action_short_desc\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x1acomplainantActionShortDesc\"\xa7\x01\n" +
 	"\x13UpdateIssueResponse\x12\x19\n" +
 	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1b\n" +
 	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
-	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xcb\x01\n" +
-	"\x11CloseIssueRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x19\n" +
-	"\border_id\x18\x03 \x01(\tR\aorderId\x12\x16\n" +
-	"\x06rating\x18\x04 \x01(\tR\x06rating\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\x127\n" +
-	"\x18complaint_act_short_desc\x18\x06 \x01(\tR\x15complaintActShortDesc\"\xa4\x01\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\x8c\x02\n" +
+	"\x11CloseIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12%\n" +
+	"\border_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x12\x1f\n" +
+	"\x06rating\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06rating\x12\x1f\n" +
+	"\x06status\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06status\x12A\n" +
+	"\x18complaint_act_short_desc\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x15complaintActShortDesc\"\xa4\x01\n" +
 	"\x12CloseIssueResponse\x12\x19\n" +
 	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
 	"\tclosed_at\x18\x03 \x01(\tR\bclosedAt\x12\x1b\n" +
 	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
-	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"E\n" +
-	"\x0fGetIssueRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\bissue_id\x18\x02 \x01(\tR\aissueId\"7\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xb0\x01\n" +
+	"\x17AcceptResolutionRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12\x1f\n" +
+	"\x06rating\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06rating\x12'\n" +
+	"\n" +
+	"short_desc\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tshortDesc\"\xaa\x01\n" +
+	"\x18AcceptResolutionResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tclosed_at\x18\x03 \x01(\tR\bclosedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xbe\x01\n" +
+	"\x17RejectResolutionRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06reason\x122\n" +
+	"\x15escalate_to_grievance\x18\x04 \x01(\bR\x13escalateToGrievance\"\xcb\x01\n" +
+	"\x18RejectResolutionResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"issue_type\x18\x03 \x01(\tR\tissueType\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x05 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x06 \x01(\tR\vondcMessage\"\xbd\x01\n" +
+	"\vOdrProvider\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"short_desc\x18\x03 \x01(\tR\tshortDesc\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12#\n" +
+	"\rpricing_model\x18\x05 \x01(\tR\fpricingModel\x124\n" +
+	"\x16proposed_by_respondent\x18\x06 \x01(\bR\x14proposedByRespondent\"f\n" +
+	"\x17ListOdrProvidersRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\"M\n" +
+	"\x18ListOdrProvidersResponse\x121\n" +
+	"\tproviders\x18\x01 \x03(\v2\x13.igm.v1.OdrProviderR\tproviders\"\xa6\x01\n" +
+	"\x10SelectOdrRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12!\n" +
+	"\x06odr_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05odrId\x12\"\n" +
+	"\x06reason\x18\x04 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06reason\"\xd3\x01\n" +
+	"\x11SelectOdrResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x1d\n" +
+	"\n" +
+	"issue_type\x18\x02 \x01(\tR\tissueType\x12%\n" +
+	"\x03odr\x18\x03 \x01(\v2\x13.igm.v1.OdrProviderR\x03odr\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x05 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x06 \x01(\tR\vondcMessage\"\x9c\x02\n" +
+	"\vInfoMessage\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"short_desc\x18\x03 \x01(\tR\tshortDesc\x12\x1b\n" +
+	"\tlong_desc\x18\x04 \x01(\tR\blongDesc\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\x05 \x03(\tR\timageUrls\x12F\n" +
+	"\x0fadditional_desc\x18\x06 \x01(\v2\x1d.igm.v1.AdditionalDescriptionR\x0eadditionalDesc\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\a \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xea\x02\n" +
+	"\x17ProvideIssueInfoRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12)\n" +
+	"\n" +
+	"short_desc\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\tshortDesc\x12%\n" +
+	"\tlong_desc\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\blongDesc\x12.\n" +
+	"\n" +
+	"image_urls\x18\x05 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10\n" +
+	"\"\x05r\x03\x88\x01\x01R\timageUrls\x12F\n" +
+	"\x0fadditional_desc\x18\x06 \x01(\v2\x1d.igm.v1.AdditionalDescriptionR\x0eadditionalDesc\x128\n" +
+	"\x0eattachment_ids\x18\a \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\n" +
+	"\x18\x01\"\x05r\x03\xb0\x01\x01R\rattachmentIds\"\xc3\x01\n" +
+	"\x18ProvideIssueInfoResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12-\n" +
+	"\amessage\x18\x02 \x01(\v2\x13.igm.v1.InfoMessageR\amessage\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"h\n" +
+	"\x19GetIssueInfoThreadRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\"\x8d\x01\n" +
+	"\x1aGetIssueInfoThreadResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12#\n" +
+	"\rawaiting_info\x18\x02 \x01(\bR\fawaitingInfo\x12/\n" +
+	"\bmessages\x18\x03 \x03(\v2\x13.igm.v1.InfoMessageR\bmessages\"\xa0\x03\n" +
+	"\x17NotificationPreferences\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12\x1f\n" +
+	"\vsms_enabled\x18\x02 \x01(\bR\n" +
+	"smsEnabled\x12#\n" +
+	"\remail_enabled\x18\x03 \x01(\bR\femailEnabled\x12!\n" +
+	"\fpush_enabled\x18\x04 \x01(\bR\vpushEnabled\x12'\n" +
+	"\n" +
+	"push_token\x18\x05 \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\tpushToken\x12U\n" +
+	"\x11quiet_hours_start\x18\x06 \x01(\tB)\xbaH&\xd8\x01\x01r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\x0fquietHoursStart\x12Q\n" +
+	"\x0fquiet_hours_end\x18\a \x01(\tB)\xbaH&\xd8\x01\x01r!2\x1f^([01][0-9]|2[0-3]):[0-5][0-9]$R\rquietHoursEnd\x12#\n" +
+	"\btimezone\x18\b \x01(\tB\a\xbaH\x04r\x02\x18@R\btimezone\"I\n" +
+	"!GetNotificationPreferencesRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\"q\n" +
+	"$UpdateNotificationPreferencesRequest\x12I\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1f.igm.v1.NotificationPreferencesB\x06\xbaH\x03\xc8\x01\x01R\vpreferences\"\xd3\x02\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x12\x1c\n" +
+	"\trecipient\x18\x06 \x01(\tR\trecipient\x12\x18\n" +
+	"\asubject\x18\a \x01(\tR\asubject\x12\x12\n" +
+	"\x04body\x18\b \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"send_after\x18\v \x01(\tR\tsendAfter\x12\x17\n" +
+	"\asent_at\x18\f \x01(\tR\x06sentAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"\x87\x01\n" +
+	"\x18ListNotificationsRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12#\n" +
+	"\bissue_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aissueId\x12 \n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\x05limit\"W\n" +
+	"\x19ListNotificationsResponse\x12:\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x14.igm.v1.NotificationR\rnotifications\"\xd7\x02\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x04 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x05 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06active\x18\x06 \x01(\bR\x06active\x121\n" +
+	"\x14consecutive_failures\x18\a \x01(\x05R\x13consecutiveFailures\x12\x1f\n" +
+	"\vdisabled_at\x18\b \x01(\tR\n" +
+	"disabledAt\x12'\n" +
+	"\x0fdisabled_reason\x18\t \x01(\tR\x0edisabledReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xbe\x01\n" +
+	" CreateWebhookSubscriptionRequest\x12\x1e\n" +
+	"\x04name\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04name\x12\x1d\n" +
+	"\x03url\x18\x02 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x124\n" +
+	"\vevent_types\x18\x03 \x03(\tB\x13\xbaH\x10\x92\x01\r\x102\x18\x01\"\ar\x05\x10\x01\x18\x80\x01R\n" +
+	"eventTypes\x12%\n" +
+	"\x06secret\x18\x04 \x01(\tB\r\xbaH\n" +
+	"\xd8\x01\x01r\x05\x10\x10\x18\x80\x02R\x06secret\"9\n" +
+	"\x1dGetWebhookSubscriptionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"B\n" +
+	"\x1fListWebhookSubscriptionsRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"e\n" +
+	" ListWebhookSubscriptionsResponse\x12A\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1b.igm.v1.WebhookSubscriptionR\rsubscriptions\"\xee\x01\n" +
+	" UpdateWebhookSubscriptionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\x04name\x12\x1d\n" +
+	"\x03url\x18\x03 \x01(\tB\v\xbaH\br\x06\x18\x80\x10\x88\x01\x01R\x03url\x124\n" +
+	"\vevent_types\x18\x04 \x03(\tB\x13\xbaH\x10\x92\x01\r\x102\x18\x01\"\ar\x05\x10\x01\x18\x80\x01R\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12#\n" +
+	"\rrotate_secret\x18\x06 \x01(\bR\frotateSecret\"<\n" +
+	" DeleteWebhookSubscriptionRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"M\n" +
+	"!DeleteWebhookSubscriptionResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adeleted\x18\x02 \x01(\bR\adeleted\"\xeb\x02\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12(\n" +
+	"\x10last_status_code\x18\a \x01(\x05R\x0elastStatusCode\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12&\n" +
+	"\x0fnext_attempt_at\x18\t \x01(\tR\rnextAttemptAt\x12!\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\tR\vdeliveredAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\x8b\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x121\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0esubscriptionId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12 \n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xc8\x01(\x00R\x05limit\"X\n" +
+	"\x1dListWebhookDeliveriesResponse\x127\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x17.igm.v1.WebhookDeliveryR\n" +
+	"deliveries\"\x8a\x01\n" +
+	"\x17UploadAttachmentRequest\x128\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x1a.igm.v1.AttachmentMetadataH\x00R\bmetadata\x12#\n" +
+	"\x05chunk\x18\x02 \x01(\fB\v\xbaH\bz\x06\x10\x01\x18\x80\x80@H\x00R\x05chunkB\x10\n" +
+	"\apayload\x12\x05\xbaH\x02\b\x01\"\xba\x01\n" +
+	"\x12AttachmentMetadata\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12'\n" +
+	"\tfile_name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\bfileName\x12-\n" +
+	"\fcontent_type\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x01R\vcontentType\x12&\n" +
+	"\n" +
+	"size_bytes\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02 \x00R\tsizeBytes\"\xae\x02\n" +
+	"\n" +
+	"Attachment\x12#\n" +
+	"\rattachment_id\x18\x01 \x01(\tR\fattachmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12$\n" +
+	"\x0eurl_expires_at\x18\t \x01(\tR\furlExpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"k\n" +
+	"\x14GetAttachmentRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12-\n" +
+	"\rattachment_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fattachmentId\"^\n" +
+	"\x0fGetIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\"7\n" +
 	"\x10GetIssueResponse\x12#\n" +
-	"\x05issue\x18\x01 \x01(\v2\r.igm.v1.IssueR\x05issue\"\\\n" +
-	"\x10ListIssueRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"M\n" +
-	"\x17ListIssueByOrderRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\tR\aorderId\"\x8c\x01\n" +
+	"\x05issue\x18\x01 \x01(\v2\r.igm.v1.IssueR\x05issue\"\x91\x01\n" +
+	"\x17GetIssueTimelineRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12)\n" +
+	"\x10include_internal\x18\x03 \x01(\bR\x0fincludeInternal\"|\n" +
+	"\rTimelineActor\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x120\n" +
+	"\n" +
+	"updated_by\x18\x02 \x01(\v2\x11.igm.v1.UpdatedByR\tupdatedBy\x12%\n" +
+	"\x0ecascaded_level\x18\x03 \x01(\x05R\rcascadedLevel\"\x86\x02\n" +
+	"\rTimelineEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"short_desc\x18\x03 \x01(\tR\tshortDesc\x12\x0e\n" +
+	"\x02at\x18\x04 \x01(\tR\x02at\x12+\n" +
+	"\x05actor\x18\x05 \x01(\v2\x15.igm.v1.TimelineActorR\x05actor\x122\n" +
+	"\n" +
+	"resolution\x18\x06 \x01(\v2\x12.igm.v1.ResolutionR\n" +
+	"resolution\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\a \x01(\tR\tmessageId\x12\x1a\n" +
+	"\binternal\x18\b \x01(\bR\binternal\"d\n" +
+	"\x18GetIssueTimelineResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12-\n" +
+	"\x06events\x18\x02 \x03(\v2\x15.igm.v1.TimelineEventR\x06events\"\x83\x01\n" +
+	"\x11WatchIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12!\n" +
+	"\fafter_cursor\x18\x03 \x01(\tR\vafterCursor\"a\n" +
+	"\x16WatchUserIssuesRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12!\n" +
+	"\fafter_cursor\x18\x02 \x01(\tR\vafterCursor\"\x81\x04\n" +
+	"\n" +
+	"IssueEvent\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp\x12G\n" +
+	"\x12respondent_actions\x18\a \x03(\v2\x18.igm.v1.RespondentActionR\x11respondentActions\x12K\n" +
+	"\x13resolution_provider\x18\b \x01(\v2\x1a.igm.v1.ResolutionProviderR\x12resolutionProvider\x122\n" +
+	"\n" +
+	"resolution\x18\t \x01(\v2\x12.igm.v1.ResolutionR\n" +
+	"resolution\x12B\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2\".igm.v1.IssueEvent.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x04\n" +
+	"\x10ListIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\x04page\x18\x02 \x01(\x05B\t\xbaH\x04\x1a\x02(\x00\x18\x01R\x04page\x12&\n" +
+	"\tpage_size\x18\x03 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"issue_type\x18\x06 \x01(\tR\tissueType\x12#\n" +
+	"\border_id\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aorderId\x12!\n" +
+	"\fcreated_from\x18\b \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\t \x01(\tR\tcreatedTo\x12*\n" +
+	"\x0ehas_resolution\x18\n" +
+	" \x01(\bH\x00R\rhasResolution\x88\x01\x01\x128\n" +
+	"\asort_by\x18\v \x01(\tB\x1f\xbaH\x1cr\x1aR\x00R\n" +
+	"created_atR\n" +
+	"updated_atR\x06sortBy\x121\n" +
+	"\n" +
+	"sort_order\x18\f \x01(\tB\x12\xbaH\x0fr\rR\x00R\x03ASCR\x04DESCR\tsortOrder\x12 \n" +
+	"\x06cursor\x18\r \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\x06cursorB\x11\n" +
+	"\x0f_has_resolution\"f\n" +
+	"\x17ListIssueByOrderRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\border_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\"\xad\x01\n" +
 	"\x11ListIssueResponse\x12%\n" +
 	"\x06issues\x18\x01 \x03(\v2\r.igm.v1.IssueR\x06issues\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xad\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\"\x9a\x03\n" +
+	"\x12IssueSearchRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\x05query\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x04R\x05query\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12\x1d\n" +
+	"\n" +
+	"issue_type\x18\x05 \x01(\tR\tissueType\x12#\n" +
+	"\border_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aorderId\x12!\n" +
+	"\fcreated_from\x18\a \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\b \x01(\tR\tcreatedTo\x12*\n" +
+	"\x0ehas_resolution\x18\t \x01(\bH\x00R\rhasResolution\x88\x01\x01\x12\x1b\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\v \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSizeB\x11\n" +
+	"\x0f_has_resolution\"c\n" +
+	"\x0eIssueSearchHit\x12#\n" +
+	"\x05issue\x18\x01 \x01(\v2\r.igm.v1.IssueR\x05issue\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"\x93\x01\n" +
+	"\x13IssueSearchResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.igm.v1.IssueSearchHitR\x04hits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xab\x02\n" +
+	"\x13SearchIssuesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x15\n" +
+	"\x06bpp_id\x18\x02 \x01(\tR\x05bppId\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12!\n" +
+	"\fcreated_from\x18\x04 \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\x05 \x01(\tR\tcreatedTo\x12!\n" +
+	"\fsla_breached\x18\x06 \x01(\bR\vslaBreached\x12\x1f\n" +
+	"\vassigned_to\x18\a \x01(\tR\n" +
+	"assignedTo\x12\x1b\n" +
+	"\x04page\x18\b \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\t \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\"\x96\x01\n" +
+	"\x14SearchIssuesResponse\x12,\n" +
+	"\x06issues\x18\x01 \x03(\v2\x14.igm.v1.SupportIssueR\x06issues\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"u\n" +
+	"\fSupportIssue\x12#\n" +
+	"\x05issue\x18\x01 \x01(\v2\r.igm.v1.IssueR\x05issue\x12\x1f\n" +
+	"\vassigned_to\x18\x02 \x01(\tR\n" +
+	"assignedTo\x12\x1f\n" +
+	"\vassigned_at\x18\x03 \x01(\tR\n" +
+	"assignedAt\"?\n" +
+	"\x16GetIssueDetailsRequest\x12%\n" +
+	"\bissue_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\"\x97\x01\n" +
+	"\vRawCallback\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"message_id\x18\x02 \x01(\tR\tmessageId\x12!\n" +
+	"\fpayload_json\x18\x03 \x01(\tR\vpayloadJson\x12\x1f\n" +
+	"\vreceived_at\x18\x04 \x01(\tR\n" +
+	"receivedAt\"\x84\x01\n" +
+	"\fInternalNote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xc0\x02\n" +
+	"\x11SupportAuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bissue_id\x18\x02 \x01(\tR\aissueId\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x04 \x01(\tR\tactorRole\x12\x16\n" +
+	"\x06action\x18\x05 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12@\n" +
+	"\adetails\x18\a \x03(\v2&.igm.v1.SupportAuditEntry.DetailsEntryR\adetails\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x84\x02\n" +
+	"\fIssueDetails\x12*\n" +
+	"\x05issue\x18\x01 \x01(\v2\x14.igm.v1.SupportIssueR\x05issue\x121\n" +
+	"\btimeline\x18\x02 \x03(\v2\x15.igm.v1.TimelineEventR\btimeline\x121\n" +
+	"\tcallbacks\x18\x03 \x03(\v2\x13.igm.v1.RawCallbackR\tcallbacks\x12*\n" +
+	"\x05notes\x18\x04 \x03(\v2\x14.igm.v1.InternalNoteR\x05notes\x126\n" +
+	"\taudit_log\x18\x05 \x03(\v2\x19.igm.v1.SupportAuditEntryR\bauditLog\"_\n" +
+	"\x16AddInternalNoteRequest\x12%\n" +
+	"\bissue_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12\x1e\n" +
+	"\x04body\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80 R\x04body\"\x85\x01\n" +
+	"\x14ReassignIssueRequest\x12%\n" +
+	"\bissue_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12$\n" +
+	"\bassignee\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\bassignee\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\bR\x06reason\"C\n" +
+	"\x15ReassignIssueResponse\x12*\n" +
+	"\x05issue\x18\x01 \x01(\v2\x14.igm.v1.SupportIssueR\x05issue\"\x85\x01\n" +
+	"\x17ForceIssueStatusRequest\x12%\n" +
+	"\bissue_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12\x1f\n" +
+	"\x06status\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\x06status\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\bR\x06reason\"F\n" +
+	"\x18ForceIssueStatusResponse\x12*\n" +
+	"\x05issue\x18\x01 \x01(\v2\x14.igm.v1.SupportIssueR\x05issue\"o\n" +
+	"\x1aListSupportAuditLogRequest\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12 \n" +
+	"\x05limit\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xf4\x03(\x00R\x05limit\"R\n" +
+	"\x1bListSupportAuditLogResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.igm.v1.SupportAuditEntryR\aentries\"\xad\x01\n" +
 	"\aContext\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x16\n" +
 	"\x06action\x18\x02 \x01(\tR\x06action\x12\x15\n" +
@@ -2444,10 +8015,32 @@ const file_api_proto_igm_v1_issue_proto_rawDesc = "" +
 	"\fOrganization\x12\x1d\n" +
 	"\x03org\x18\x01 \x01(\v2\v.igm.v1.OrgR\x03org\x12&\n" +
 	"\x06person\x18\x02 \x01(\v2\x0e.igm.v1.PersonR\x06person\x12)\n" +
-	"\acontact\x18\x03 \x01(\v2\x0f.igm.v1.ContactR\acontact\"f\n" +
+	"\acontact\x18\x03 \x01(\v2\x0f.igm.v1.ContactR\acontact\"9\n" +
+	"\x05Price\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"V\n" +
+	"\fPricingModel\x12#\n" +
+	"\x05price\x18\x01 \x01(\v2\r.igm.v1.PriceR\x05price\x12!\n" +
+	"\fpricing_info\x18\x02 \x01(\tR\vpricingInfo\"\x8d\x01\n" +
+	"\vSelectedOdr\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"short_desc\x18\x02 \x01(\tR\tshortDesc\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x129\n" +
+	"\rpricing_model\x18\x04 \x01(\v2\x14.igm.v1.PricingModelR\fpricingModel\"s\n" +
+	"\x03Gro\x12&\n" +
+	"\x06person\x18\x01 \x01(\v2\x0e.igm.v1.PersonR\x06person\x12)\n" +
+	"\acontact\x18\x02 \x01(\v2\x0f.igm.v1.ContactR\acontact\x12\x19\n" +
+	"\bgro_type\x18\x03 \x01(\tR\agroType\"\xb6\x01\n" +
+	"\x11ResolutionSupport\x12\x1b\n" +
+	"\tchat_link\x18\x01 \x01(\tR\bchatLink\x12)\n" +
+	"\acontact\x18\x02 \x01(\v2\x0f.igm.v1.ContactR\acontact\x128\n" +
+	"\rselected_odrs\x18\x03 \x03(\v2\x13.igm.v1.SelectedOdrR\fselectedOdrs\x12\x1f\n" +
+	"\x04gros\x18\x04 \x03(\v2\v.igm.v1.GroR\x04gros\"\xb0\x01\n" +
 	"\x16ResolutionProviderInfo\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x128\n" +
-	"\forganization\x18\x02 \x01(\v2\x14.igm.v1.OrganizationR\forganization\"]\n" +
+	"\forganization\x18\x02 \x01(\v2\x14.igm.v1.OrganizationR\forganization\x12H\n" +
+	"\x12resolution_support\x18\x03 \x01(\v2\x19.igm.v1.ResolutionSupportR\x11resolutionSupport\"]\n" +
 	"\x12ResolutionProvider\x12G\n" +
 	"\x0frespondent_info\x18\x01 \x01(\v2\x1e.igm.v1.ResolutionProviderInfoR\x0erespondentInfo\"\x98\x01\n" +
 	"\n" +
@@ -2503,7 +8096,7 @@ const file_api_proto_igm_v1_issue_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1b\n" +
 	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
-	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xd8\x03\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xfd\f\n" +
 	"\x05Issue\x12\x19\n" +
 	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
@@ -2524,19 +8117,112 @@ const file_api_proto_igm_v1_issue_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAt2\x99\x05\n" +
-	"\fIssueService\x12F\n" +
-	"\vCreateIssue\x12\x1a.igm.v1.CreateIssueRequest\x1a\x1b.igm.v1.CreateIssueResponse\x12F\n" +
-	"\vUpdateIssue\x12\x1a.igm.v1.UpdateIssueRequest\x1a\x1b.igm.v1.UpdateIssueResponse\x12C\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12%\n" +
+	"\x0ecascaded_level\x18\x10 \x01(\x05R\rcascadedLevel\x12F\n" +
+	"\x12current_respondent\x18\x11 \x01(\v2\x17.igm.v1.RespondentPartyR\x11currentRespondent\x12B\n" +
+	"\x10respondent_chain\x18\x12 \x03(\v2\x17.igm.v1.RespondentPartyR\x0frespondentChain\x12+\n" +
+	"\x11respondent_status\x18\x13 \x01(\tR\x10respondentStatus\x12\x16\n" +
+	"\x06rating\x18\x14 \x01(\tR\x06rating\x122\n" +
 	"\n" +
-	"CloseIssue\x12\x19.igm.v1.CloseIssueRequest\x1a\x1a.igm.v1.CloseIssueResponse\x12=\n" +
-	"\bGetIssue\x12\x17.igm.v1.GetIssueRequest\x1a\x18.igm.v1.GetIssueResponse\x12A\n" +
+	"resolution\x18\x15 \x01(\v2\x12.igm.v1.ResolutionR\n" +
+	"resolution\x12K\n" +
+	"\x13resolution_provider\x18\x16 \x01(\v2\x1a.igm.v1.ResolutionProviderR\x12resolutionProvider\x12J\n" +
+	"\x13complainant_actions\x18\x17 \x03(\v2\x19.igm.v1.ComplainantActionR\x12complainantActions\x12G\n" +
+	"\x12respondent_actions\x18\x18 \x03(\v2\x18.igm.v1.RespondentActionR\x11respondentActions\x12\x1d\n" +
+	"\x03gro\x18\x19 \x01(\v2\v.igm.v1.GroR\x03gro\x12F\n" +
+	"\x0fadditional_desc\x18\x1a \x01(\v2\x1d.igm.v1.AdditionalDescriptionR\x0eadditionalDesc\x12B\n" +
+	"\x10complainant_info\x18\x1b \x01(\v2\x17.igm.v1.ComplainantInfoR\x0fcomplainantInfo\x129\n" +
+	"\rorder_details\x18\x1c \x01(\v2\x14.igm.v1.OrderDetailsR\forderDetails\x124\n" +
+	"\x16expected_response_time\x18\x1d \x01(\tR\x14expectedResponseTime\x128\n" +
+	"\x18expected_resolution_time\x18\x1e \x01(\tR\x16expectedResolutionTime\x12\x1d\n" +
 	"\n" +
-	"ListIssues\x12\x18.igm.v1.ListIssueRequest\x1a\x19.igm.v1.ListIssueResponse\x12N\n" +
-	"\x10ListIssueByOrder\x12\x1f.igm.v1.ListIssueByOrderRequest\x1a\x19.igm.v1.ListIssueResponse\x12L\n" +
-	"\x11HandleIssueStatus\x12\x1a.igm.v1.IssueStatusRequest\x1a\x1b.igm.v1.IssueStatusResponse\x12@\n" +
-	"\rHandleOnIssue\x12\x16.igm.v1.OnIssueRequest\x1a\x17.igm.v1.OnIssueResponse\x12R\n" +
-	"\x13HandleOnIssueStatus\x12\x1c.igm.v1.OnIssueStatusRequest\x1a\x1d.igm.v1.OnIssueStatusResponseB/Z-github/effimove/igm-svc/api/proto/igm/v1;igmbb\x06proto3"
+	"respond_by\x18\x1f \x01(\tR\trespondBy\x12\x1d\n" +
+	"\n" +
+	"resolve_by\x18  \x01(\tR\tresolveBy\x120\n" +
+	"\x14response_breached_at\x18! \x01(\tR\x12responseBreachedAt\x124\n" +
+	"\x16resolution_breached_at\x18\" \x01(\tR\x14resolutionBreachedAt\x12\x1f\n" +
+	"\vresolved_at\x18# \x01(\tR\n" +
+	"resolvedAt\x12$\n" +
+	"\x0eauto_closed_at\x18$ \x01(\tR\fautoClosedAt\x12&\n" +
+	"\x0fodr_provider_id\x18% \x01(\tR\rodrProviderId\x12*\n" +
+	"\x11dispute_raised_at\x18& \x01(\tR\x0fdisputeRaisedAt\"d\n" +
+	"\x0fComplainantInfo\x12&\n" +
+	"\x06person\x18\x01 \x01(\v2\x0e.igm.v1.PersonR\x06person\x12)\n" +
+	"\acontact\x18\x02 \x01(\v2\x0f.igm.v1.ContactR\acontact\"h\n" +
+	"\fOrderDetails\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vprovider_id\x18\x02 \x01(\tR\n" +
+	"providerId\x12'\n" +
+	"\x05items\x18\x03 \x03(\v2\x11.igm.v1.IssueItemR\x05items\"\xd1\x01\n" +
+	"\x0fRespondentParty\x12%\n" +
+	"\x0ecascaded_level\x18\x01 \x01(\x05R\rcascadedLevel\x128\n" +
+	"\forganization\x18\x02 \x01(\v2\x14.igm.v1.OrganizationR\forganization\x12\x1d\n" +
+	"\x03gro\x18\x03 \x01(\v2\v.igm.v1.GroR\x03gro\x12\x1f\n" +
+	"\vlast_action\x18\x04 \x01(\tR\n" +
+	"lastAction\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\"\xf1\x01\n" +
+	"\tErrorBody\x12\x1f\n" +
+	"\vhttp_status\x18\x01 \x01(\x05R\n" +
+	"httpStatus\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12K\n" +
+	"\x10field_violations\x18\x04 \x03(\v2 .igm.v1.ErrorBody.FieldViolationR\x0ffieldViolations\x1aH\n" +
+	"\x0eFieldViolation\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription2\xec\x1c\n" +
+	"\fIssueService\x12]\n" +
+	"\vCreateIssue\x12\x1a.igm.v1.CreateIssueRequest\x1a\x1b.igm.v1.CreateIssueResponse\"\x15\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/issues\x12h\n" +
+	"\vUpdateIssue\x12\x1a.igm.v1.UpdateIssueRequest\x1a\x1b.igm.v1.UpdateIssueResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v1/issues/{issue_id}\x12k\n" +
+	"\n" +
+	"CloseIssue\x12\x19.igm.v1.CloseIssueRequest\x1a\x1a.igm.v1.CloseIssueResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/issues/{issue_id}:close\x12\x88\x01\n" +
+	"\x10AcceptResolution\x12\x1f.igm.v1.AcceptResolutionRequest\x1a .igm.v1.AcceptResolutionResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/issues/{issue_id}:acceptResolution\x12\x88\x01\n" +
+	"\x10RejectResolution\x12\x1f.igm.v1.RejectResolutionRequest\x1a .igm.v1.RejectResolutionResponse\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/issues/{issue_id}:rejectResolution\x12\x82\x01\n" +
+	"\x10ListOdrProviders\x12\x1f.igm.v1.ListOdrProvidersRequest\x1a .igm.v1.ListOdrProvidersResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/issues/{issue_id}/odr-providers\x12l\n" +
+	"\tSelectOdr\x12\x18.igm.v1.SelectOdrRequest\x1a\x19.igm.v1.SelectOdrResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/issues/{issue_id}:selectOdr\x12|\n" +
+	"\x10ProvideIssueInfo\x12\x1f.igm.v1.ProvideIssueInfoRequest\x1a .igm.v1.ProvideIssueInfoResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/issues/{issue_id}/info\x12\x7f\n" +
+	"\x12GetIssueInfoThread\x12!.igm.v1.GetIssueInfoThreadRequest\x1a\".igm.v1.GetIssueInfoThreadResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/issues/{issue_id}/info\x12\x8e\x01\n" +
+	"\x1aGetNotificationPreferences\x12).igm.v1.GetNotificationPreferencesRequest\x1a\x1f.igm.v1.NotificationPreferences\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/notification-preferences\x12\xa1\x01\n" +
+	"\x1dUpdateNotificationPreferences\x12,.igm.v1.UpdateNotificationPreferencesRequest\x1a\x1f.igm.v1.NotificationPreferences\"1\x82\xd3\xe4\x93\x02+:\vpreferences\x1a\x1c/v1/notification-preferences\x12s\n" +
+	"\x11ListNotifications\x12 .igm.v1.ListNotificationsRequest\x1a!.igm.v1.ListNotificationsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/notifications\x12\x88\x01\n" +
+	"\x19CreateWebhookSubscription\x12(.igm.v1.CreateWebhookSubscriptionRequest\x1a\x1b.igm.v1.WebhookSubscription\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/webhook-subscriptions\x12\x84\x01\n" +
+	"\x16GetWebhookSubscription\x12%.igm.v1.GetWebhookSubscriptionRequest\x1a\x1b.igm.v1.WebhookSubscription\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/webhook-subscriptions/{id}\x12\x90\x01\n" +
+	"\x18ListWebhookSubscriptions\x12'.igm.v1.ListWebhookSubscriptionsRequest\x1a(.igm.v1.ListWebhookSubscriptionsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/webhook-subscriptions\x12\x8d\x01\n" +
+	"\x19UpdateWebhookSubscription\x12(.igm.v1.UpdateWebhookSubscriptionRequest\x1a\x1b.igm.v1.WebhookSubscription\")\x82\xd3\xe4\x93\x02#:\x01*2\x1e/v1/webhook-subscriptions/{id}\x12\x98\x01\n" +
+	"\x19DeleteWebhookSubscription\x12(.igm.v1.DeleteWebhookSubscriptionRequest\x1a).igm.v1.DeleteWebhookSubscriptionResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/webhook-subscriptions/{id}\x12\xa4\x01\n" +
+	"\x15ListWebhookDeliveries\x12$.igm.v1.ListWebhookDeliveriesRequest\x1a%.igm.v1.ListWebhookDeliveriesResponse\">\x82\xd3\xe4\x93\x028\x126/v1/webhook-subscriptions/{subscription_id}/deliveries\x12e\n" +
+	"\x10UploadAttachment\x12\x1f.igm.v1.UploadAttachmentRequest\x1a\x12.igm.v1.Attachment\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/attachments(\x01\x12j\n" +
+	"\rGetAttachment\x12\x1c.igm.v1.GetAttachmentRequest\x1a\x12.igm.v1.Attachment\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/attachments/{attachment_id}\x12\\\n" +
+	"\bGetIssue\x12\x17.igm.v1.GetIssueRequest\x1a\x18.igm.v1.GetIssueResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/issues/{issue_id}\x12}\n" +
+	"\x10GetIssueTimeline\x12\x1f.igm.v1.GetIssueTimelineRequest\x1a .igm.v1.GetIssueTimelineResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/issues/{issue_id}/timeline\x12b\n" +
+	"\n" +
+	"WatchIssue\x12\x19.igm.v1.WatchIssueRequest\x1a\x12.igm.v1.IssueEvent\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/issues/{issue_id}:watch0\x01\x12a\n" +
+	"\x0fWatchUserIssues\x12\x1e.igm.v1.WatchUserIssuesRequest\x1a\x12.igm.v1.IssueEvent\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/issues:watch0\x01\x12U\n" +
+	"\n" +
+	"ListIssues\x12\x18.igm.v1.ListIssueRequest\x1a\x19.igm.v1.ListIssueResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/issues\x12t\n" +
+	"\x10ListIssueByOrder\x12\x1f.igm.v1.ListIssueByOrderRequest\x1a\x19.igm.v1.ListIssueResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/orders/{order_id}/issues\x12b\n" +
+	"\fSearchIssues\x12\x1a.igm.v1.IssueSearchRequest\x1a\x1b.igm.v1.IssueSearchResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/issues:search\x12|\n" +
+	"\x11HandleIssueStatus\x12\x1a.igm.v1.IssueStatusRequest\x1a\x1b.igm.v1.IssueStatusResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/issues/{issue_id}:refreshStatus\x12^\n" +
+	"\rHandleOnIssue\x12\x16.igm.v1.OnIssueRequest\x1a\x17.igm.v1.OnIssueResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/ondc/on_issue\x12w\n" +
+	"\x13HandleOnIssueStatus\x12\x1c.igm.v1.OnIssueStatusRequest\x1a\x1d.igm.v1.OnIssueStatusResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/ondc/on_issue_status2\xf2\x03\n" +
+	"\x0eSupportService\x12I\n" +
+	"\fSearchIssues\x12\x1b.igm.v1.SearchIssuesRequest\x1a\x1c.igm.v1.SearchIssuesResponse\x12G\n" +
+	"\x0fGetIssueDetails\x12\x1e.igm.v1.GetIssueDetailsRequest\x1a\x14.igm.v1.IssueDetails\x12G\n" +
+	"\x0fAddInternalNote\x12\x1e.igm.v1.AddInternalNoteRequest\x1a\x14.igm.v1.InternalNote\x12L\n" +
+	"\rReassignIssue\x12\x1c.igm.v1.ReassignIssueRequest\x1a\x1d.igm.v1.ReassignIssueResponse\x12U\n" +
+	"\x10ForceIssueStatus\x12\x1f.igm.v1.ForceIssueStatusRequest\x1a .igm.v1.ForceIssueStatusResponse\x12^\n" +
+	"\x13ListSupportAuditLog\x12\".igm.v1.ListSupportAuditLogRequest\x1a#.igm.v1.ListSupportAuditLogResponseB\xb4\x02\x92A\x91\x02\x12\x82\x01\n" +
+	"\vIGM Service\x12nHTTP/JSON gateway for igm.v1.IssueService and igm.v2.IssueService. Errors use the body described by ErrorBody.2\x031.02\x10application/json:\x10application/jsonR)\n" +
+	"\adefault\x12\x1e\n" +
+	"\x05Error\x12\x15\n" +
+	"\x13\x1a\x11.igm.v1.ErrorBodyZ-\n" +
+	"+\n" +
+	"\x06bearer\x12!\b\x02\x12\fBearer <JWT>\x1a\rAuthorization \x02b\f\n" +
+	"\n" +
+	"\n" +
+	"\x06bearer\x12\x00Z\x1digm-svc/api/proto/igm/v1;igmbb\x06proto3"
 
 var (
 	file_api_proto_igm_v1_issue_proto_rawDescOnce sync.Once
@@ -2550,90 +8236,272 @@ func file_api_proto_igm_v1_issue_proto_rawDescGZIP() []byte {
 	return file_api_proto_igm_v1_issue_proto_rawDescData
 }
 
-var file_api_proto_igm_v1_issue_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_api_proto_igm_v1_issue_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_api_proto_igm_v1_issue_proto_goTypes = []any{
-	(*CreateIssueRequest)(nil),      // 0: igm.v1.CreateIssueRequest
-	(*AdditionalDescription)(nil),   // 1: igm.v1.AdditionalDescription
-	(*IssueItem)(nil),               // 2: igm.v1.IssueItem
-	(*CreateIssueResponse)(nil),     // 3: igm.v1.CreateIssueResponse
-	(*UpdateIssueRequest)(nil),      // 4: igm.v1.UpdateIssueRequest
-	(*UpdateIssueResponse)(nil),     // 5: igm.v1.UpdateIssueResponse
-	(*CloseIssueRequest)(nil),       // 6: igm.v1.CloseIssueRequest
-	(*CloseIssueResponse)(nil),      // 7: igm.v1.CloseIssueResponse
-	(*GetIssueRequest)(nil),         // 8: igm.v1.GetIssueRequest
-	(*GetIssueResponse)(nil),        // 9: igm.v1.GetIssueResponse
-	(*ListIssueRequest)(nil),        // 10: igm.v1.ListIssueRequest
-	(*ListIssueByOrderRequest)(nil), // 11: igm.v1.ListIssueByOrderRequest
-	(*ListIssueResponse)(nil),       // 12: igm.v1.ListIssueResponse
-	(*Context)(nil),                 // 13: igm.v1.Context
-	(*Org)(nil),                     // 14: igm.v1.Org
-	(*Contact)(nil),                 // 15: igm.v1.Contact
-	(*Person)(nil),                  // 16: igm.v1.Person
-	(*UpdatedBy)(nil),               // 17: igm.v1.UpdatedBy
-	(*RespondentAction)(nil),        // 18: igm.v1.RespondentAction
-	(*ComplainantAction)(nil),       // 19: igm.v1.ComplainantAction
-	(*IssueActions)(nil),            // 20: igm.v1.IssueActions
-	(*Organization)(nil),            // 21: igm.v1.Organization
-	(*ResolutionProviderInfo)(nil),  // 22: igm.v1.ResolutionProviderInfo
-	(*ResolutionProvider)(nil),      // 23: igm.v1.ResolutionProvider
-	(*Resolution)(nil),              // 24: igm.v1.Resolution
-	(*IncomingIssue)(nil),           // 25: igm.v1.IncomingIssue
-	(*OnIssuePayload)(nil),          // 26: igm.v1.OnIssuePayload
-	(*OnIssueRequest)(nil),          // 27: igm.v1.OnIssueRequest
-	(*OnIssueResponse)(nil),         // 28: igm.v1.OnIssueResponse
-	(*OnIssueStatusRequest)(nil),    // 29: igm.v1.OnIssueStatusRequest
-	(*OnIssueStatusResponse)(nil),   // 30: igm.v1.OnIssueStatusResponse
-	(*IssueStatusRequest)(nil),      // 31: igm.v1.IssueStatusRequest
-	(*IssueStatusResponse)(nil),     // 32: igm.v1.IssueStatusResponse
-	(*Issue)(nil),                   // 33: igm.v1.Issue
+	(*CreateIssueRequest)(nil),                   // 0: igm.v1.CreateIssueRequest
+	(*AdditionalDescription)(nil),                // 1: igm.v1.AdditionalDescription
+	(*IssueItem)(nil),                            // 2: igm.v1.IssueItem
+	(*CreateIssueResponse)(nil),                  // 3: igm.v1.CreateIssueResponse
+	(*UpdateIssueRequest)(nil),                   // 4: igm.v1.UpdateIssueRequest
+	(*UpdateIssueResponse)(nil),                  // 5: igm.v1.UpdateIssueResponse
+	(*CloseIssueRequest)(nil),                    // 6: igm.v1.CloseIssueRequest
+	(*CloseIssueResponse)(nil),                   // 7: igm.v1.CloseIssueResponse
+	(*AcceptResolutionRequest)(nil),              // 8: igm.v1.AcceptResolutionRequest
+	(*AcceptResolutionResponse)(nil),             // 9: igm.v1.AcceptResolutionResponse
+	(*RejectResolutionRequest)(nil),              // 10: igm.v1.RejectResolutionRequest
+	(*RejectResolutionResponse)(nil),             // 11: igm.v1.RejectResolutionResponse
+	(*OdrProvider)(nil),                          // 12: igm.v1.OdrProvider
+	(*ListOdrProvidersRequest)(nil),              // 13: igm.v1.ListOdrProvidersRequest
+	(*ListOdrProvidersResponse)(nil),             // 14: igm.v1.ListOdrProvidersResponse
+	(*SelectOdrRequest)(nil),                     // 15: igm.v1.SelectOdrRequest
+	(*SelectOdrResponse)(nil),                    // 16: igm.v1.SelectOdrResponse
+	(*InfoMessage)(nil),                          // 17: igm.v1.InfoMessage
+	(*ProvideIssueInfoRequest)(nil),              // 18: igm.v1.ProvideIssueInfoRequest
+	(*ProvideIssueInfoResponse)(nil),             // 19: igm.v1.ProvideIssueInfoResponse
+	(*GetIssueInfoThreadRequest)(nil),            // 20: igm.v1.GetIssueInfoThreadRequest
+	(*GetIssueInfoThreadResponse)(nil),           // 21: igm.v1.GetIssueInfoThreadResponse
+	(*NotificationPreferences)(nil),              // 22: igm.v1.NotificationPreferences
+	(*GetNotificationPreferencesRequest)(nil),    // 23: igm.v1.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferencesRequest)(nil), // 24: igm.v1.UpdateNotificationPreferencesRequest
+	(*Notification)(nil),                         // 25: igm.v1.Notification
+	(*ListNotificationsRequest)(nil),             // 26: igm.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),            // 27: igm.v1.ListNotificationsResponse
+	(*WebhookSubscription)(nil),                  // 28: igm.v1.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),     // 29: igm.v1.CreateWebhookSubscriptionRequest
+	(*GetWebhookSubscriptionRequest)(nil),        // 30: igm.v1.GetWebhookSubscriptionRequest
+	(*ListWebhookSubscriptionsRequest)(nil),      // 31: igm.v1.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),     // 32: igm.v1.ListWebhookSubscriptionsResponse
+	(*UpdateWebhookSubscriptionRequest)(nil),     // 33: igm.v1.UpdateWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionRequest)(nil),     // 34: igm.v1.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil),    // 35: igm.v1.DeleteWebhookSubscriptionResponse
+	(*WebhookDelivery)(nil),                      // 36: igm.v1.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),         // 37: igm.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),        // 38: igm.v1.ListWebhookDeliveriesResponse
+	(*UploadAttachmentRequest)(nil),              // 39: igm.v1.UploadAttachmentRequest
+	(*AttachmentMetadata)(nil),                   // 40: igm.v1.AttachmentMetadata
+	(*Attachment)(nil),                           // 41: igm.v1.Attachment
+	(*GetAttachmentRequest)(nil),                 // 42: igm.v1.GetAttachmentRequest
+	(*GetIssueRequest)(nil),                      // 43: igm.v1.GetIssueRequest
+	(*GetIssueResponse)(nil),                     // 44: igm.v1.GetIssueResponse
+	(*GetIssueTimelineRequest)(nil),              // 45: igm.v1.GetIssueTimelineRequest
+	(*TimelineActor)(nil),                        // 46: igm.v1.TimelineActor
+	(*TimelineEvent)(nil),                        // 47: igm.v1.TimelineEvent
+	(*GetIssueTimelineResponse)(nil),             // 48: igm.v1.GetIssueTimelineResponse
+	(*WatchIssueRequest)(nil),                    // 49: igm.v1.WatchIssueRequest
+	(*WatchUserIssuesRequest)(nil),               // 50: igm.v1.WatchUserIssuesRequest
+	(*IssueEvent)(nil),                           // 51: igm.v1.IssueEvent
+	(*ListIssueRequest)(nil),                     // 52: igm.v1.ListIssueRequest
+	(*ListIssueByOrderRequest)(nil),              // 53: igm.v1.ListIssueByOrderRequest
+	(*ListIssueResponse)(nil),                    // 54: igm.v1.ListIssueResponse
+	(*IssueSearchRequest)(nil),                   // 55: igm.v1.IssueSearchRequest
+	(*IssueSearchHit)(nil),                       // 56: igm.v1.IssueSearchHit
+	(*IssueSearchResponse)(nil),                  // 57: igm.v1.IssueSearchResponse
+	(*SearchIssuesRequest)(nil),                  // 58: igm.v1.SearchIssuesRequest
+	(*SearchIssuesResponse)(nil),                 // 59: igm.v1.SearchIssuesResponse
+	(*SupportIssue)(nil),                         // 60: igm.v1.SupportIssue
+	(*GetIssueDetailsRequest)(nil),               // 61: igm.v1.GetIssueDetailsRequest
+	(*RawCallback)(nil),                          // 62: igm.v1.RawCallback
+	(*InternalNote)(nil),                         // 63: igm.v1.InternalNote
+	(*SupportAuditEntry)(nil),                    // 64: igm.v1.SupportAuditEntry
+	(*IssueDetails)(nil),                         // 65: igm.v1.IssueDetails
+	(*AddInternalNoteRequest)(nil),               // 66: igm.v1.AddInternalNoteRequest
+	(*ReassignIssueRequest)(nil),                 // 67: igm.v1.ReassignIssueRequest
+	(*ReassignIssueResponse)(nil),                // 68: igm.v1.ReassignIssueResponse
+	(*ForceIssueStatusRequest)(nil),              // 69: igm.v1.ForceIssueStatusRequest
+	(*ForceIssueStatusResponse)(nil),             // 70: igm.v1.ForceIssueStatusResponse
+	(*ListSupportAuditLogRequest)(nil),           // 71: igm.v1.ListSupportAuditLogRequest
+	(*ListSupportAuditLogResponse)(nil),          // 72: igm.v1.ListSupportAuditLogResponse
+	(*Context)(nil),                              // 73: igm.v1.Context
+	(*Org)(nil),                                  // 74: igm.v1.Org
+	(*Contact)(nil),                              // 75: igm.v1.Contact
+	(*Person)(nil),                               // 76: igm.v1.Person
+	(*UpdatedBy)(nil),                            // 77: igm.v1.UpdatedBy
+	(*RespondentAction)(nil),                     // 78: igm.v1.RespondentAction
+	(*ComplainantAction)(nil),                    // 79: igm.v1.ComplainantAction
+	(*IssueActions)(nil),                         // 80: igm.v1.IssueActions
+	(*Organization)(nil),                         // 81: igm.v1.Organization
+	(*Price)(nil),                                // 82: igm.v1.Price
+	(*PricingModel)(nil),                         // 83: igm.v1.PricingModel
+	(*SelectedOdr)(nil),                          // 84: igm.v1.SelectedOdr
+	(*Gro)(nil),                                  // 85: igm.v1.Gro
+	(*ResolutionSupport)(nil),                    // 86: igm.v1.ResolutionSupport
+	(*ResolutionProviderInfo)(nil),               // 87: igm.v1.ResolutionProviderInfo
+	(*ResolutionProvider)(nil),                   // 88: igm.v1.ResolutionProvider
+	(*Resolution)(nil),                           // 89: igm.v1.Resolution
+	(*IncomingIssue)(nil),                        // 90: igm.v1.IncomingIssue
+	(*OnIssuePayload)(nil),                       // 91: igm.v1.OnIssuePayload
+	(*OnIssueRequest)(nil),                       // 92: igm.v1.OnIssueRequest
+	(*OnIssueResponse)(nil),                      // 93: igm.v1.OnIssueResponse
+	(*OnIssueStatusRequest)(nil),                 // 94: igm.v1.OnIssueStatusRequest
+	(*OnIssueStatusResponse)(nil),                // 95: igm.v1.OnIssueStatusResponse
+	(*IssueStatusRequest)(nil),                   // 96: igm.v1.IssueStatusRequest
+	(*IssueStatusResponse)(nil),                  // 97: igm.v1.IssueStatusResponse
+	(*Issue)(nil),                                // 98: igm.v1.Issue
+	(*ComplainantInfo)(nil),                      // 99: igm.v1.ComplainantInfo
+	(*OrderDetails)(nil),                         // 100: igm.v1.OrderDetails
+	(*RespondentParty)(nil),                      // 101: igm.v1.RespondentParty
+	(*ErrorBody)(nil),                            // 102: igm.v1.ErrorBody
+	nil,                                          // 103: igm.v1.IssueEvent.AttributesEntry
+	nil,                                          // 104: igm.v1.SupportAuditEntry.DetailsEntry
+	(*ErrorBody_FieldViolation)(nil),             // 105: igm.v1.ErrorBody.FieldViolation
 }
 var file_api_proto_igm_v1_issue_proto_depIdxs = []int32{
-	1,  // 0: igm.v1.CreateIssueRequest.additional_desc:type_name -> igm.v1.AdditionalDescription
-	2,  // 1: igm.v1.CreateIssueRequest.items:type_name -> igm.v1.IssueItem
-	33, // 2: igm.v1.GetIssueResponse.issue:type_name -> igm.v1.Issue
-	33, // 3: igm.v1.ListIssueResponse.issues:type_name -> igm.v1.Issue
-	14, // 4: igm.v1.UpdatedBy.org:type_name -> igm.v1.Org
-	15, // 5: igm.v1.UpdatedBy.contact:type_name -> igm.v1.Contact
-	16, // 6: igm.v1.UpdatedBy.person:type_name -> igm.v1.Person
-	17, // 7: igm.v1.RespondentAction.updated_by:type_name -> igm.v1.UpdatedBy
-	17, // 8: igm.v1.ComplainantAction.updated_by:type_name -> igm.v1.UpdatedBy
-	19, // 9: igm.v1.IssueActions.complainant_actions:type_name -> igm.v1.ComplainantAction
-	18, // 10: igm.v1.IssueActions.respondent_actions:type_name -> igm.v1.RespondentAction
-	14, // 11: igm.v1.Organization.org:type_name -> igm.v1.Org
-	16, // 12: igm.v1.Organization.person:type_name -> igm.v1.Person
-	15, // 13: igm.v1.Organization.contact:type_name -> igm.v1.Contact
-	21, // 14: igm.v1.ResolutionProviderInfo.organization:type_name -> igm.v1.Organization
-	22, // 15: igm.v1.ResolutionProvider.respondent_info:type_name -> igm.v1.ResolutionProviderInfo
-	20, // 16: igm.v1.IncomingIssue.issue_actions:type_name -> igm.v1.IssueActions
-	23, // 17: igm.v1.IncomingIssue.resolution_provider:type_name -> igm.v1.ResolutionProvider
-	24, // 18: igm.v1.IncomingIssue.resolution:type_name -> igm.v1.Resolution
-	13, // 19: igm.v1.OnIssuePayload.context:type_name -> igm.v1.Context
-	25, // 20: igm.v1.OnIssuePayload.issue:type_name -> igm.v1.IncomingIssue
-	26, // 21: igm.v1.OnIssueRequest.payload:type_name -> igm.v1.OnIssuePayload
-	26, // 22: igm.v1.OnIssueStatusRequest.payload:type_name -> igm.v1.OnIssuePayload
-	0,  // 23: igm.v1.IssueService.CreateIssue:input_type -> igm.v1.CreateIssueRequest
-	4,  // 24: igm.v1.IssueService.UpdateIssue:input_type -> igm.v1.UpdateIssueRequest
-	6,  // 25: igm.v1.IssueService.CloseIssue:input_type -> igm.v1.CloseIssueRequest
-	8,  // 26: igm.v1.IssueService.GetIssue:input_type -> igm.v1.GetIssueRequest
-	10, // 27: igm.v1.IssueService.ListIssues:input_type -> igm.v1.ListIssueRequest
-	11, // 28: igm.v1.IssueService.ListIssueByOrder:input_type -> igm.v1.ListIssueByOrderRequest
-	31, // 29: igm.v1.IssueService.HandleIssueStatus:input_type -> igm.v1.IssueStatusRequest
-	27, // 30: igm.v1.IssueService.HandleOnIssue:input_type -> igm.v1.OnIssueRequest
-	29, // 31: igm.v1.IssueService.HandleOnIssueStatus:input_type -> igm.v1.OnIssueStatusRequest
-	3,  // 32: igm.v1.IssueService.CreateIssue:output_type -> igm.v1.CreateIssueResponse
-	5,  // 33: igm.v1.IssueService.UpdateIssue:output_type -> igm.v1.UpdateIssueResponse
-	7,  // 34: igm.v1.IssueService.CloseIssue:output_type -> igm.v1.CloseIssueResponse
-	9,  // 35: igm.v1.IssueService.GetIssue:output_type -> igm.v1.GetIssueResponse
-	12, // 36: igm.v1.IssueService.ListIssues:output_type -> igm.v1.ListIssueResponse
-	12, // 37: igm.v1.IssueService.ListIssueByOrder:output_type -> igm.v1.ListIssueResponse
-	32, // 38: igm.v1.IssueService.HandleIssueStatus:output_type -> igm.v1.IssueStatusResponse
-	28, // 39: igm.v1.IssueService.HandleOnIssue:output_type -> igm.v1.OnIssueResponse
-	30, // 40: igm.v1.IssueService.HandleOnIssueStatus:output_type -> igm.v1.OnIssueStatusResponse
-	32, // [32:41] is the sub-list for method output_type
-	23, // [23:32] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	1,   // 0: igm.v1.CreateIssueRequest.additional_desc:type_name -> igm.v1.AdditionalDescription
+	2,   // 1: igm.v1.CreateIssueRequest.items:type_name -> igm.v1.IssueItem
+	12,  // 2: igm.v1.ListOdrProvidersResponse.providers:type_name -> igm.v1.OdrProvider
+	12,  // 3: igm.v1.SelectOdrResponse.odr:type_name -> igm.v1.OdrProvider
+	1,   // 4: igm.v1.InfoMessage.additional_desc:type_name -> igm.v1.AdditionalDescription
+	1,   // 5: igm.v1.ProvideIssueInfoRequest.additional_desc:type_name -> igm.v1.AdditionalDescription
+	17,  // 6: igm.v1.ProvideIssueInfoResponse.message:type_name -> igm.v1.InfoMessage
+	17,  // 7: igm.v1.GetIssueInfoThreadResponse.messages:type_name -> igm.v1.InfoMessage
+	22,  // 8: igm.v1.UpdateNotificationPreferencesRequest.preferences:type_name -> igm.v1.NotificationPreferences
+	25,  // 9: igm.v1.ListNotificationsResponse.notifications:type_name -> igm.v1.Notification
+	28,  // 10: igm.v1.ListWebhookSubscriptionsResponse.subscriptions:type_name -> igm.v1.WebhookSubscription
+	36,  // 11: igm.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> igm.v1.WebhookDelivery
+	40,  // 12: igm.v1.UploadAttachmentRequest.metadata:type_name -> igm.v1.AttachmentMetadata
+	98,  // 13: igm.v1.GetIssueResponse.issue:type_name -> igm.v1.Issue
+	77,  // 14: igm.v1.TimelineActor.updated_by:type_name -> igm.v1.UpdatedBy
+	46,  // 15: igm.v1.TimelineEvent.actor:type_name -> igm.v1.TimelineActor
+	89,  // 16: igm.v1.TimelineEvent.resolution:type_name -> igm.v1.Resolution
+	47,  // 17: igm.v1.GetIssueTimelineResponse.events:type_name -> igm.v1.TimelineEvent
+	78,  // 18: igm.v1.IssueEvent.respondent_actions:type_name -> igm.v1.RespondentAction
+	88,  // 19: igm.v1.IssueEvent.resolution_provider:type_name -> igm.v1.ResolutionProvider
+	89,  // 20: igm.v1.IssueEvent.resolution:type_name -> igm.v1.Resolution
+	103, // 21: igm.v1.IssueEvent.attributes:type_name -> igm.v1.IssueEvent.AttributesEntry
+	98,  // 22: igm.v1.ListIssueResponse.issues:type_name -> igm.v1.Issue
+	98,  // 23: igm.v1.IssueSearchHit.issue:type_name -> igm.v1.Issue
+	56,  // 24: igm.v1.IssueSearchResponse.hits:type_name -> igm.v1.IssueSearchHit
+	60,  // 25: igm.v1.SearchIssuesResponse.issues:type_name -> igm.v1.SupportIssue
+	98,  // 26: igm.v1.SupportIssue.issue:type_name -> igm.v1.Issue
+	104, // 27: igm.v1.SupportAuditEntry.details:type_name -> igm.v1.SupportAuditEntry.DetailsEntry
+	60,  // 28: igm.v1.IssueDetails.issue:type_name -> igm.v1.SupportIssue
+	47,  // 29: igm.v1.IssueDetails.timeline:type_name -> igm.v1.TimelineEvent
+	62,  // 30: igm.v1.IssueDetails.callbacks:type_name -> igm.v1.RawCallback
+	63,  // 31: igm.v1.IssueDetails.notes:type_name -> igm.v1.InternalNote
+	64,  // 32: igm.v1.IssueDetails.audit_log:type_name -> igm.v1.SupportAuditEntry
+	60,  // 33: igm.v1.ReassignIssueResponse.issue:type_name -> igm.v1.SupportIssue
+	60,  // 34: igm.v1.ForceIssueStatusResponse.issue:type_name -> igm.v1.SupportIssue
+	64,  // 35: igm.v1.ListSupportAuditLogResponse.entries:type_name -> igm.v1.SupportAuditEntry
+	74,  // 36: igm.v1.UpdatedBy.org:type_name -> igm.v1.Org
+	75,  // 37: igm.v1.UpdatedBy.contact:type_name -> igm.v1.Contact
+	76,  // 38: igm.v1.UpdatedBy.person:type_name -> igm.v1.Person
+	77,  // 39: igm.v1.RespondentAction.updated_by:type_name -> igm.v1.UpdatedBy
+	77,  // 40: igm.v1.ComplainantAction.updated_by:type_name -> igm.v1.UpdatedBy
+	79,  // 41: igm.v1.IssueActions.complainant_actions:type_name -> igm.v1.ComplainantAction
+	78,  // 42: igm.v1.IssueActions.respondent_actions:type_name -> igm.v1.RespondentAction
+	74,  // 43: igm.v1.Organization.org:type_name -> igm.v1.Org
+	76,  // 44: igm.v1.Organization.person:type_name -> igm.v1.Person
+	75,  // 45: igm.v1.Organization.contact:type_name -> igm.v1.Contact
+	82,  // 46: igm.v1.PricingModel.price:type_name -> igm.v1.Price
+	83,  // 47: igm.v1.SelectedOdr.pricing_model:type_name -> igm.v1.PricingModel
+	76,  // 48: igm.v1.Gro.person:type_name -> igm.v1.Person
+	75,  // 49: igm.v1.Gro.contact:type_name -> igm.v1.Contact
+	75,  // 50: igm.v1.ResolutionSupport.contact:type_name -> igm.v1.Contact
+	84,  // 51: igm.v1.ResolutionSupport.selected_odrs:type_name -> igm.v1.SelectedOdr
+	85,  // 52: igm.v1.ResolutionSupport.gros:type_name -> igm.v1.Gro
+	81,  // 53: igm.v1.ResolutionProviderInfo.organization:type_name -> igm.v1.Organization
+	86,  // 54: igm.v1.ResolutionProviderInfo.resolution_support:type_name -> igm.v1.ResolutionSupport
+	87,  // 55: igm.v1.ResolutionProvider.respondent_info:type_name -> igm.v1.ResolutionProviderInfo
+	80,  // 56: igm.v1.IncomingIssue.issue_actions:type_name -> igm.v1.IssueActions
+	88,  // 57: igm.v1.IncomingIssue.resolution_provider:type_name -> igm.v1.ResolutionProvider
+	89,  // 58: igm.v1.IncomingIssue.resolution:type_name -> igm.v1.Resolution
+	73,  // 59: igm.v1.OnIssuePayload.context:type_name -> igm.v1.Context
+	90,  // 60: igm.v1.OnIssuePayload.issue:type_name -> igm.v1.IncomingIssue
+	91,  // 61: igm.v1.OnIssueRequest.payload:type_name -> igm.v1.OnIssuePayload
+	91,  // 62: igm.v1.OnIssueStatusRequest.payload:type_name -> igm.v1.OnIssuePayload
+	101, // 63: igm.v1.Issue.current_respondent:type_name -> igm.v1.RespondentParty
+	101, // 64: igm.v1.Issue.respondent_chain:type_name -> igm.v1.RespondentParty
+	89,  // 65: igm.v1.Issue.resolution:type_name -> igm.v1.Resolution
+	88,  // 66: igm.v1.Issue.resolution_provider:type_name -> igm.v1.ResolutionProvider
+	79,  // 67: igm.v1.Issue.complainant_actions:type_name -> igm.v1.ComplainantAction
+	78,  // 68: igm.v1.Issue.respondent_actions:type_name -> igm.v1.RespondentAction
+	85,  // 69: igm.v1.Issue.gro:type_name -> igm.v1.Gro
+	1,   // 70: igm.v1.Issue.additional_desc:type_name -> igm.v1.AdditionalDescription
+	99,  // 71: igm.v1.Issue.complainant_info:type_name -> igm.v1.ComplainantInfo
+	100, // 72: igm.v1.Issue.order_details:type_name -> igm.v1.OrderDetails
+	76,  // 73: igm.v1.ComplainantInfo.person:type_name -> igm.v1.Person
+	75,  // 74: igm.v1.ComplainantInfo.contact:type_name -> igm.v1.Contact
+	2,   // 75: igm.v1.OrderDetails.items:type_name -> igm.v1.IssueItem
+	81,  // 76: igm.v1.RespondentParty.organization:type_name -> igm.v1.Organization
+	85,  // 77: igm.v1.RespondentParty.gro:type_name -> igm.v1.Gro
+	105, // 78: igm.v1.ErrorBody.field_violations:type_name -> igm.v1.ErrorBody.FieldViolation
+	0,   // 79: igm.v1.IssueService.CreateIssue:input_type -> igm.v1.CreateIssueRequest
+	4,   // 80: igm.v1.IssueService.UpdateIssue:input_type -> igm.v1.UpdateIssueRequest
+	6,   // 81: igm.v1.IssueService.CloseIssue:input_type -> igm.v1.CloseIssueRequest
+	8,   // 82: igm.v1.IssueService.AcceptResolution:input_type -> igm.v1.AcceptResolutionRequest
+	10,  // 83: igm.v1.IssueService.RejectResolution:input_type -> igm.v1.RejectResolutionRequest
+	13,  // 84: igm.v1.IssueService.ListOdrProviders:input_type -> igm.v1.ListOdrProvidersRequest
+	15,  // 85: igm.v1.IssueService.SelectOdr:input_type -> igm.v1.SelectOdrRequest
+	18,  // 86: igm.v1.IssueService.ProvideIssueInfo:input_type -> igm.v1.ProvideIssueInfoRequest
+	20,  // 87: igm.v1.IssueService.GetIssueInfoThread:input_type -> igm.v1.GetIssueInfoThreadRequest
+	23,  // 88: igm.v1.IssueService.GetNotificationPreferences:input_type -> igm.v1.GetNotificationPreferencesRequest
+	24,  // 89: igm.v1.IssueService.UpdateNotificationPreferences:input_type -> igm.v1.UpdateNotificationPreferencesRequest
+	26,  // 90: igm.v1.IssueService.ListNotifications:input_type -> igm.v1.ListNotificationsRequest
+	29,  // 91: igm.v1.IssueService.CreateWebhookSubscription:input_type -> igm.v1.CreateWebhookSubscriptionRequest
+	30,  // 92: igm.v1.IssueService.GetWebhookSubscription:input_type -> igm.v1.GetWebhookSubscriptionRequest
+	31,  // 93: igm.v1.IssueService.ListWebhookSubscriptions:input_type -> igm.v1.ListWebhookSubscriptionsRequest
+	33,  // 94: igm.v1.IssueService.UpdateWebhookSubscription:input_type -> igm.v1.UpdateWebhookSubscriptionRequest
+	34,  // 95: igm.v1.IssueService.DeleteWebhookSubscription:input_type -> igm.v1.DeleteWebhookSubscriptionRequest
+	37,  // 96: igm.v1.IssueService.ListWebhookDeliveries:input_type -> igm.v1.ListWebhookDeliveriesRequest
+	39,  // 97: igm.v1.IssueService.UploadAttachment:input_type -> igm.v1.UploadAttachmentRequest
+	42,  // 98: igm.v1.IssueService.GetAttachment:input_type -> igm.v1.GetAttachmentRequest
+	43,  // 99: igm.v1.IssueService.GetIssue:input_type -> igm.v1.GetIssueRequest
+	45,  // 100: igm.v1.IssueService.GetIssueTimeline:input_type -> igm.v1.GetIssueTimelineRequest
+	49,  // 101: igm.v1.IssueService.WatchIssue:input_type -> igm.v1.WatchIssueRequest
+	50,  // 102: igm.v1.IssueService.WatchUserIssues:input_type -> igm.v1.WatchUserIssuesRequest
+	52,  // 103: igm.v1.IssueService.ListIssues:input_type -> igm.v1.ListIssueRequest
+	53,  // 104: igm.v1.IssueService.ListIssueByOrder:input_type -> igm.v1.ListIssueByOrderRequest
+	55,  // 105: igm.v1.IssueService.SearchIssues:input_type -> igm.v1.IssueSearchRequest
+	96,  // 106: igm.v1.IssueService.HandleIssueStatus:input_type -> igm.v1.IssueStatusRequest
+	92,  // 107: igm.v1.IssueService.HandleOnIssue:input_type -> igm.v1.OnIssueRequest
+	94,  // 108: igm.v1.IssueService.HandleOnIssueStatus:input_type -> igm.v1.OnIssueStatusRequest
+	58,  // 109: igm.v1.SupportService.SearchIssues:input_type -> igm.v1.SearchIssuesRequest
+	61,  // 110: igm.v1.SupportService.GetIssueDetails:input_type -> igm.v1.GetIssueDetailsRequest
+	66,  // 111: igm.v1.SupportService.AddInternalNote:input_type -> igm.v1.AddInternalNoteRequest
+	67,  // 112: igm.v1.SupportService.ReassignIssue:input_type -> igm.v1.ReassignIssueRequest
+	69,  // 113: igm.v1.SupportService.ForceIssueStatus:input_type -> igm.v1.ForceIssueStatusRequest
+	71,  // 114: igm.v1.SupportService.ListSupportAuditLog:input_type -> igm.v1.ListSupportAuditLogRequest
+	3,   // 115: igm.v1.IssueService.CreateIssue:output_type -> igm.v1.CreateIssueResponse
+	5,   // 116: igm.v1.IssueService.UpdateIssue:output_type -> igm.v1.UpdateIssueResponse
+	7,   // 117: igm.v1.IssueService.CloseIssue:output_type -> igm.v1.CloseIssueResponse
+	9,   // 118: igm.v1.IssueService.AcceptResolution:output_type -> igm.v1.AcceptResolutionResponse
+	11,  // 119: igm.v1.IssueService.RejectResolution:output_type -> igm.v1.RejectResolutionResponse
+	14,  // 120: igm.v1.IssueService.ListOdrProviders:output_type -> igm.v1.ListOdrProvidersResponse
+	16,  // 121: igm.v1.IssueService.SelectOdr:output_type -> igm.v1.SelectOdrResponse
+	19,  // 122: igm.v1.IssueService.ProvideIssueInfo:output_type -> igm.v1.ProvideIssueInfoResponse
+	21,  // 123: igm.v1.IssueService.GetIssueInfoThread:output_type -> igm.v1.GetIssueInfoThreadResponse
+	22,  // 124: igm.v1.IssueService.GetNotificationPreferences:output_type -> igm.v1.NotificationPreferences
+	22,  // 125: igm.v1.IssueService.UpdateNotificationPreferences:output_type -> igm.v1.NotificationPreferences
+	27,  // 126: igm.v1.IssueService.ListNotifications:output_type -> igm.v1.ListNotificationsResponse
+	28,  // 127: igm.v1.IssueService.CreateWebhookSubscription:output_type -> igm.v1.WebhookSubscription
+	28,  // 128: igm.v1.IssueService.GetWebhookSubscription:output_type -> igm.v1.WebhookSubscription
+	32,  // 129: igm.v1.IssueService.ListWebhookSubscriptions:output_type -> igm.v1.ListWebhookSubscriptionsResponse
+	28,  // 130: igm.v1.IssueService.UpdateWebhookSubscription:output_type -> igm.v1.WebhookSubscription
+	35,  // 131: igm.v1.IssueService.DeleteWebhookSubscription:output_type -> igm.v1.DeleteWebhookSubscriptionResponse
+	38,  // 132: igm.v1.IssueService.ListWebhookDeliveries:output_type -> igm.v1.ListWebhookDeliveriesResponse
+	41,  // 133: igm.v1.IssueService.UploadAttachment:output_type -> igm.v1.Attachment
+	41,  // 134: igm.v1.IssueService.GetAttachment:output_type -> igm.v1.Attachment
+	44,  // 135: igm.v1.IssueService.GetIssue:output_type -> igm.v1.GetIssueResponse
+	48,  // 136: igm.v1.IssueService.GetIssueTimeline:output_type -> igm.v1.GetIssueTimelineResponse
+	51,  // 137: igm.v1.IssueService.WatchIssue:output_type -> igm.v1.IssueEvent
+	51,  // 138: igm.v1.IssueService.WatchUserIssues:output_type -> igm.v1.IssueEvent
+	54,  // 139: igm.v1.IssueService.ListIssues:output_type -> igm.v1.ListIssueResponse
+	54,  // 140: igm.v1.IssueService.ListIssueByOrder:output_type -> igm.v1.ListIssueResponse
+	57,  // 141: igm.v1.IssueService.SearchIssues:output_type -> igm.v1.IssueSearchResponse
+	97,  // 142: igm.v1.IssueService.HandleIssueStatus:output_type -> igm.v1.IssueStatusResponse
+	93,  // 143: igm.v1.IssueService.HandleOnIssue:output_type -> igm.v1.OnIssueResponse
+	95,  // 144: igm.v1.IssueService.HandleOnIssueStatus:output_type -> igm.v1.OnIssueStatusResponse
+	59,  // 145: igm.v1.SupportService.SearchIssues:output_type -> igm.v1.SearchIssuesResponse
+	65,  // 146: igm.v1.SupportService.GetIssueDetails:output_type -> igm.v1.IssueDetails
+	63,  // 147: igm.v1.SupportService.AddInternalNote:output_type -> igm.v1.InternalNote
+	68,  // 148: igm.v1.SupportService.ReassignIssue:output_type -> igm.v1.ReassignIssueResponse
+	70,  // 149: igm.v1.SupportService.ForceIssueStatus:output_type -> igm.v1.ForceIssueStatusResponse
+	72,  // 150: igm.v1.SupportService.ListSupportAuditLog:output_type -> igm.v1.ListSupportAuditLogResponse
+	115, // [115:151] is the sub-list for method output_type
+	79,  // [79:115] is the sub-list for method input_type
+	79,  // [79:79] is the sub-list for extension type_name
+	79,  // [79:79] is the sub-list for extension extendee
+	0,   // [0:79] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_igm_v1_issue_proto_init() }
@@ -2641,15 +8509,21 @@ func file_api_proto_igm_v1_issue_proto_init() {
 	if File_api_proto_igm_v1_issue_proto != nil {
 		return
 	}
+	file_api_proto_igm_v1_issue_proto_msgTypes[39].OneofWrappers = []any{
+		(*UploadAttachmentRequest_Metadata)(nil),
+		(*UploadAttachmentRequest_Chunk)(nil),
+	}
+	file_api_proto_igm_v1_issue_proto_msgTypes[52].OneofWrappers = []any{}
+	file_api_proto_igm_v1_issue_proto_msgTypes[55].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_igm_v1_issue_proto_rawDesc), len(file_api_proto_igm_v1_issue_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   106,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_proto_igm_v1_issue_proto_goTypes,
 		DependencyIndexes: file_api_proto_igm_v1_issue_proto_depIdxs,
@@ -19,15 +19,36 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IssueService_CreateIssue_FullMethodName         = "/igm.v1.IssueService/CreateIssue"
-	IssueService_UpdateIssue_FullMethodName         = "/igm.v1.IssueService/UpdateIssue"
-	IssueService_CloseIssue_FullMethodName          = "/igm.v1.IssueService/CloseIssue"
-	IssueService_GetIssue_FullMethodName            = "/igm.v1.IssueService/GetIssue"
-	IssueService_ListIssues_FullMethodName          = "/igm.v1.IssueService/ListIssues"
-	IssueService_ListIssueByOrder_FullMethodName    = "/igm.v1.IssueService/ListIssueByOrder"
-	IssueService_HandleIssueStatus_FullMethodName   = "/igm.v1.IssueService/HandleIssueStatus"
-	IssueService_HandleOnIssue_FullMethodName       = "/igm.v1.IssueService/HandleOnIssue"
-	IssueService_HandleOnIssueStatus_FullMethodName = "/igm.v1.IssueService/HandleOnIssueStatus"
+	IssueService_CreateIssue_FullMethodName                   = "/igm.v1.IssueService/CreateIssue"
+	IssueService_UpdateIssue_FullMethodName                   = "/igm.v1.IssueService/UpdateIssue"
+	IssueService_CloseIssue_FullMethodName                    = "/igm.v1.IssueService/CloseIssue"
+	IssueService_AcceptResolution_FullMethodName              = "/igm.v1.IssueService/AcceptResolution"
+	IssueService_RejectResolution_FullMethodName              = "/igm.v1.IssueService/RejectResolution"
+	IssueService_ListOdrProviders_FullMethodName              = "/igm.v1.IssueService/ListOdrProviders"
+	IssueService_SelectOdr_FullMethodName                     = "/igm.v1.IssueService/SelectOdr"
+	IssueService_ProvideIssueInfo_FullMethodName              = "/igm.v1.IssueService/ProvideIssueInfo"
+	IssueService_GetIssueInfoThread_FullMethodName            = "/igm.v1.IssueService/GetIssueInfoThread"
+	IssueService_GetNotificationPreferences_FullMethodName    = "/igm.v1.IssueService/GetNotificationPreferences"
+	IssueService_UpdateNotificationPreferences_FullMethodName = "/igm.v1.IssueService/UpdateNotificationPreferences"
+	IssueService_ListNotifications_FullMethodName             = "/igm.v1.IssueService/ListNotifications"
+	IssueService_CreateWebhookSubscription_FullMethodName     = "/igm.v1.IssueService/CreateWebhookSubscription"
+	IssueService_GetWebhookSubscription_FullMethodName        = "/igm.v1.IssueService/GetWebhookSubscription"
+	IssueService_ListWebhookSubscriptions_FullMethodName      = "/igm.v1.IssueService/ListWebhookSubscriptions"
+	IssueService_UpdateWebhookSubscription_FullMethodName     = "/igm.v1.IssueService/UpdateWebhookSubscription"
+	IssueService_DeleteWebhookSubscription_FullMethodName     = "/igm.v1.IssueService/DeleteWebhookSubscription"
+	IssueService_ListWebhookDeliveries_FullMethodName         = "/igm.v1.IssueService/ListWebhookDeliveries"
+	IssueService_UploadAttachment_FullMethodName              = "/igm.v1.IssueService/UploadAttachment"
+	IssueService_GetAttachment_FullMethodName                 = "/igm.v1.IssueService/GetAttachment"
+	IssueService_GetIssue_FullMethodName                      = "/igm.v1.IssueService/GetIssue"
+	IssueService_GetIssueTimeline_FullMethodName              = "/igm.v1.IssueService/GetIssueTimeline"
+	IssueService_WatchIssue_FullMethodName                    = "/igm.v1.IssueService/WatchIssue"
+	IssueService_WatchUserIssues_FullMethodName               = "/igm.v1.IssueService/WatchUserIssues"
+	IssueService_ListIssues_FullMethodName                    = "/igm.v1.IssueService/ListIssues"
+	IssueService_ListIssueByOrder_FullMethodName              = "/igm.v1.IssueService/ListIssueByOrder"
+	IssueService_SearchIssues_FullMethodName                  = "/igm.v1.IssueService/SearchIssues"
+	IssueService_HandleIssueStatus_FullMethodName             = "/igm.v1.IssueService/HandleIssueStatus"
+	IssueService_HandleOnIssue_FullMethodName                 = "/igm.v1.IssueService/HandleOnIssue"
+	IssueService_HandleOnIssueStatus_FullMethodName           = "/igm.v1.IssueService/HandleOnIssueStatus"
 )
 
 // IssueServiceClient is the client API for IssueService service.
@@ -37,9 +58,34 @@ type IssueServiceClient interface {
 	CreateIssue(ctx context.Context, in *CreateIssueRequest, opts ...grpc.CallOption) (*CreateIssueResponse, error)
 	UpdateIssue(ctx context.Context, in *UpdateIssueRequest, opts ...grpc.CallOption) (*UpdateIssueResponse, error)
 	CloseIssue(ctx context.Context, in *CloseIssueRequest, opts ...grpc.CallOption) (*CloseIssueResponse, error)
+	AcceptResolution(ctx context.Context, in *AcceptResolutionRequest, opts ...grpc.CallOption) (*AcceptResolutionResponse, error)
+	RejectResolution(ctx context.Context, in *RejectResolutionRequest, opts ...grpc.CallOption) (*RejectResolutionResponse, error)
+	ListOdrProviders(ctx context.Context, in *ListOdrProvidersRequest, opts ...grpc.CallOption) (*ListOdrProvidersResponse, error)
+	SelectOdr(ctx context.Context, in *SelectOdrRequest, opts ...grpc.CallOption) (*SelectOdrResponse, error)
+	ProvideIssueInfo(ctx context.Context, in *ProvideIssueInfoRequest, opts ...grpc.CallOption) (*ProvideIssueInfoResponse, error)
+	GetIssueInfoThread(ctx context.Context, in *GetIssueInfoThreadRequest, opts ...grpc.CallOption) (*GetIssueInfoThreadResponse, error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	GetWebhookSubscription(ctx context.Context, in *GetWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	// UploadAttachment takes the metadata first, then the file in chunks.
+	// The attachment id goes into CreateIssue or ProvideIssueInfo, which
+	// send signed, expiring URLs for it to the BPP.
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error)
+	// GetAttachment returns the attachment with a freshly signed URL.
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error)
 	GetIssue(ctx context.Context, in *GetIssueRequest, opts ...grpc.CallOption) (*GetIssueResponse, error)
+	GetIssueTimeline(ctx context.Context, in *GetIssueTimelineRequest, opts ...grpc.CallOption) (*GetIssueTimelineResponse, error)
+	WatchIssue(ctx context.Context, in *WatchIssueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssueEvent], error)
+	WatchUserIssues(ctx context.Context, in *WatchUserIssuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssueEvent], error)
 	ListIssues(ctx context.Context, in *ListIssueRequest, opts ...grpc.CallOption) (*ListIssueResponse, error)
 	ListIssueByOrder(ctx context.Context, in *ListIssueByOrderRequest, opts ...grpc.CallOption) (*ListIssueResponse, error)
+	SearchIssues(ctx context.Context, in *IssueSearchRequest, opts ...grpc.CallOption) (*IssueSearchResponse, error)
 	HandleIssueStatus(ctx context.Context, in *IssueStatusRequest, opts ...grpc.CallOption) (*IssueStatusResponse, error)
 	HandleOnIssue(ctx context.Context, in *OnIssueRequest, opts ...grpc.CallOption) (*OnIssueResponse, error)
 	HandleOnIssueStatus(ctx context.Context, in *OnIssueStatusRequest, opts ...grpc.CallOption) (*OnIssueStatusResponse, error)
@@ -83,6 +129,179 @@ func (c *issueServiceClient) CloseIssue(ctx context.Context, in *CloseIssueReque
 	return out, nil
 }
 
+func (c *issueServiceClient) AcceptResolution(ctx context.Context, in *AcceptResolutionRequest, opts ...grpc.CallOption) (*AcceptResolutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptResolutionResponse)
+	err := c.cc.Invoke(ctx, IssueService_AcceptResolution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) RejectResolution(ctx context.Context, in *RejectResolutionRequest, opts ...grpc.CallOption) (*RejectResolutionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectResolutionResponse)
+	err := c.cc.Invoke(ctx, IssueService_RejectResolution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListOdrProviders(ctx context.Context, in *ListOdrProvidersRequest, opts ...grpc.CallOption) (*ListOdrProvidersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOdrProvidersResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListOdrProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) SelectOdr(ctx context.Context, in *SelectOdrRequest, opts ...grpc.CallOption) (*SelectOdrResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectOdrResponse)
+	err := c.cc.Invoke(ctx, IssueService_SelectOdr_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ProvideIssueInfo(ctx context.Context, in *ProvideIssueInfoRequest, opts ...grpc.CallOption) (*ProvideIssueInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProvideIssueInfoResponse)
+	err := c.cc.Invoke(ctx, IssueService_ProvideIssueInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetIssueInfoThread(ctx context.Context, in *GetIssueInfoThreadRequest, opts ...grpc.CallOption) (*GetIssueInfoThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueInfoThreadResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetIssueInfoThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, IssueService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, IssueService_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, IssueService_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetWebhookSubscription(ctx context.Context, in *GetWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, IssueService_GetWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UpdateWebhookSubscription(ctx context.Context, in *UpdateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, IssueService_UpdateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, IssueService_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssueService_ServiceDesc.Streams[0], IssueService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, Attachment]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, Attachment]
+
+func (c *issueServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*Attachment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Attachment)
+	err := c.cc.Invoke(ctx, IssueService_GetAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) GetIssue(ctx context.Context, in *GetIssueRequest, opts ...grpc.CallOption) (*GetIssueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetIssueResponse)
@@ -93,6 +312,54 @@ func (c *issueServiceClient) GetIssue(ctx context.Context, in *GetIssueRequest,
 	return out, nil
 }
 
+func (c *issueServiceClient) GetIssueTimeline(ctx context.Context, in *GetIssueTimelineRequest, opts ...grpc.CallOption) (*GetIssueTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueTimelineResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetIssueTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) WatchIssue(ctx context.Context, in *WatchIssueRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssueEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssueService_ServiceDesc.Streams[1], IssueService_WatchIssue_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchIssueRequest, IssueEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_WatchIssueClient = grpc.ServerStreamingClient[IssueEvent]
+
+func (c *issueServiceClient) WatchUserIssues(ctx context.Context, in *WatchUserIssuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IssueEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &IssueService_ServiceDesc.Streams[2], IssueService_WatchUserIssues_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchUserIssuesRequest, IssueEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_WatchUserIssuesClient = grpc.ServerStreamingClient[IssueEvent]
+
 func (c *issueServiceClient) ListIssues(ctx context.Context, in *ListIssueRequest, opts ...grpc.CallOption) (*ListIssueResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListIssueResponse)
@@ -113,6 +380,16 @@ func (c *issueServiceClient) ListIssueByOrder(ctx context.Context, in *ListIssue
 	return out, nil
 }
 
+func (c *issueServiceClient) SearchIssues(ctx context.Context, in *IssueSearchRequest, opts ...grpc.CallOption) (*IssueSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueSearchResponse)
+	err := c.cc.Invoke(ctx, IssueService_SearchIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) HandleIssueStatus(ctx context.Context, in *IssueStatusRequest, opts ...grpc.CallOption) (*IssueStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IssueStatusResponse)
@@ -150,9 +427,34 @@ type IssueServiceServer interface {
 	CreateIssue(context.Context, *CreateIssueRequest) (*CreateIssueResponse, error)
 	UpdateIssue(context.Context, *UpdateIssueRequest) (*UpdateIssueResponse, error)
 	CloseIssue(context.Context, *CloseIssueRequest) (*CloseIssueResponse, error)
+	AcceptResolution(context.Context, *AcceptResolutionRequest) (*AcceptResolutionResponse, error)
+	RejectResolution(context.Context, *RejectResolutionRequest) (*RejectResolutionResponse, error)
+	ListOdrProviders(context.Context, *ListOdrProvidersRequest) (*ListOdrProvidersResponse, error)
+	SelectOdr(context.Context, *SelectOdrRequest) (*SelectOdrResponse, error)
+	ProvideIssueInfo(context.Context, *ProvideIssueInfoRequest) (*ProvideIssueInfoResponse, error)
+	GetIssueInfoThread(context.Context, *GetIssueInfoThreadRequest) (*GetIssueInfoThreadResponse, error)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error)
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error)
+	ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	GetWebhookSubscription(context.Context, *GetWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	// UploadAttachment takes the metadata first, then the file in chunks.
+	// The attachment id goes into CreateIssue or ProvideIssueInfo, which
+	// send signed, expiring URLs for it to the BPP.
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error
+	// GetAttachment returns the attachment with a freshly signed URL.
+	GetAttachment(context.Context, *GetAttachmentRequest) (*Attachment, error)
 	GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error)
+	GetIssueTimeline(context.Context, *GetIssueTimelineRequest) (*GetIssueTimelineResponse, error)
+	WatchIssue(*WatchIssueRequest, grpc.ServerStreamingServer[IssueEvent]) error
+	WatchUserIssues(*WatchUserIssuesRequest, grpc.ServerStreamingServer[IssueEvent]) error
 	ListIssues(context.Context, *ListIssueRequest) (*ListIssueResponse, error)
 	ListIssueByOrder(context.Context, *ListIssueByOrderRequest) (*ListIssueResponse, error)
+	SearchIssues(context.Context, *IssueSearchRequest) (*IssueSearchResponse, error)
 	HandleIssueStatus(context.Context, *IssueStatusRequest) (*IssueStatusResponse, error)
 	HandleOnIssue(context.Context, *OnIssueRequest) (*OnIssueResponse, error)
 	HandleOnIssueStatus(context.Context, *OnIssueStatusRequest) (*OnIssueStatusResponse, error)
@@ -175,15 +477,78 @@ func (UnimplementedIssueServiceServer) UpdateIssue(context.Context, *UpdateIssue
 func (UnimplementedIssueServiceServer) CloseIssue(context.Context, *CloseIssueRequest) (*CloseIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloseIssue not implemented")
 }
+func (UnimplementedIssueServiceServer) AcceptResolution(context.Context, *AcceptResolutionRequest) (*AcceptResolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptResolution not implemented")
+}
+func (UnimplementedIssueServiceServer) RejectResolution(context.Context, *RejectResolutionRequest) (*RejectResolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectResolution not implemented")
+}
+func (UnimplementedIssueServiceServer) ListOdrProviders(context.Context, *ListOdrProvidersRequest) (*ListOdrProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOdrProviders not implemented")
+}
+func (UnimplementedIssueServiceServer) SelectOdr(context.Context, *SelectOdrRequest) (*SelectOdrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectOdr not implemented")
+}
+func (UnimplementedIssueServiceServer) ProvideIssueInfo(context.Context, *ProvideIssueInfoRequest) (*ProvideIssueInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvideIssueInfo not implemented")
+}
+func (UnimplementedIssueServiceServer) GetIssueInfoThread(context.Context, *GetIssueInfoThreadRequest) (*GetIssueInfoThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueInfoThread not implemented")
+}
+func (UnimplementedIssueServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedIssueServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (UnimplementedIssueServiceServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedIssueServiceServer) GetWebhookSubscription(context.Context, *GetWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookSubscription not implemented")
+}
+func (UnimplementedIssueServiceServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedIssueServiceServer) UpdateWebhookSubscription(context.Context, *UpdateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhookSubscription not implemented")
+}
+func (UnimplementedIssueServiceServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedIssueServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedIssueServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedIssueServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*Attachment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
 func (UnimplementedIssueServiceServer) GetIssue(context.Context, *GetIssueRequest) (*GetIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIssue not implemented")
 }
+func (UnimplementedIssueServiceServer) GetIssueTimeline(context.Context, *GetIssueTimelineRequest) (*GetIssueTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueTimeline not implemented")
+}
+func (UnimplementedIssueServiceServer) WatchIssue(*WatchIssueRequest, grpc.ServerStreamingServer[IssueEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchIssue not implemented")
+}
+func (UnimplementedIssueServiceServer) WatchUserIssues(*WatchUserIssuesRequest, grpc.ServerStreamingServer[IssueEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchUserIssues not implemented")
+}
 func (UnimplementedIssueServiceServer) ListIssues(context.Context, *ListIssueRequest) (*ListIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIssues not implemented")
 }
 func (UnimplementedIssueServiceServer) ListIssueByOrder(context.Context, *ListIssueByOrderRequest) (*ListIssueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIssueByOrder not implemented")
 }
+func (UnimplementedIssueServiceServer) SearchIssues(context.Context, *IssueSearchRequest) (*IssueSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchIssues not implemented")
+}
 func (UnimplementedIssueServiceServer) HandleIssueStatus(context.Context, *IssueStatusRequest) (*IssueStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleIssueStatus not implemented")
 }
@@ -268,156 +633,895 @@ func _IssueService_CloseIssue_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_GetIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIssueRequest)
+func _IssueService_AcceptResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptResolutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).GetIssue(ctx, in)
+		return srv.(IssueServiceServer).AcceptResolution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_GetIssue_FullMethodName,
+		FullMethod: IssueService_AcceptResolution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).GetIssue(ctx, req.(*GetIssueRequest))
+		return srv.(IssueServiceServer).AcceptResolution(ctx, req.(*AcceptResolutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_ListIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIssueRequest)
+func _IssueService_RejectResolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectResolutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).ListIssues(ctx, in)
+		return srv.(IssueServiceServer).RejectResolution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_ListIssues_FullMethodName,
+		FullMethod: IssueService_RejectResolution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).ListIssues(ctx, req.(*ListIssueRequest))
+		return srv.(IssueServiceServer).RejectResolution(ctx, req.(*RejectResolutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_ListIssueByOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIssueByOrderRequest)
+func _IssueService_ListOdrProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOdrProvidersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).ListIssueByOrder(ctx, in)
+		return srv.(IssueServiceServer).ListOdrProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_ListIssueByOrder_FullMethodName,
+		FullMethod: IssueService_ListOdrProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).ListIssueByOrder(ctx, req.(*ListIssueByOrderRequest))
+		return srv.(IssueServiceServer).ListOdrProviders(ctx, req.(*ListOdrProvidersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_HandleIssueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IssueStatusRequest)
+func _IssueService_SelectOdr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectOdrRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).HandleIssueStatus(ctx, in)
+		return srv.(IssueServiceServer).SelectOdr(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_HandleIssueStatus_FullMethodName,
+		FullMethod: IssueService_SelectOdr_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).HandleIssueStatus(ctx, req.(*IssueStatusRequest))
+		return srv.(IssueServiceServer).SelectOdr(ctx, req.(*SelectOdrRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_HandleOnIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OnIssueRequest)
+func _IssueService_ProvideIssueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvideIssueInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).HandleOnIssue(ctx, in)
+		return srv.(IssueServiceServer).ProvideIssueInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_HandleOnIssue_FullMethodName,
+		FullMethod: IssueService_ProvideIssueInfo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).HandleOnIssue(ctx, req.(*OnIssueRequest))
+		return srv.(IssueServiceServer).ProvideIssueInfo(ctx, req.(*ProvideIssueInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _IssueService_HandleOnIssueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OnIssueStatusRequest)
+func _IssueService_GetIssueInfoThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueInfoThreadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(IssueServiceServer).HandleOnIssueStatus(ctx, in)
+		return srv.(IssueServiceServer).GetIssueInfoThread(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: IssueService_HandleOnIssueStatus_FullMethodName,
+		FullMethod: IssueService_GetIssueInfoThread_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(IssueServiceServer).HandleOnIssueStatus(ctx, req.(*OnIssueStatusRequest))
+		return srv.(IssueServiceServer).GetIssueInfoThread(ctx, req.(*GetIssueInfoThreadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// IssueService_ServiceDesc is the grpc.ServiceDesc for IssueService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var IssueService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "igm.v1.IssueService",
-	HandlerType: (*IssueServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateIssue",
-			Handler:    _IssueService_CreateIssue_Handler,
-		},
-		{
-			MethodName: "UpdateIssue",
-			Handler:    _IssueService_UpdateIssue_Handler,
-		},
-		{
-			MethodName: "CloseIssue",
-			Handler:    _IssueService_CloseIssue_Handler,
-		},
-		{
-			MethodName: "GetIssue",
-			Handler:    _IssueService_GetIssue_Handler,
-		},
-		{
-			MethodName: "ListIssues",
-			Handler:    _IssueService_ListIssues_Handler,
-		},
-		{
-			MethodName: "ListIssueByOrder",
-			Handler:    _IssueService_ListIssueByOrder_Handler,
-		},
-		{
-			MethodName: "HandleIssueStatus",
-			Handler:    _IssueService_HandleIssueStatus_Handler,
-		},
-		{
-			MethodName: "HandleOnIssue",
-			Handler:    _IssueService_HandleOnIssue_Handler,
-		},
-		{
-			MethodName: "HandleOnIssueStatus",
-			Handler:    _IssueService_HandleOnIssueStatus_Handler,
+func _IssueService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UpdateNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UpdateNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListNotifications(ctx, req.(*ListNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetWebhookSubscription(ctx, req.(*GetWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UpdateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).UpdateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_UpdateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).UpdateWebhookSubscription(ctx, req.(*UpdateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(IssueServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, Attachment]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, Attachment]
+
+func _IssueService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetAttachment(ctx, req.(*GetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssue(ctx, req.(*GetIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetIssueTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssueTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssueTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssueTimeline(ctx, req.(*GetIssueTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_WatchIssue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchIssueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IssueServiceServer).WatchIssue(m, &grpc.GenericServerStream[WatchIssueRequest, IssueEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_WatchIssueServer = grpc.ServerStreamingServer[IssueEvent]
+
+func _IssueService_WatchUserIssues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchUserIssuesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IssueServiceServer).WatchUserIssues(m, &grpc.GenericServerStream[WatchUserIssuesRequest, IssueEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type IssueService_WatchUserIssuesServer = grpc.ServerStreamingServer[IssueEvent]
+
+func _IssueService_ListIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListIssues(ctx, req.(*ListIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListIssueByOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueByOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListIssueByOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListIssueByOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListIssueByOrder(ctx, req.(*ListIssueByOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_SearchIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).SearchIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_SearchIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).SearchIssues(ctx, req.(*IssueSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_HandleIssueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).HandleIssueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_HandleIssueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).HandleIssueStatus(ctx, req.(*IssueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_HandleOnIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).HandleOnIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_HandleOnIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).HandleOnIssue(ctx, req.(*OnIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_HandleOnIssueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnIssueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).HandleOnIssueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_HandleOnIssueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).HandleOnIssueStatus(ctx, req.(*OnIssueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IssueService_ServiceDesc is the grpc.ServiceDesc for IssueService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IssueService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "igm.v1.IssueService",
+	HandlerType: (*IssueServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateIssue",
+			Handler:    _IssueService_CreateIssue_Handler,
+		},
+		{
+			MethodName: "UpdateIssue",
+			Handler:    _IssueService_UpdateIssue_Handler,
+		},
+		{
+			MethodName: "CloseIssue",
+			Handler:    _IssueService_CloseIssue_Handler,
+		},
+		{
+			MethodName: "AcceptResolution",
+			Handler:    _IssueService_AcceptResolution_Handler,
+		},
+		{
+			MethodName: "RejectResolution",
+			Handler:    _IssueService_RejectResolution_Handler,
+		},
+		{
+			MethodName: "ListOdrProviders",
+			Handler:    _IssueService_ListOdrProviders_Handler,
+		},
+		{
+			MethodName: "SelectOdr",
+			Handler:    _IssueService_SelectOdr_Handler,
+		},
+		{
+			MethodName: "ProvideIssueInfo",
+			Handler:    _IssueService_ProvideIssueInfo_Handler,
+		},
+		{
+			MethodName: "GetIssueInfoThread",
+			Handler:    _IssueService_GetIssueInfoThread_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _IssueService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _IssueService_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _IssueService_ListNotifications_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _IssueService_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "GetWebhookSubscription",
+			Handler:    _IssueService_GetWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _IssueService_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "UpdateWebhookSubscription",
+			Handler:    _IssueService_UpdateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _IssueService_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _IssueService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _IssueService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "GetIssue",
+			Handler:    _IssueService_GetIssue_Handler,
+		},
+		{
+			MethodName: "GetIssueTimeline",
+			Handler:    _IssueService_GetIssueTimeline_Handler,
+		},
+		{
+			MethodName: "ListIssues",
+			Handler:    _IssueService_ListIssues_Handler,
+		},
+		{
+			MethodName: "ListIssueByOrder",
+			Handler:    _IssueService_ListIssueByOrder_Handler,
+		},
+		{
+			MethodName: "SearchIssues",
+			Handler:    _IssueService_SearchIssues_Handler,
+		},
+		{
+			MethodName: "HandleIssueStatus",
+			Handler:    _IssueService_HandleIssueStatus_Handler,
+		},
+		{
+			MethodName: "HandleOnIssue",
+			Handler:    _IssueService_HandleOnIssue_Handler,
+		},
+		{
+			MethodName: "HandleOnIssueStatus",
+			Handler:    _IssueService_HandleOnIssueStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _IssueService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchIssue",
+			Handler:       _IssueService_WatchIssue_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchUserIssues",
+			Handler:       _IssueService_WatchUserIssues_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/proto/igm/v1/issue.proto",
+}
+
+const (
+	SupportService_SearchIssues_FullMethodName        = "/igm.v1.SupportService/SearchIssues"
+	SupportService_GetIssueDetails_FullMethodName     = "/igm.v1.SupportService/GetIssueDetails"
+	SupportService_AddInternalNote_FullMethodName     = "/igm.v1.SupportService/AddInternalNote"
+	SupportService_ReassignIssue_FullMethodName       = "/igm.v1.SupportService/ReassignIssue"
+	SupportService_ForceIssueStatus_FullMethodName    = "/igm.v1.SupportService/ForceIssueStatus"
+	SupportService_ListSupportAuditLog_FullMethodName = "/igm.v1.SupportService/ListSupportAuditLog"
+)
+
+// SupportServiceClient is the client API for SupportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SupportService is the support-agent view across all users. Every call
+// requires the support (or service) role and is written to the audit log.
+type SupportServiceClient interface {
+	SearchIssues(ctx context.Context, in *SearchIssuesRequest, opts ...grpc.CallOption) (*SearchIssuesResponse, error)
+	GetIssueDetails(ctx context.Context, in *GetIssueDetailsRequest, opts ...grpc.CallOption) (*IssueDetails, error)
+	AddInternalNote(ctx context.Context, in *AddInternalNoteRequest, opts ...grpc.CallOption) (*InternalNote, error)
+	ReassignIssue(ctx context.Context, in *ReassignIssueRequest, opts ...grpc.CallOption) (*ReassignIssueResponse, error)
+	ForceIssueStatus(ctx context.Context, in *ForceIssueStatusRequest, opts ...grpc.CallOption) (*ForceIssueStatusResponse, error)
+	ListSupportAuditLog(ctx context.Context, in *ListSupportAuditLogRequest, opts ...grpc.CallOption) (*ListSupportAuditLogResponse, error)
+}
+
+type supportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSupportServiceClient(cc grpc.ClientConnInterface) SupportServiceClient {
+	return &supportServiceClient{cc}
+}
+
+func (c *supportServiceClient) SearchIssues(ctx context.Context, in *SearchIssuesRequest, opts ...grpc.CallOption) (*SearchIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchIssuesResponse)
+	err := c.cc.Invoke(ctx, SupportService_SearchIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportServiceClient) GetIssueDetails(ctx context.Context, in *GetIssueDetailsRequest, opts ...grpc.CallOption) (*IssueDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueDetails)
+	err := c.cc.Invoke(ctx, SupportService_GetIssueDetails_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportServiceClient) AddInternalNote(ctx context.Context, in *AddInternalNoteRequest, opts ...grpc.CallOption) (*InternalNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalNote)
+	err := c.cc.Invoke(ctx, SupportService_AddInternalNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportServiceClient) ReassignIssue(ctx context.Context, in *ReassignIssueRequest, opts ...grpc.CallOption) (*ReassignIssueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignIssueResponse)
+	err := c.cc.Invoke(ctx, SupportService_ReassignIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportServiceClient) ForceIssueStatus(ctx context.Context, in *ForceIssueStatusRequest, opts ...grpc.CallOption) (*ForceIssueStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForceIssueStatusResponse)
+	err := c.cc.Invoke(ctx, SupportService_ForceIssueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *supportServiceClient) ListSupportAuditLog(ctx context.Context, in *ListSupportAuditLogRequest, opts ...grpc.CallOption) (*ListSupportAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSupportAuditLogResponse)
+	err := c.cc.Invoke(ctx, SupportService_ListSupportAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SupportServiceServer is the server API for SupportService service.
+// All implementations must embed UnimplementedSupportServiceServer
+// for forward compatibility.
+//
+// SupportService is the support-agent view across all users. Every call
+// requires the support (or service) role and is written to the audit log.
+type SupportServiceServer interface {
+	SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error)
+	GetIssueDetails(context.Context, *GetIssueDetailsRequest) (*IssueDetails, error)
+	AddInternalNote(context.Context, *AddInternalNoteRequest) (*InternalNote, error)
+	ReassignIssue(context.Context, *ReassignIssueRequest) (*ReassignIssueResponse, error)
+	ForceIssueStatus(context.Context, *ForceIssueStatusRequest) (*ForceIssueStatusResponse, error)
+	ListSupportAuditLog(context.Context, *ListSupportAuditLogRequest) (*ListSupportAuditLogResponse, error)
+	mustEmbedUnimplementedSupportServiceServer()
+}
+
+// UnimplementedSupportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSupportServiceServer struct{}
+
+func (UnimplementedSupportServiceServer) SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchIssues not implemented")
+}
+func (UnimplementedSupportServiceServer) GetIssueDetails(context.Context, *GetIssueDetailsRequest) (*IssueDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueDetails not implemented")
+}
+func (UnimplementedSupportServiceServer) AddInternalNote(context.Context, *AddInternalNoteRequest) (*InternalNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInternalNote not implemented")
+}
+func (UnimplementedSupportServiceServer) ReassignIssue(context.Context, *ReassignIssueRequest) (*ReassignIssueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignIssue not implemented")
+}
+func (UnimplementedSupportServiceServer) ForceIssueStatus(context.Context, *ForceIssueStatusRequest) (*ForceIssueStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceIssueStatus not implemented")
+}
+func (UnimplementedSupportServiceServer) ListSupportAuditLog(context.Context, *ListSupportAuditLogRequest) (*ListSupportAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSupportAuditLog not implemented")
+}
+func (UnimplementedSupportServiceServer) mustEmbedUnimplementedSupportServiceServer() {}
+func (UnimplementedSupportServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeSupportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SupportServiceServer will
+// result in compilation errors.
+type UnsafeSupportServiceServer interface {
+	mustEmbedUnimplementedSupportServiceServer()
+}
+
+func RegisterSupportServiceServer(s grpc.ServiceRegistrar, srv SupportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSupportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SupportService_ServiceDesc, srv)
+}
+
+func _SupportService_SearchIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportServiceServer).SearchIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportService_SearchIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportServiceServer).SearchIssues(ctx, req.(*SearchIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportService_GetIssueDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportServiceServer).GetIssueDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportService_GetIssueDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportServiceServer).GetIssueDetails(ctx, req.(*GetIssueDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportService_AddInternalNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInternalNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportServiceServer).AddInternalNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportService_AddInternalNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportServiceServer).AddInternalNote(ctx, req.(*AddInternalNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportService_ReassignIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignIssueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportServiceServer).ReassignIssue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportService_ReassignIssue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportServiceServer).ReassignIssue(ctx, req.(*ReassignIssueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportService_ForceIssueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceIssueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportServiceServer).ForceIssueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportService_ForceIssueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportServiceServer).ForceIssueStatus(ctx, req.(*ForceIssueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SupportService_ListSupportAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSupportAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SupportServiceServer).ListSupportAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SupportService_ListSupportAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SupportServiceServer).ListSupportAuditLog(ctx, req.(*ListSupportAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SupportService_ServiceDesc is the grpc.ServiceDesc for SupportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SupportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "igm.v1.SupportService",
+	HandlerType: (*SupportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchIssues",
+			Handler:    _SupportService_SearchIssues_Handler,
+		},
+		{
+			MethodName: "GetIssueDetails",
+			Handler:    _SupportService_GetIssueDetails_Handler,
+		},
+		{
+			MethodName: "AddInternalNote",
+			Handler:    _SupportService_AddInternalNote_Handler,
+		},
+		{
+			MethodName: "ReassignIssue",
+			Handler:    _SupportService_ReassignIssue_Handler,
+		},
+		{
+			MethodName: "ForceIssueStatus",
+			Handler:    _SupportService_ForceIssueStatus_Handler,
+		},
+		{
+			MethodName: "ListSupportAuditLog",
+			Handler:    _SupportService_ListSupportAuditLog_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,2355 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.32.1
+// source: api/proto/igm/v2/issue.proto
+
+package igmv2
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	v1 "igm-svc/api/proto/igm/v1"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ++++++ enums ++++++
+type IssueStatus int32
+
+const (
+	IssueStatus_ISSUE_STATUS_UNSPECIFIED IssueStatus = 0
+	IssueStatus_ISSUE_STATUS_OPEN        IssueStatus = 1
+	IssueStatus_ISSUE_STATUS_CLOSED      IssueStatus = 2
+)
+
+// Enum value maps for IssueStatus.
+var (
+	IssueStatus_name = map[int32]string{
+		0: "ISSUE_STATUS_UNSPECIFIED",
+		1: "ISSUE_STATUS_OPEN",
+		2: "ISSUE_STATUS_CLOSED",
+	}
+	IssueStatus_value = map[string]int32{
+		"ISSUE_STATUS_UNSPECIFIED": 0,
+		"ISSUE_STATUS_OPEN":        1,
+		"ISSUE_STATUS_CLOSED":      2,
+	}
+)
+
+func (x IssueStatus) Enum() *IssueStatus {
+	p := new(IssueStatus)
+	*p = x
+	return p
+}
+
+func (x IssueStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_igm_v2_issue_proto_enumTypes[0].Descriptor()
+}
+
+func (IssueStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_igm_v2_issue_proto_enumTypes[0]
+}
+
+func (x IssueStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueStatus.Descriptor instead.
+func (IssueStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{0}
+}
+
+type IssueCategory int32
+
+const (
+	IssueCategory_ISSUE_CATEGORY_UNSPECIFIED   IssueCategory = 0
+	IssueCategory_ISSUE_CATEGORY_ORDER         IssueCategory = 1
+	IssueCategory_ISSUE_CATEGORY_FULFILLMENT   IssueCategory = 2
+	IssueCategory_ISSUE_CATEGORY_PAYMENT       IssueCategory = 3
+	IssueCategory_ISSUE_CATEGORY_ITEM          IssueCategory = 4
+	IssueCategory_ISSUE_CATEGORY_AGENT         IssueCategory = 5
+	IssueCategory_ISSUE_CATEGORY_CUSTOMER      IssueCategory = 6
+	IssueCategory_ISSUE_CATEGORY_TECHNICAL     IssueCategory = 7
+	IssueCategory_ISSUE_CATEGORY_VISIBILITY    IssueCategory = 8
+	IssueCategory_ISSUE_CATEGORY_POLICY_BREACH IssueCategory = 9 //"POLICY BREACH" on the wire
+	IssueCategory_ISSUE_CATEGORY_BUSINESS      IssueCategory = 10
+)
+
+// Enum value maps for IssueCategory.
+var (
+	IssueCategory_name = map[int32]string{
+		0:  "ISSUE_CATEGORY_UNSPECIFIED",
+		1:  "ISSUE_CATEGORY_ORDER",
+		2:  "ISSUE_CATEGORY_FULFILLMENT",
+		3:  "ISSUE_CATEGORY_PAYMENT",
+		4:  "ISSUE_CATEGORY_ITEM",
+		5:  "ISSUE_CATEGORY_AGENT",
+		6:  "ISSUE_CATEGORY_CUSTOMER",
+		7:  "ISSUE_CATEGORY_TECHNICAL",
+		8:  "ISSUE_CATEGORY_VISIBILITY",
+		9:  "ISSUE_CATEGORY_POLICY_BREACH",
+		10: "ISSUE_CATEGORY_BUSINESS",
+	}
+	IssueCategory_value = map[string]int32{
+		"ISSUE_CATEGORY_UNSPECIFIED":   0,
+		"ISSUE_CATEGORY_ORDER":         1,
+		"ISSUE_CATEGORY_FULFILLMENT":   2,
+		"ISSUE_CATEGORY_PAYMENT":       3,
+		"ISSUE_CATEGORY_ITEM":          4,
+		"ISSUE_CATEGORY_AGENT":         5,
+		"ISSUE_CATEGORY_CUSTOMER":      6,
+		"ISSUE_CATEGORY_TECHNICAL":     7,
+		"ISSUE_CATEGORY_VISIBILITY":    8,
+		"ISSUE_CATEGORY_POLICY_BREACH": 9,
+		"ISSUE_CATEGORY_BUSINESS":      10,
+	}
+)
+
+func (x IssueCategory) Enum() *IssueCategory {
+	p := new(IssueCategory)
+	*p = x
+	return p
+}
+
+func (x IssueCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_igm_v2_issue_proto_enumTypes[1].Descriptor()
+}
+
+func (IssueCategory) Type() protoreflect.EnumType {
+	return &file_api_proto_igm_v2_issue_proto_enumTypes[1]
+}
+
+func (x IssueCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueCategory.Descriptor instead.
+func (IssueCategory) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{1}
+}
+
+type IssueType int32
+
+const (
+	IssueType_ISSUE_TYPE_UNSPECIFIED IssueType = 0
+	IssueType_ISSUE_TYPE_ISSUE       IssueType = 1
+	IssueType_ISSUE_TYPE_GRIEVANCE   IssueType = 2
+	IssueType_ISSUE_TYPE_DISPUTE     IssueType = 3 //only set by SelectOdr
+)
+
+// Enum value maps for IssueType.
+var (
+	IssueType_name = map[int32]string{
+		0: "ISSUE_TYPE_UNSPECIFIED",
+		1: "ISSUE_TYPE_ISSUE",
+		2: "ISSUE_TYPE_GRIEVANCE",
+		3: "ISSUE_TYPE_DISPUTE",
+	}
+	IssueType_value = map[string]int32{
+		"ISSUE_TYPE_UNSPECIFIED": 0,
+		"ISSUE_TYPE_ISSUE":       1,
+		"ISSUE_TYPE_GRIEVANCE":   2,
+		"ISSUE_TYPE_DISPUTE":     3,
+	}
+)
+
+func (x IssueType) Enum() *IssueType {
+	p := new(IssueType)
+	*p = x
+	return p
+}
+
+func (x IssueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_igm_v2_issue_proto_enumTypes[2].Descriptor()
+}
+
+func (IssueType) Type() protoreflect.EnumType {
+	return &file_api_proto_igm_v2_issue_proto_enumTypes[2]
+}
+
+func (x IssueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueType.Descriptor instead.
+func (IssueType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{2}
+}
+
+type Rating int32
+
+const (
+	Rating_RATING_UNSPECIFIED Rating = 0
+	Rating_RATING_THUMBS_UP   Rating = 1
+	Rating_RATING_THUMBS_DOWN Rating = 2
+)
+
+// Enum value maps for Rating.
+var (
+	Rating_name = map[int32]string{
+		0: "RATING_UNSPECIFIED",
+		1: "RATING_THUMBS_UP",
+		2: "RATING_THUMBS_DOWN",
+	}
+	Rating_value = map[string]int32{
+		"RATING_UNSPECIFIED": 0,
+		"RATING_THUMBS_UP":   1,
+		"RATING_THUMBS_DOWN": 2,
+	}
+)
+
+func (x Rating) Enum() *Rating {
+	p := new(Rating)
+	*p = x
+	return p
+}
+
+func (x Rating) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rating) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_igm_v2_issue_proto_enumTypes[3].Descriptor()
+}
+
+func (Rating) Type() protoreflect.EnumType {
+	return &file_api_proto_igm_v2_issue_proto_enumTypes[3]
+}
+
+func (x Rating) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rating.Descriptor instead.
+func (Rating) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{3}
+}
+
+// +++++create issue++++++++
+type CreateIssueRequest struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	UserId          string                    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId         string                    `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Category        IssueCategory             `protobuf:"varint,3,opt,name=category,proto3,enum=igm.v2.IssueCategory" json:"category,omitempty"`
+	SubCategory     string                    `protobuf:"bytes,4,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
+	IssueType       IssueType                 `protobuf:"varint,5,opt,name=issue_type,json=issueType,proto3,enum=igm.v2.IssueType" json:"issue_type,omitempty"`
+	Description     string                    `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	LongDescription string                    `protobuf:"bytes,7,opt,name=long_description,json=longDescription,proto3" json:"long_description,omitempty"`
+	ImageUrls       []string                  `protobuf:"bytes,8,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	AdditionalDesc  *v1.AdditionalDescription `protobuf:"bytes,9,opt,name=additional_desc,json=additionalDesc,proto3" json:"additional_desc,omitempty"`
+	Items           []*v1.IssueItem           `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
+	AttachmentIds   []string                  `protobuf:"bytes,11,rep,name=attachment_ids,json=attachmentIds,proto3" json:"attachment_ids,omitempty"` //uploaded images, sent as signed image_urls
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateIssueRequest) Reset() {
+	*x = CreateIssueRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueRequest) ProtoMessage() {}
+
+func (x *CreateIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueRequest.ProtoReflect.Descriptor instead.
+func (*CreateIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetCategory() IssueCategory {
+	if x != nil {
+		return x.Category
+	}
+	return IssueCategory_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *CreateIssueRequest) GetSubCategory() string {
+	if x != nil {
+		return x.SubCategory
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateIssueRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetLongDescription() string {
+	if x != nil {
+		return x.LongDescription
+	}
+	return ""
+}
+
+func (x *CreateIssueRequest) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *CreateIssueRequest) GetAdditionalDesc() *v1.AdditionalDescription {
+	if x != nil {
+		return x.AdditionalDesc
+	}
+	return nil
+}
+
+func (x *CreateIssueRequest) GetItems() []*v1.IssueItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateIssueRequest) GetAttachmentIds() []string {
+	if x != nil {
+		return x.AttachmentIds
+	}
+	return nil
+}
+
+type CreateIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        IssueStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	TransactionId string                 `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,6,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,7,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIssueResponse) Reset() {
+	*x = CreateIssueResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIssueResponse) ProtoMessage() {}
+
+func (x *CreateIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIssueResponse.ProtoReflect.Descriptor instead.
+func (*CreateIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateIssueResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *CreateIssueResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CreateIssueResponse) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *CreateIssueResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CreateIssueResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CreateIssueResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *CreateIssueResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+// ++++++update issue++++++++
+type UpdateIssueRequest struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	UserId                     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId                    string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	OrderId                    string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	IssueType                  IssueType              `protobuf:"varint,4,opt,name=issue_type,json=issueType,proto3,enum=igm.v2.IssueType" json:"issue_type,omitempty"` //unspecified keeps the current type
+	Status                     IssueStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	ComplainantActionShortDesc string                 `protobuf:"bytes,6,opt,name=complainant_action_short_desc,json=complainantActionShortDesc,proto3" json:"complainant_action_short_desc,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *UpdateIssueRequest) Reset() {
+	*x = UpdateIssueRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIssueRequest) ProtoMessage() {}
+
+func (x *UpdateIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIssueRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UpdateIssueRequest) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateIssueRequest) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateIssueRequest) GetComplainantActionShortDesc() string {
+	if x != nil {
+		return x.ComplainantActionShortDesc
+	}
+	return ""
+}
+
+type UpdateIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        IssueStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,4,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,5,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateIssueResponse) Reset() {
+	*x = UpdateIssueResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIssueResponse) ProtoMessage() {}
+
+func (x *UpdateIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIssueResponse.ProtoReflect.Descriptor instead.
+func (*UpdateIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateIssueResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *UpdateIssueResponse) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateIssueResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *UpdateIssueResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *UpdateIssueResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+// ++++++++close issue ++++++++++
+type CloseIssueRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId               string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	OrderId               string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Rating                Rating                 `protobuf:"varint,4,opt,name=rating,proto3,enum=igm.v2.Rating" json:"rating,omitempty"`
+	Status                IssueStatus            `protobuf:"varint,5,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	ComplaintActShortDesc string                 `protobuf:"bytes,6,opt,name=complaint_act_short_desc,json=complaintActShortDesc,proto3" json:"complaint_act_short_desc,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CloseIssueRequest) Reset() {
+	*x = CloseIssueRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseIssueRequest) ProtoMessage() {}
+
+func (x *CloseIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseIssueRequest.ProtoReflect.Descriptor instead.
+func (*CloseIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CloseIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CloseIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *CloseIssueRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CloseIssueRequest) GetRating() Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return Rating_RATING_UNSPECIFIED
+}
+
+func (x *CloseIssueRequest) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *CloseIssueRequest) GetComplaintActShortDesc() string {
+	if x != nil {
+		return x.ComplaintActShortDesc
+	}
+	return ""
+}
+
+type CloseIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        IssueStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	ClosedAt      string                 `protobuf:"bytes,3,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,4,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,5,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseIssueResponse) Reset() {
+	*x = CloseIssueResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseIssueResponse) ProtoMessage() {}
+
+func (x *CloseIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseIssueResponse.ProtoReflect.Descriptor instead.
+func (*CloseIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CloseIssueResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *CloseIssueResponse) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *CloseIssueResponse) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *CloseIssueResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *CloseIssueResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+// ++++++++ resolution acceptance ++++++++++
+type AcceptResolutionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Rating        Rating                 `protobuf:"varint,3,opt,name=rating,proto3,enum=igm.v2.Rating" json:"rating,omitempty"`
+	ShortDesc     string                 `protobuf:"bytes,4,opt,name=short_desc,json=shortDesc,proto3" json:"short_desc,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptResolutionRequest) Reset() {
+	*x = AcceptResolutionRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptResolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptResolutionRequest) ProtoMessage() {}
+
+func (x *AcceptResolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptResolutionRequest.ProtoReflect.Descriptor instead.
+func (*AcceptResolutionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcceptResolutionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptResolutionRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *AcceptResolutionRequest) GetRating() Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return Rating_RATING_UNSPECIFIED
+}
+
+func (x *AcceptResolutionRequest) GetShortDesc() string {
+	if x != nil {
+		return x.ShortDesc
+	}
+	return ""
+}
+
+type AcceptResolutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        IssueStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	ClosedAt      string                 `protobuf:"bytes,3,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,4,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,5,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptResolutionResponse) Reset() {
+	*x = AcceptResolutionResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptResolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptResolutionResponse) ProtoMessage() {}
+
+func (x *AcceptResolutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptResolutionResponse.ProtoReflect.Descriptor instead.
+func (*AcceptResolutionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptResolutionResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *AcceptResolutionResponse) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *AcceptResolutionResponse) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+func (x *AcceptResolutionResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *AcceptResolutionResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+type RejectResolutionRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId             string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Reason              string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	EscalateToGrievance bool                   `protobuf:"varint,4,opt,name=escalate_to_grievance,json=escalateToGrievance,proto3" json:"escalate_to_grievance,omitempty"` //upgrade ISSUE to GRIEVANCE
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RejectResolutionRequest) Reset() {
+	*x = RejectResolutionRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectResolutionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectResolutionRequest) ProtoMessage() {}
+
+func (x *RejectResolutionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectResolutionRequest.ProtoReflect.Descriptor instead.
+func (*RejectResolutionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectResolutionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RejectResolutionRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *RejectResolutionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectResolutionRequest) GetEscalateToGrievance() bool {
+	if x != nil {
+		return x.EscalateToGrievance
+	}
+	return false
+}
+
+type RejectResolutionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IssueId       string                 `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	Status        IssueStatus            `protobuf:"varint,2,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	IssueType     IssueType              `protobuf:"varint,3,opt,name=issue_type,json=issueType,proto3,enum=igm.v2.IssueType" json:"issue_type,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	OndcSent      bool                   `protobuf:"varint,5,opt,name=ondc_sent,json=ondcSent,proto3" json:"ondc_sent,omitempty"`
+	OndcMessage   string                 `protobuf:"bytes,6,opt,name=ondc_message,json=ondcMessage,proto3" json:"ondc_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectResolutionResponse) Reset() {
+	*x = RejectResolutionResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectResolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectResolutionResponse) ProtoMessage() {}
+
+func (x *RejectResolutionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectResolutionResponse.ProtoReflect.Descriptor instead.
+func (*RejectResolutionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectResolutionResponse) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *RejectResolutionResponse) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *RejectResolutionResponse) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *RejectResolutionResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *RejectResolutionResponse) GetOndcSent() bool {
+	if x != nil {
+		return x.OndcSent
+	}
+	return false
+}
+
+func (x *RejectResolutionResponse) GetOndcMessage() string {
+	if x != nil {
+		return x.OndcMessage
+	}
+	return ""
+}
+
+// ++++++ get and list issues ++++++
+type GetIssueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IssueId       string                 `protobuf:"bytes,2,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueRequest) Reset() {
+	*x = GetIssueRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueRequest) ProtoMessage() {}
+
+func (x *GetIssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueRequest.ProtoReflect.Descriptor instead.
+func (*GetIssueRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetIssueRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetIssueRequest) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+type GetIssueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIssueResponse) Reset() {
+	*x = GetIssueResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIssueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIssueResponse) ProtoMessage() {}
+
+func (x *GetIssueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIssueResponse.ProtoReflect.Descriptor instead.
+func (*GetIssueResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetIssueResponse) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+type ListIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Status        IssueStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	Category      IssueCategory          `protobuf:"varint,4,opt,name=category,proto3,enum=igm.v2.IssueCategory" json:"category,omitempty"`
+	IssueType     IssueType              `protobuf:"varint,5,opt,name=issue_type,json=issueType,proto3,enum=igm.v2.IssueType" json:"issue_type,omitempty"`
+	OrderId       string                 `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedFrom   string                 `protobuf:"bytes,7,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"` //RFC3339, inclusive
+	CreatedTo     string                 `protobuf:"bytes,8,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`       //RFC3339, exclusive
+	HasResolution *bool                  `protobuf:"varint,9,opt,name=has_resolution,json=hasResolution,proto3,oneof" json:"has_resolution,omitempty"`
+	SortBy        string                 `protobuf:"bytes,10,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`          //created_at (default) or updated_at
+	SortOrder     string                 `protobuf:"bytes,11,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"` //DESC (default) or ASC
+	Cursor        string                 `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`                        //next_cursor of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesRequest) Reset() {
+	*x = ListIssuesRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesRequest) ProtoMessage() {}
+
+func (x *ListIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesRequest.ProtoReflect.Descriptor instead.
+func (*ListIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListIssuesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIssuesRequest) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *ListIssuesRequest) GetCategory() IssueCategory {
+	if x != nil {
+		return x.Category
+	}
+	return IssueCategory_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *ListIssuesRequest) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *ListIssuesRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetHasResolution() bool {
+	if x != nil && x.HasResolution != nil {
+		return *x.HasResolution
+	}
+	return false
+}
+
+func (x *ListIssuesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListIssuesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*Issue               `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` //empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIssuesResponse) Reset() {
+	*x = ListIssuesResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIssuesResponse) ProtoMessage() {}
+
+func (x *ListIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIssuesResponse.ProtoReflect.Descriptor instead.
+func (*ListIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListIssuesResponse) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ListIssuesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListIssuesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type SearchIssuesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Status        IssueStatus            `protobuf:"varint,3,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	Category      IssueCategory          `protobuf:"varint,4,opt,name=category,proto3,enum=igm.v2.IssueCategory" json:"category,omitempty"`
+	IssueType     IssueType              `protobuf:"varint,5,opt,name=issue_type,json=issueType,proto3,enum=igm.v2.IssueType" json:"issue_type,omitempty"`
+	OrderId       string                 `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CreatedFrom   string                 `protobuf:"bytes,7,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     string                 `protobuf:"bytes,8,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	HasResolution *bool                  `protobuf:"varint,9,opt,name=has_resolution,json=hasResolution,proto3,oneof" json:"has_resolution,omitempty"`
+	Page          int32                  `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIssuesRequest) Reset() {
+	*x = SearchIssuesRequest{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIssuesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIssuesRequest) ProtoMessage() {}
+
+func (x *SearchIssuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIssuesRequest.ProtoReflect.Descriptor instead.
+func (*SearchIssuesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SearchIssuesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *SearchIssuesRequest) GetCategory() IssueCategory {
+	if x != nil {
+		return x.Category
+	}
+	return IssueCategory_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *SearchIssuesRequest) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *SearchIssuesRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetCreatedFrom() string {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetCreatedTo() string {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return ""
+}
+
+func (x *SearchIssuesRequest) GetHasResolution() bool {
+	if x != nil && x.HasResolution != nil {
+		return *x.HasResolution
+	}
+	return false
+}
+
+func (x *SearchIssuesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchIssuesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type SearchIssueHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issue         *Issue                 `protobuf:"bytes,1,opt,name=issue,proto3" json:"issue,omitempty"`
+	Rank          float32                `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet       string                 `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"` //matched terms wrapped in <mark></mark>
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIssueHit) Reset() {
+	*x = SearchIssueHit{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIssueHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIssueHit) ProtoMessage() {}
+
+func (x *SearchIssueHit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIssueHit.ProtoReflect.Descriptor instead.
+func (*SearchIssueHit) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchIssueHit) GetIssue() *Issue {
+	if x != nil {
+		return x.Issue
+	}
+	return nil
+}
+
+func (x *SearchIssueHit) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchIssueHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchIssuesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchIssueHit      `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchIssuesResponse) Reset() {
+	*x = SearchIssuesResponse{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchIssuesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchIssuesResponse) ProtoMessage() {}
+
+func (x *SearchIssuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchIssuesResponse.ProtoReflect.Descriptor instead.
+func (*SearchIssuesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchIssuesResponse) GetHits() []*SearchIssueHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+func (x *SearchIssuesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchIssuesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchIssuesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ++++++ issue ++++++
+type Issue struct {
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	IssueId                string                    `protobuf:"bytes,1,opt,name=issue_id,json=issueId,proto3" json:"issue_id,omitempty"`
+	OrderId                string                    `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId                 string                    `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId          string                    `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Category               IssueCategory             `protobuf:"varint,5,opt,name=category,proto3,enum=igm.v2.IssueCategory" json:"category,omitempty"`
+	SubCategory            string                    `protobuf:"bytes,6,opt,name=sub_category,json=subCategory,proto3" json:"sub_category,omitempty"`
+	IssueType              IssueType                 `protobuf:"varint,7,opt,name=issue_type,json=issueType,proto3,enum=igm.v2.IssueType" json:"issue_type,omitempty"`
+	Status                 IssueStatus               `protobuf:"varint,8,opt,name=status,proto3,enum=igm.v2.IssueStatus" json:"status,omitempty"`
+	DescriptionShort       string                    `protobuf:"bytes,9,opt,name=description_short,json=descriptionShort,proto3" json:"description_short,omitempty"`
+	DescriptionLong        string                    `protobuf:"bytes,10,opt,name=description_long,json=descriptionLong,proto3" json:"description_long,omitempty"`
+	ImageUrls              []string                  `protobuf:"bytes,11,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"`
+	BppId                  string                    `protobuf:"bytes,12,opt,name=bpp_id,json=bppId,proto3" json:"bpp_id,omitempty"`
+	BppUri                 string                    `protobuf:"bytes,13,opt,name=bpp_uri,json=bppUri,proto3" json:"bpp_uri,omitempty"`
+	CreatedAt              string                    `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              string                    `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CascadedLevel          int32                     `protobuf:"varint,16,opt,name=cascaded_level,json=cascadedLevel,proto3" json:"cascaded_level,omitempty"`
+	CurrentRespondent      *v1.RespondentParty       `protobuf:"bytes,17,opt,name=current_respondent,json=currentRespondent,proto3" json:"current_respondent,omitempty"`
+	RespondentChain        []*v1.RespondentParty     `protobuf:"bytes,18,rep,name=respondent_chain,json=respondentChain,proto3" json:"respondent_chain,omitempty"`
+	RespondentStatus       string                    `protobuf:"bytes,19,opt,name=respondent_status,json=respondentStatus,proto3" json:"respondent_status,omitempty"`
+	Rating                 Rating                    `protobuf:"varint,20,opt,name=rating,proto3,enum=igm.v2.Rating" json:"rating,omitempty"`
+	Resolution             *v1.Resolution            `protobuf:"bytes,21,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	ResolutionProvider     *v1.ResolutionProvider    `protobuf:"bytes,22,opt,name=resolution_provider,json=resolutionProvider,proto3" json:"resolution_provider,omitempty"`
+	ComplainantActions     []*v1.ComplainantAction   `protobuf:"bytes,23,rep,name=complainant_actions,json=complainantActions,proto3" json:"complainant_actions,omitempty"`
+	RespondentActions      []*v1.RespondentAction    `protobuf:"bytes,24,rep,name=respondent_actions,json=respondentActions,proto3" json:"respondent_actions,omitempty"`
+	Gro                    *v1.Gro                   `protobuf:"bytes,25,opt,name=gro,proto3" json:"gro,omitempty"`
+	AdditionalDesc         *v1.AdditionalDescription `protobuf:"bytes,26,opt,name=additional_desc,json=additionalDesc,proto3" json:"additional_desc,omitempty"`
+	ComplainantInfo        *v1.ComplainantInfo       `protobuf:"bytes,27,opt,name=complainant_info,json=complainantInfo,proto3" json:"complainant_info,omitempty"`
+	OrderDetails           *v1.OrderDetails          `protobuf:"bytes,28,opt,name=order_details,json=orderDetails,proto3" json:"order_details,omitempty"`
+	ExpectedResponseTime   string                    `protobuf:"bytes,29,opt,name=expected_response_time,json=expectedResponseTime,proto3" json:"expected_response_time,omitempty"` //ISO-8601 duration
+	ExpectedResolutionTime string                    `protobuf:"bytes,30,opt,name=expected_resolution_time,json=expectedResolutionTime,proto3" json:"expected_resolution_time,omitempty"`
+	RespondBy              string                    `protobuf:"bytes,31,opt,name=respond_by,json=respondBy,proto3" json:"respond_by,omitempty"`
+	ResolveBy              string                    `protobuf:"bytes,32,opt,name=resolve_by,json=resolveBy,proto3" json:"resolve_by,omitempty"`
+	ResponseBreachedAt     string                    `protobuf:"bytes,33,opt,name=response_breached_at,json=responseBreachedAt,proto3" json:"response_breached_at,omitempty"`
+	ResolutionBreachedAt   string                    `protobuf:"bytes,34,opt,name=resolution_breached_at,json=resolutionBreachedAt,proto3" json:"resolution_breached_at,omitempty"`
+	ResolvedAt             string                    `protobuf:"bytes,35,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	AutoClosedAt           string                    `protobuf:"bytes,36,opt,name=auto_closed_at,json=autoClosedAt,proto3" json:"auto_closed_at,omitempty"`
+	OdrProviderId          string                    `protobuf:"bytes,37,opt,name=odr_provider_id,json=odrProviderId,proto3" json:"odr_provider_id,omitempty"`
+	DisputeRaisedAt        string                    `protobuf:"bytes,38,opt,name=dispute_raised_at,json=disputeRaisedAt,proto3" json:"dispute_raised_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_igm_v2_issue_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_api_proto_igm_v2_issue_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Issue) GetIssueId() string {
+	if x != nil {
+		return x.IssueId
+	}
+	return ""
+}
+
+func (x *Issue) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Issue) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Issue) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Issue) GetCategory() IssueCategory {
+	if x != nil {
+		return x.Category
+	}
+	return IssueCategory_ISSUE_CATEGORY_UNSPECIFIED
+}
+
+func (x *Issue) GetSubCategory() string {
+	if x != nil {
+		return x.SubCategory
+	}
+	return ""
+}
+
+func (x *Issue) GetIssueType() IssueType {
+	if x != nil {
+		return x.IssueType
+	}
+	return IssueType_ISSUE_TYPE_UNSPECIFIED
+}
+
+func (x *Issue) GetStatus() IssueStatus {
+	if x != nil {
+		return x.Status
+	}
+	return IssueStatus_ISSUE_STATUS_UNSPECIFIED
+}
+
+func (x *Issue) GetDescriptionShort() string {
+	if x != nil {
+		return x.DescriptionShort
+	}
+	return ""
+}
+
+func (x *Issue) GetDescriptionLong() string {
+	if x != nil {
+		return x.DescriptionLong
+	}
+	return ""
+}
+
+func (x *Issue) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+func (x *Issue) GetBppId() string {
+	if x != nil {
+		return x.BppId
+	}
+	return ""
+}
+
+func (x *Issue) GetBppUri() string {
+	if x != nil {
+		return x.BppUri
+	}
+	return ""
+}
+
+func (x *Issue) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetCascadedLevel() int32 {
+	if x != nil {
+		return x.CascadedLevel
+	}
+	return 0
+}
+
+func (x *Issue) GetCurrentRespondent() *v1.RespondentParty {
+	if x != nil {
+		return x.CurrentRespondent
+	}
+	return nil
+}
+
+func (x *Issue) GetRespondentChain() []*v1.RespondentParty {
+	if x != nil {
+		return x.RespondentChain
+	}
+	return nil
+}
+
+func (x *Issue) GetRespondentStatus() string {
+	if x != nil {
+		return x.RespondentStatus
+	}
+	return ""
+}
+
+func (x *Issue) GetRating() Rating {
+	if x != nil {
+		return x.Rating
+	}
+	return Rating_RATING_UNSPECIFIED
+}
+
+func (x *Issue) GetResolution() *v1.Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return nil
+}
+
+func (x *Issue) GetResolutionProvider() *v1.ResolutionProvider {
+	if x != nil {
+		return x.ResolutionProvider
+	}
+	return nil
+}
+
+func (x *Issue) GetComplainantActions() []*v1.ComplainantAction {
+	if x != nil {
+		return x.ComplainantActions
+	}
+	return nil
+}
+
+func (x *Issue) GetRespondentActions() []*v1.RespondentAction {
+	if x != nil {
+		return x.RespondentActions
+	}
+	return nil
+}
+
+func (x *Issue) GetGro() *v1.Gro {
+	if x != nil {
+		return x.Gro
+	}
+	return nil
+}
+
+func (x *Issue) GetAdditionalDesc() *v1.AdditionalDescription {
+	if x != nil {
+		return x.AdditionalDesc
+	}
+	return nil
+}
+
+func (x *Issue) GetComplainantInfo() *v1.ComplainantInfo {
+	if x != nil {
+		return x.ComplainantInfo
+	}
+	return nil
+}
+
+func (x *Issue) GetOrderDetails() *v1.OrderDetails {
+	if x != nil {
+		return x.OrderDetails
+	}
+	return nil
+}
+
+func (x *Issue) GetExpectedResponseTime() string {
+	if x != nil {
+		return x.ExpectedResponseTime
+	}
+	return ""
+}
+
+func (x *Issue) GetExpectedResolutionTime() string {
+	if x != nil {
+		return x.ExpectedResolutionTime
+	}
+	return ""
+}
+
+func (x *Issue) GetRespondBy() string {
+	if x != nil {
+		return x.RespondBy
+	}
+	return ""
+}
+
+func (x *Issue) GetResolveBy() string {
+	if x != nil {
+		return x.ResolveBy
+	}
+	return ""
+}
+
+func (x *Issue) GetResponseBreachedAt() string {
+	if x != nil {
+		return x.ResponseBreachedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetResolutionBreachedAt() string {
+	if x != nil {
+		return x.ResolutionBreachedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetAutoClosedAt() string {
+	if x != nil {
+		return x.AutoClosedAt
+	}
+	return ""
+}
+
+func (x *Issue) GetOdrProviderId() string {
+	if x != nil {
+		return x.OdrProviderId
+	}
+	return ""
+}
+
+func (x *Issue) GetDisputeRaisedAt() string {
+	if x != nil {
+		return x.DisputeRaisedAt
+	}
+	return ""
+}
+
+var File_api_proto_igm_v2_issue_proto protoreflect.FileDescriptor
+
+const file_api_proto_igm_v2_issue_proto_rawDesc = "" +
+	"\n" +
+	"\x1capi/proto/igm/v2/issue.proto\x12\x06igm.v2\x1a\x1capi/proto/igm/v1/issue.proto\x1a\x1bbuf/validate/validate.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xd6\x04\n" +
+	"\x12CreateIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\border_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x12=\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x15.igm.v2.IssueCategoryB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\bcategory\x12,\n" +
+	"\fsub_category\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18 R\vsubCategory\x12<\n" +
+	"\n" +
+	"issue_type\x18\x05 \x01(\x0e2\x11.igm.v2.IssueTypeB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\tissueType\x12,\n" +
+	"\vdescription\x18\x06 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vdescription\x123\n" +
+	"\x10long_description\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x80 R\x0flongDescription\x12.\n" +
+	"\n" +
+	"image_urls\x18\b \x03(\tB\x0f\xbaH\f\x92\x01\t\x10\n" +
+	"\"\x05r\x03\x88\x01\x01R\timageUrls\x12F\n" +
+	"\x0fadditional_desc\x18\t \x01(\v2\x1d.igm.v1.AdditionalDescriptionR\x0eadditionalDesc\x123\n" +
+	"\x05items\x18\n" +
+	" \x03(\v2\x11.igm.v1.IssueItemB\n" +
+	"\xbaH\a\x92\x01\x04\b\x01\x10dR\x05items\x128\n" +
+	"\x0eattachment_ids\x18\v \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\n" +
+	"\x18\x01\"\x05r\x03\xb0\x01\x01R\rattachmentIds\"\xfe\x01\n" +
+	"\x13CreateIssueResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12+\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.igm.v2.IssueStatusR\x06status\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x06 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\a \x01(\tR\vondcMessage\"\xca\x02\n" +
+	"\x12UpdateIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12%\n" +
+	"\border_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x12:\n" +
+	"\n" +
+	"issue_type\x18\x04 \x01(\x0e2\x11.igm.v2.IssueTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\tissueType\x127\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x13.igm.v2.IssueStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12K\n" +
+	"\x1dcomplainant_action_short_desc\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x1acomplainantActionShortDesc\"\xbc\x01\n" +
+	"\x13UpdateIssueResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.igm.v2.IssueStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xb7\x02\n" +
+	"\x11CloseIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12%\n" +
+	"\border_id\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aorderId\x122\n" +
+	"\x06rating\x18\x04 \x01(\x0e2\x0e.igm.v2.RatingB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06rating\x127\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x13.igm.v2.IssueStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\x12A\n" +
+	"\x18complaint_act_short_desc\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\x15complaintActShortDesc\"\xb9\x01\n" +
+	"\x12CloseIssueResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.igm.v2.IssueStatusR\x06status\x12\x1b\n" +
+	"\tclosed_at\x18\x03 \x01(\tR\bclosedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xc3\x01\n" +
+	"\x17AcceptResolutionRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x122\n" +
+	"\x06rating\x18\x03 \x01(\x0e2\x0e.igm.v2.RatingB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06rating\x12'\n" +
+	"\n" +
+	"short_desc\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tshortDesc\"\xbf\x01\n" +
+	"\x18AcceptResolutionResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.igm.v2.IssueStatusR\x06status\x12\x1b\n" +
+	"\tclosed_at\x18\x03 \x01(\tR\bclosedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x04 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x05 \x01(\tR\vondcMessage\"\xbe\x01\n" +
+	"\x17RejectResolutionRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\x12\"\n" +
+	"\x06reason\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x06reason\x122\n" +
+	"\x15escalate_to_grievance\x18\x04 \x01(\bR\x13escalateToGrievance\"\xf3\x01\n" +
+	"\x18RejectResolutionResponse\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12+\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x13.igm.v2.IssueStatusR\x06status\x120\n" +
+	"\n" +
+	"issue_type\x18\x03 \x01(\x0e2\x11.igm.v2.IssueTypeR\tissueType\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x12\x1b\n" +
+	"\tondc_sent\x18\x05 \x01(\bR\bondcSent\x12!\n" +
+	"\fondc_message\x18\x06 \x01(\tR\vondcMessage\"^\n" +
+	"\x0fGetIssueRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12%\n" +
+	"\bissue_id\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\aissueId\"7\n" +
+	"\x10GetIssueResponse\x12#\n" +
+	"\x05issue\x18\x01 \x01(\v2\r.igm.v2.IssueR\x05issue\"\xc6\x04\n" +
+	"\x11ListIssuesRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12&\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSize\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.igm.v2.IssueStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12;\n" +
+	"\bcategory\x18\x04 \x01(\x0e2\x15.igm.v2.IssueCategoryB\b\xbaH\x05\x82\x01\x02\x10\x01R\bcategory\x12:\n" +
+	"\n" +
+	"issue_type\x18\x05 \x01(\x0e2\x11.igm.v2.IssueTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\tissueType\x12#\n" +
+	"\border_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aorderId\x12!\n" +
+	"\fcreated_from\x18\a \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\b \x01(\tR\tcreatedTo\x12*\n" +
+	"\x0ehas_resolution\x18\t \x01(\bH\x00R\rhasResolution\x88\x01\x01\x128\n" +
+	"\asort_by\x18\n" +
+	" \x01(\tB\x1f\xbaH\x1cr\x1aR\x00R\n" +
+	"created_atR\n" +
+	"updated_atR\x06sortBy\x121\n" +
+	"\n" +
+	"sort_order\x18\v \x01(\tB\x12\xbaH\x0fr\rR\x00R\x03ASCR\x04DESCR\tsortOrder\x12 \n" +
+	"\x06cursor\x18\f \x01(\tB\b\xbaH\x05r\x03\x18\x80\x04R\x06cursorB\x11\n" +
+	"\x0f_has_resolution\"}\n" +
+	"\x12ListIssuesResponse\x12%\n" +
+	"\x06issues\x18\x01 \x03(\v2\r.igm.v2.IssueR\x06issues\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xf8\x03\n" +
+	"\x13SearchIssuesRequest\x12$\n" +
+	"\auser_id\x18\x01 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\x05query\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\x80\x04R\x05query\x125\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.igm.v2.IssueStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12;\n" +
+	"\bcategory\x18\x04 \x01(\x0e2\x15.igm.v2.IssueCategoryB\b\xbaH\x05\x82\x01\x02\x10\x01R\bcategory\x12:\n" +
+	"\n" +
+	"issue_type\x18\x05 \x01(\x0e2\x11.igm.v2.IssueTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\tissueType\x12#\n" +
+	"\border_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\aorderId\x12!\n" +
+	"\fcreated_from\x18\a \x01(\tR\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\b \x01(\tR\tcreatedTo\x12*\n" +
+	"\x0ehas_resolution\x18\t \x01(\bH\x00R\rhasResolution\x88\x01\x01\x12\x1b\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x04page\x12&\n" +
+	"\tpage_size\x18\v \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x00R\bpageSizeB\x11\n" +
+	"\x0f_has_resolution\"c\n" +
+	"\x0eSearchIssueHit\x12#\n" +
+	"\x05issue\x18\x01 \x01(\v2\r.igm.v2.IssueR\x05issue\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"\x94\x01\n" +
+	"\x14SearchIssuesResponse\x12*\n" +
+	"\x04hits\x18\x01 \x03(\v2\x16.igm.v2.SearchIssueHitR\x04hits\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xcc\r\n" +
+	"\x05Issue\x12\x19\n" +
+	"\bissue_id\x18\x01 \x01(\tR\aissueId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\tR\rtransactionId\x121\n" +
+	"\bcategory\x18\x05 \x01(\x0e2\x15.igm.v2.IssueCategoryR\bcategory\x12!\n" +
+	"\fsub_category\x18\x06 \x01(\tR\vsubCategory\x120\n" +
+	"\n" +
+	"issue_type\x18\a \x01(\x0e2\x11.igm.v2.IssueTypeR\tissueType\x12+\n" +
+	"\x06status\x18\b \x01(\x0e2\x13.igm.v2.IssueStatusR\x06status\x12+\n" +
+	"\x11description_short\x18\t \x01(\tR\x10descriptionShort\x12)\n" +
+	"\x10description_long\x18\n" +
+	" \x01(\tR\x0fdescriptionLong\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\v \x03(\tR\timageUrls\x12\x15\n" +
+	"\x06bpp_id\x18\f \x01(\tR\x05bppId\x12\x17\n" +
+	"\abpp_uri\x18\r \x01(\tR\x06bppUri\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\x12%\n" +
+	"\x0ecascaded_level\x18\x10 \x01(\x05R\rcascadedLevel\x12F\n" +
+	"\x12current_respondent\x18\x11 \x01(\v2\x17.igm.v1.RespondentPartyR\x11currentRespondent\x12B\n" +
+	"\x10respondent_chain\x18\x12 \x03(\v2\x17.igm.v1.RespondentPartyR\x0frespondentChain\x12+\n" +
+	"\x11respondent_status\x18\x13 \x01(\tR\x10respondentStatus\x12&\n" +
+	"\x06rating\x18\x14 \x01(\x0e2\x0e.igm.v2.RatingR\x06rating\x122\n" +
+	"\n" +
+	"resolution\x18\x15 \x01(\v2\x12.igm.v1.ResolutionR\n" +
+	"resolution\x12K\n" +
+	"\x13resolution_provider\x18\x16 \x01(\v2\x1a.igm.v1.ResolutionProviderR\x12resolutionProvider\x12J\n" +
+	"\x13complainant_actions\x18\x17 \x03(\v2\x19.igm.v1.ComplainantActionR\x12complainantActions\x12G\n" +
+	"\x12respondent_actions\x18\x18 \x03(\v2\x18.igm.v1.RespondentActionR\x11respondentActions\x12\x1d\n" +
+	"\x03gro\x18\x19 \x01(\v2\v.igm.v1.GroR\x03gro\x12F\n" +
+	"\x0fadditional_desc\x18\x1a \x01(\v2\x1d.igm.v1.AdditionalDescriptionR\x0eadditionalDesc\x12B\n" +
+	"\x10complainant_info\x18\x1b \x01(\v2\x17.igm.v1.ComplainantInfoR\x0fcomplainantInfo\x129\n" +
+	"\rorder_details\x18\x1c \x01(\v2\x14.igm.v1.OrderDetailsR\forderDetails\x124\n" +
+	"\x16expected_response_time\x18\x1d \x01(\tR\x14expectedResponseTime\x128\n" +
+	"\x18expected_resolution_time\x18\x1e \x01(\tR\x16expectedResolutionTime\x12\x1d\n" +
+	"\n" +
+	"respond_by\x18\x1f \x01(\tR\trespondBy\x12\x1d\n" +
+	"\n" +
+	"resolve_by\x18  \x01(\tR\tresolveBy\x120\n" +
+	"\x14response_breached_at\x18! \x01(\tR\x12responseBreachedAt\x124\n" +
+	"\x16resolution_breached_at\x18\" \x01(\tR\x14resolutionBreachedAt\x12\x1f\n" +
+	"\vresolved_at\x18# \x01(\tR\n" +
+	"resolvedAt\x12$\n" +
+	"\x0eauto_closed_at\x18$ \x01(\tR\fautoClosedAt\x12&\n" +
+	"\x0fodr_provider_id\x18% \x01(\tR\rodrProviderId\x12*\n" +
+	"\x11dispute_raised_at\x18& \x01(\tR\x0fdisputeRaisedAt*[\n" +
+	"\vIssueStatus\x12\x1c\n" +
+	"\x18ISSUE_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11ISSUE_STATUS_OPEN\x10\x01\x12\x17\n" +
+	"\x13ISSUE_STATUS_CLOSED\x10\x02*\xd1\x02\n" +
+	"\rIssueCategory\x12\x1e\n" +
+	"\x1aISSUE_CATEGORY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ISSUE_CATEGORY_ORDER\x10\x01\x12\x1e\n" +
+	"\x1aISSUE_CATEGORY_FULFILLMENT\x10\x02\x12\x1a\n" +
+	"\x16ISSUE_CATEGORY_PAYMENT\x10\x03\x12\x17\n" +
+	"\x13ISSUE_CATEGORY_ITEM\x10\x04\x12\x18\n" +
+	"\x14ISSUE_CATEGORY_AGENT\x10\x05\x12\x1b\n" +
+	"\x17ISSUE_CATEGORY_CUSTOMER\x10\x06\x12\x1c\n" +
+	"\x18ISSUE_CATEGORY_TECHNICAL\x10\a\x12\x1d\n" +
+	"\x19ISSUE_CATEGORY_VISIBILITY\x10\b\x12 \n" +
+	"\x1cISSUE_CATEGORY_POLICY_BREACH\x10\t\x12\x1b\n" +
+	"\x17ISSUE_CATEGORY_BUSINESS\x10\n" +
+	"*o\n" +
+	"\tIssueType\x12\x1a\n" +
+	"\x16ISSUE_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10ISSUE_TYPE_ISSUE\x10\x01\x12\x18\n" +
+	"\x14ISSUE_TYPE_GRIEVANCE\x10\x02\x12\x16\n" +
+	"\x12ISSUE_TYPE_DISPUTE\x10\x03*N\n" +
+	"\x06Rating\x12\x16\n" +
+	"\x12RATING_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10RATING_THUMBS_UP\x10\x01\x12\x16\n" +
+	"\x12RATING_THUMBS_DOWN\x10\x022\xf8\b\n" +
+	"\fIssueService\x12|\n" +
+	"\vCreateIssue\x12\x1a.igm.v2.CreateIssueRequest\x1a\x1b.igm.v2.CreateIssueResponse\"4\x92A\x1c*\x1aIssueServiceV2_CreateIssue\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v2/issues\x12\x87\x01\n" +
+	"\vUpdateIssue\x12\x1a.igm.v2.UpdateIssueRequest\x1a\x1b.igm.v2.UpdateIssueResponse\"?\x92A\x1c*\x1aIssueServiceV2_UpdateIssue\x82\xd3\xe4\x93\x02\x1a:\x01*2\x15/v2/issues/{issue_id}\x12\x89\x01\n" +
+	"\n" +
+	"CloseIssue\x12\x19.igm.v2.CloseIssueRequest\x1a\x1a.igm.v2.CloseIssueResponse\"D\x92A\x1b*\x19IssueServiceV2_CloseIssue\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v2/issues/{issue_id}:close\x12\xac\x01\n" +
+	"\x10AcceptResolution\x12\x1f.igm.v2.AcceptResolutionRequest\x1a .igm.v2.AcceptResolutionResponse\"U\x92A!*\x1fIssueServiceV2_AcceptResolution\x82\xd3\xe4\x93\x02+:\x01*\"&/v2/issues/{issue_id}:acceptResolution\x12\xac\x01\n" +
+	"\x10RejectResolution\x12\x1f.igm.v2.RejectResolutionRequest\x1a .igm.v2.RejectResolutionResponse\"U\x92A!*\x1fIssueServiceV2_RejectResolution\x82\xd3\xe4\x93\x02+:\x01*\"&/v2/issues/{issue_id}:rejectResolution\x12x\n" +
+	"\bGetIssue\x12\x17.igm.v2.GetIssueRequest\x1a\x18.igm.v2.GetIssueResponse\"9\x92A\x19*\x17IssueServiceV2_GetIssue\x82\xd3\xe4\x93\x02\x17\x12\x15/v2/issues/{issue_id}\x12u\n" +
+	"\n" +
+	"ListIssues\x12\x19.igm.v2.ListIssuesRequest\x1a\x1a.igm.v2.ListIssuesResponse\"0\x92A\x1b*\x19IssueServiceV2_ListIssues\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v2/issues\x12\x84\x01\n" +
+	"\fSearchIssues\x12\x1b.igm.v2.SearchIssuesRequest\x1a\x1c.igm.v2.SearchIssuesResponse\"9\x92A\x1d*\x1bIssueServiceV2_SearchIssues\x82\xd3\xe4\x93\x02\x13\x12\x11/v2/issues:searchB Z\x1eigm-svc/api/proto/igm/v2;igmv2b\x06proto3"
+
+var (
+	file_api_proto_igm_v2_issue_proto_rawDescOnce sync.Once
+	file_api_proto_igm_v2_issue_proto_rawDescData []byte
+)
+
+func file_api_proto_igm_v2_issue_proto_rawDescGZIP() []byte {
+	file_api_proto_igm_v2_issue_proto_rawDescOnce.Do(func() {
+		file_api_proto_igm_v2_issue_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_proto_igm_v2_issue_proto_rawDesc), len(file_api_proto_igm_v2_issue_proto_rawDesc)))
+	})
+	return file_api_proto_igm_v2_issue_proto_rawDescData
+}
+
+var file_api_proto_igm_v2_issue_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_proto_igm_v2_issue_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_api_proto_igm_v2_issue_proto_goTypes = []any{
+	(IssueStatus)(0),                 // 0: igm.v2.IssueStatus
+	(IssueCategory)(0),               // 1: igm.v2.IssueCategory
+	(IssueType)(0),                   // 2: igm.v2.IssueType
+	(Rating)(0),                      // 3: igm.v2.Rating
+	(*CreateIssueRequest)(nil),       // 4: igm.v2.CreateIssueRequest
+	(*CreateIssueResponse)(nil),      // 5: igm.v2.CreateIssueResponse
+	(*UpdateIssueRequest)(nil),       // 6: igm.v2.UpdateIssueRequest
+	(*UpdateIssueResponse)(nil),      // 7: igm.v2.UpdateIssueResponse
+	(*CloseIssueRequest)(nil),        // 8: igm.v2.CloseIssueRequest
+	(*CloseIssueResponse)(nil),       // 9: igm.v2.CloseIssueResponse
+	(*AcceptResolutionRequest)(nil),  // 10: igm.v2.AcceptResolutionRequest
+	(*AcceptResolutionResponse)(nil), // 11: igm.v2.AcceptResolutionResponse
+	(*RejectResolutionRequest)(nil),  // 12: igm.v2.RejectResolutionRequest
+	(*RejectResolutionResponse)(nil), // 13: igm.v2.RejectResolutionResponse
+	(*GetIssueRequest)(nil),          // 14: igm.v2.GetIssueRequest
+	(*GetIssueResponse)(nil),         // 15: igm.v2.GetIssueResponse
+	(*ListIssuesRequest)(nil),        // 16: igm.v2.ListIssuesRequest
+	(*ListIssuesResponse)(nil),       // 17: igm.v2.ListIssuesResponse
+	(*SearchIssuesRequest)(nil),      // 18: igm.v2.SearchIssuesRequest
+	(*SearchIssueHit)(nil),           // 19: igm.v2.SearchIssueHit
+	(*SearchIssuesResponse)(nil),     // 20: igm.v2.SearchIssuesResponse
+	(*Issue)(nil),                    // 21: igm.v2.Issue
+	(*v1.AdditionalDescription)(nil), // 22: igm.v1.AdditionalDescription
+	(*v1.IssueItem)(nil),             // 23: igm.v1.IssueItem
+	(*v1.RespondentParty)(nil),       // 24: igm.v1.RespondentParty
+	(*v1.Resolution)(nil),            // 25: igm.v1.Resolution
+	(*v1.ResolutionProvider)(nil),    // 26: igm.v1.ResolutionProvider
+	(*v1.ComplainantAction)(nil),     // 27: igm.v1.ComplainantAction
+	(*v1.RespondentAction)(nil),      // 28: igm.v1.RespondentAction
+	(*v1.Gro)(nil),                   // 29: igm.v1.Gro
+	(*v1.ComplainantInfo)(nil),       // 30: igm.v1.ComplainantInfo
+	(*v1.OrderDetails)(nil),          // 31: igm.v1.OrderDetails
+}
+var file_api_proto_igm_v2_issue_proto_depIdxs = []int32{
+	1,  // 0: igm.v2.CreateIssueRequest.category:type_name -> igm.v2.IssueCategory
+	2,  // 1: igm.v2.CreateIssueRequest.issue_type:type_name -> igm.v2.IssueType
+	22, // 2: igm.v2.CreateIssueRequest.additional_desc:type_name -> igm.v1.AdditionalDescription
+	23, // 3: igm.v2.CreateIssueRequest.items:type_name -> igm.v1.IssueItem
+	0,  // 4: igm.v2.CreateIssueResponse.status:type_name -> igm.v2.IssueStatus
+	2,  // 5: igm.v2.UpdateIssueRequest.issue_type:type_name -> igm.v2.IssueType
+	0,  // 6: igm.v2.UpdateIssueRequest.status:type_name -> igm.v2.IssueStatus
+	0,  // 7: igm.v2.UpdateIssueResponse.status:type_name -> igm.v2.IssueStatus
+	3,  // 8: igm.v2.CloseIssueRequest.rating:type_name -> igm.v2.Rating
+	0,  // 9: igm.v2.CloseIssueRequest.status:type_name -> igm.v2.IssueStatus
+	0,  // 10: igm.v2.CloseIssueResponse.status:type_name -> igm.v2.IssueStatus
+	3,  // 11: igm.v2.AcceptResolutionRequest.rating:type_name -> igm.v2.Rating
+	0,  // 12: igm.v2.AcceptResolutionResponse.status:type_name -> igm.v2.IssueStatus
+	0,  // 13: igm.v2.RejectResolutionResponse.status:type_name -> igm.v2.IssueStatus
+	2,  // 14: igm.v2.RejectResolutionResponse.issue_type:type_name -> igm.v2.IssueType
+	21, // 15: igm.v2.GetIssueResponse.issue:type_name -> igm.v2.Issue
+	0,  // 16: igm.v2.ListIssuesRequest.status:type_name -> igm.v2.IssueStatus
+	1,  // 17: igm.v2.ListIssuesRequest.category:type_name -> igm.v2.IssueCategory
+	2,  // 18: igm.v2.ListIssuesRequest.issue_type:type_name -> igm.v2.IssueType
+	21, // 19: igm.v2.ListIssuesResponse.issues:type_name -> igm.v2.Issue
+	0,  // 20: igm.v2.SearchIssuesRequest.status:type_name -> igm.v2.IssueStatus
+	1,  // 21: igm.v2.SearchIssuesRequest.category:type_name -> igm.v2.IssueCategory
+	2,  // 22: igm.v2.SearchIssuesRequest.issue_type:type_name -> igm.v2.IssueType
+	21, // 23: igm.v2.SearchIssueHit.issue:type_name -> igm.v2.Issue
+	19, // 24: igm.v2.SearchIssuesResponse.hits:type_name -> igm.v2.SearchIssueHit
+	1,  // 25: igm.v2.Issue.category:type_name -> igm.v2.IssueCategory
+	2,  // 26: igm.v2.Issue.issue_type:type_name -> igm.v2.IssueType
+	0,  // 27: igm.v2.Issue.status:type_name -> igm.v2.IssueStatus
+	24, // 28: igm.v2.Issue.current_respondent:type_name -> igm.v1.RespondentParty
+	24, // 29: igm.v2.Issue.respondent_chain:type_name -> igm.v1.RespondentParty
+	3,  // 30: igm.v2.Issue.rating:type_name -> igm.v2.Rating
+	25, // 31: igm.v2.Issue.resolution:type_name -> igm.v1.Resolution
+	26, // 32: igm.v2.Issue.resolution_provider:type_name -> igm.v1.ResolutionProvider
+	27, // 33: igm.v2.Issue.complainant_actions:type_name -> igm.v1.ComplainantAction
+	28, // 34: igm.v2.Issue.respondent_actions:type_name -> igm.v1.RespondentAction
+	29, // 35: igm.v2.Issue.gro:type_name -> igm.v1.Gro
+	22, // 36: igm.v2.Issue.additional_desc:type_name -> igm.v1.AdditionalDescription
+	30, // 37: igm.v2.Issue.complainant_info:type_name -> igm.v1.ComplainantInfo
+	31, // 38: igm.v2.Issue.order_details:type_name -> igm.v1.OrderDetails
+	4,  // 39: igm.v2.IssueService.CreateIssue:input_type -> igm.v2.CreateIssueRequest
+	6,  // 40: igm.v2.IssueService.UpdateIssue:input_type -> igm.v2.UpdateIssueRequest
+	8,  // 41: igm.v2.IssueService.CloseIssue:input_type -> igm.v2.CloseIssueRequest
+	10, // 42: igm.v2.IssueService.AcceptResolution:input_type -> igm.v2.AcceptResolutionRequest
+	12, // 43: igm.v2.IssueService.RejectResolution:input_type -> igm.v2.RejectResolutionRequest
+	14, // 44: igm.v2.IssueService.GetIssue:input_type -> igm.v2.GetIssueRequest
+	16, // 45: igm.v2.IssueService.ListIssues:input_type -> igm.v2.ListIssuesRequest
+	18, // 46: igm.v2.IssueService.SearchIssues:input_type -> igm.v2.SearchIssuesRequest
+	5,  // 47: igm.v2.IssueService.CreateIssue:output_type -> igm.v2.CreateIssueResponse
+	7,  // 48: igm.v2.IssueService.UpdateIssue:output_type -> igm.v2.UpdateIssueResponse
+	9,  // 49: igm.v2.IssueService.CloseIssue:output_type -> igm.v2.CloseIssueResponse
+	11, // 50: igm.v2.IssueService.AcceptResolution:output_type -> igm.v2.AcceptResolutionResponse
+	13, // 51: igm.v2.IssueService.RejectResolution:output_type -> igm.v2.RejectResolutionResponse
+	15, // 52: igm.v2.IssueService.GetIssue:output_type -> igm.v2.GetIssueResponse
+	17, // 53: igm.v2.IssueService.ListIssues:output_type -> igm.v2.ListIssuesResponse
+	20, // 54: igm.v2.IssueService.SearchIssues:output_type -> igm.v2.SearchIssuesResponse
+	47, // [47:55] is the sub-list for method output_type
+	39, // [39:47] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_igm_v2_issue_proto_init() }
+func file_api_proto_igm_v2_issue_proto_init() {
+	if File_api_proto_igm_v2_issue_proto != nil {
+		return
+	}
+	file_api_proto_igm_v2_issue_proto_msgTypes[12].OneofWrappers = []any{}
+	file_api_proto_igm_v2_issue_proto_msgTypes[14].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_igm_v2_issue_proto_rawDesc), len(file_api_proto_igm_v2_issue_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_proto_igm_v2_issue_proto_goTypes,
+		DependencyIndexes: file_api_proto_igm_v2_issue_proto_depIdxs,
+		EnumInfos:         file_api_proto_igm_v2_issue_proto_enumTypes,
+		MessageInfos:      file_api_proto_igm_v2_issue_proto_msgTypes,
+	}.Build()
+	File_api_proto_igm_v2_issue_proto = out.File
+	file_api_proto_igm_v2_issue_proto_goTypes = nil
+	file_api_proto_igm_v2_issue_proto_depIdxs = nil
+}
@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
+	"igm-svc/internal/auth"
 	"igm-svc/internal/config"
 	"igm-svc/internal/handlers"
 	"igm-svc/internal/repository"
 	"igm-svc/internal/server"
 	"igm-svc/internal/services"
+	"igm-svc/pkg/eventbus"
+	"igm-svc/pkg/events"
+	"igm-svc/pkg/notify"
+	"igm-svc/pkg/storage"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	_ "time/tzdata" // notification quiet hours use the user's timezone
 
 	"github.com/joho/godotenv"
 )
@@ -34,22 +43,212 @@ func main() {
 	log.Println("connected to redis")
 	issuRepo := repository.NewIssueRepository(db)
 	OnIssueRepo := repository.NewOnIssueRepository(db)
-	redisRepo := repository.NewRedisRepository(redisClient)
+	slaPolicyRepo := repository.NewSLAPolicyRepository(db)
+	issueInfoRepo := repository.NewIssueInfoRepository(db)
+	respondentRepo := repository.NewIssueRespondentRepository(db)
+	odrProviderRepo := repository.NewFileOdrProviderRepository(cfg.OdrProvidersFile)
+	outboxRepo := repository.NewOutboxRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	supportRepo := repository.NewSupportRepository(db)
+	attachmentRepo := repository.NewAttachmentRepository(db)
+	redisRepo := repository.NewRedisRepository(redisClient, eventbus.PublisherConfig{
+		MaxLen:      int64(cfg.EventStreamMaxLen),
+		IssueMaxLen: int64(cfg.EventIssueStreamMaxLen),
+		IssueTTL:    cfg.EventIssueStreamTTL,
+	})
 
-	ondcClient := services.NewOndcClient(cfg.SubscriberID, cfg.BapURI)
+	var eventBackend events.EventPublisher
+	switch cfg.EventPublisher {
+	case "kafka":
+		eventBackend = events.NewKafkaPublisher(strings.Split(cfg.KafkaBrokers, ","), cfg.KafkaTopic)
+	case "memory":
+		eventBackend = events.NewMemoryPublisher()
+	default:
+		eventBackend = events.NewRedisPublisher(redisClient, cfg.DomainEventStream, int64(cfg.EventStreamMaxLen))
+	}
+	transactor := repository.NewTransactor(db)
+	eventPublisher := services.NewOutboxPublisher(outboxRepo, transactor)
+	log.Printf("domain events published via %s", cfg.EventPublisher)
 
 	serviceConfig := &services.Config{
 		SubcriberID: cfg.SubscriberID,
 		BAPURI:      cfg.BapURI,
+		Domain:      cfg.Domain,
+	}
+
+	slaPolicyService := services.NewSLAPolicyService(slaPolicyRepo, cfg.SLAPolicyFile)
+	if err := slaPolicyService.Load(context.Background()); err != nil {
+		log.Printf("failed to load sla policies, using defaults:%v", err)
+	}
+
+	var attachmentStore storage.Store
+	var attachmentDownloads http.Handler
+	switch cfg.AttachmentStore {
+	case "s3":
+		attachmentStore, err = storage.NewS3Store(storage.S3Config{
+			Endpoint:  cfg.AttachmentS3Endpoint,
+			Region:    cfg.AttachmentS3Region,
+			Bucket:    cfg.AttachmentS3Bucket,
+			AccessKey: cfg.AttachmentS3AccessKey,
+			SecretKey: cfg.AttachmentS3SecretKey,
+			UseSSL:    cfg.AttachmentS3UseSSL,
+		})
+	default:
+		var localStore *storage.LocalStore
+		localStore, err = storage.NewLocalStore(cfg.AttachmentDir, cfg.AttachmentPublicBaseURL, cfg.AttachmentSigningKey)
+		if err == nil {
+			attachmentStore, attachmentDownloads = localStore, localStore.Handler()
+		}
+	}
+	if err != nil {
+		log.Fatalf("failed to create attachment store:%v", err)
+	}
+	log.Printf("attachments stored in %s store", cfg.AttachmentStore)
+	attachmentService := services.NewAttachmentService(attachmentRepo, attachmentStore, services.AttachmentConfig{
+		MaxBytes:          int64(cfg.AttachmentMaxBytes),
+		MaxImageDimension: cfg.AttachmentMaxImageDimension,
+		URLTTL:            cfg.AttachmentURLTTL,
+	})
+
+	ondcClient := services.NewOndcClient(cfg.SubscriberID, cfg.BapURI, cfg.Domain, attachmentService)
+
+	issueService := services.NewIssueService(issuRepo, respondentRepo, redisRepo, eventPublisher, ondcClient, slaPolicyService, attachmentService, serviceConfig)
+	onIssueService := services.NewOnIssueService(OnIssueRepo, issueInfoRepo, respondentRepo, redisRepo, eventPublisher, ondcClient, serviceConfig)
+	issueStatusService := services.NewIssueStatusService(issuRepo, OnIssueRepo, issueInfoRepo, respondentRepo, redisRepo, eventPublisher, ondcClient, serviceConfig)
+
+	disputeService := services.NewDisputeService(issuRepo, odrProviderRepo, redisRepo, ondcClient, serviceConfig)
+	issueInfoService := services.NewIssueInfoService(issuRepo, issueInfoRepo, redisRepo, attachmentService, ondcClient, transactor, serviceConfig)
+	timelineService := services.NewIssueTimelineService(issuRepo, OnIssueRepo)
+	watchService := services.NewIssueWatchService(issuRepo, redisRepo)
+
+	notificationTemplates, err := services.LoadNotificationTemplates(cfg.NotificationTemplatesFile)
+	if err != nil {
+		log.Fatalf("failed to load notification templates:%v", err)
+	}
+	var notificationSink notify.Notifier = notify.NewLogNotifier()
+	if cfg.NotificationSink == "file" {
+		notificationSink = notify.NewFileNotifier(cfg.NotificationFile)
 	}
+	notificationService := services.NewNotificationService(issuRepo, notificationRepo, map[string]notify.Notifier{
+		notify.ChannelSMS:   notificationSink,
+		notify.ChannelEmail: notificationSink,
+		notify.ChannelPush:  notificationSink,
+	}, notificationTemplates)
+	webhookService := services.NewWebhookService(webhookRepo)
+	supportService := services.NewSupportService(issuRepo, OnIssueRepo, supportRepo, eventPublisher, serviceConfig)
 
-	issueService := services.NewIssueService(issuRepo, redisRepo, ondcClient, serviceConfig)
-	onIssueService := services.NewOnIssueService(OnIssueRepo, redisRepo, ondcClient, serviceConfig)
-	issueStatusService := services.NewIssueStatusService(issuRepo, OnIssueRepo, redisRepo, ondcClient, serviceConfig)
+	issueHandler := handlers.NewIssueHandler(issueService, onIssueService, issueStatusService, disputeService, issueInfoService, timelineService, watchService, notificationService, webhookService, attachmentService)
+	issueV2Handler := handlers.NewIssueV2Handler(issueService)
+	supportHandler := handlers.NewSupportHandler(supportService)
 
-	issueHandler := handlers.NewIssueHandler(issueService, onIssueService, issueStatusService)
+	var verifier auth.Verifier = auth.TrustAll{}
+	if cfg.AuthEnabled {
+		keys, err := auth.LoadJWKSFile(cfg.AuthJWKSFile)
+		if err != nil {
+			log.Fatalf("failed to load jwks:%v", err)
+		}
+		verifier = auth.NewJWTVerifier(keys, auth.JWTConfig{
+			Issuer:   cfg.AuthIssuer,
+			Audience: cfg.AuthAudience,
+			Leeway:   cfg.AuthLeeway,
+		})
+	} else {
+		log.Println("WARNING: AUTH_ENABLED=false, every caller is treated as a trusted service")
+	}
+
+	grpcServer, err := server.NewGRPCServer(cfg.GRPCPort, issueHandler, issueV2Handler, supportHandler, verifier)
+	if err != nil {
+		log.Fatalf("failed to create grpc server:%v", err)
+	}
+
+	var gatewayServer *server.GatewayServer
+	if cfg.HTTPGatewayEnabled {
+		var allowedOrigins []string
+		if cfg.CORSAllowedOrigins != "" {
+			allowedOrigins = strings.Split(cfg.CORSAllowedOrigins, ",")
+		}
+		gatewayServer, err = server.NewGatewayServer(context.Background(), server.GatewayConfig{
+			Port:           cfg.HTTPPort,
+			GRPCPort:       cfg.GRPCPort,
+			AllowedOrigins: allowedOrigins,
+			Attachments:    attachmentDownloads,
+		})
+		if err != nil {
+			log.Fatalf("failed to create http gateway:%v", err)
+		}
+		go func() {
+			if err := gatewayServer.Start(); err != nil {
+				log.Fatalf("Failed to start http gateway: %v", err)
+			}
+		}()
+	}
+
+	var grpcWebServer *server.GRPCWebServer
+	if cfg.GRPCWebEnabled {
+		var allowedOrigins []string
+		if cfg.GRPCWebAllowedOrigins != "" {
+			allowedOrigins = strings.Split(cfg.GRPCWebAllowedOrigins, ",")
+		}
+		grpcWebServer = server.NewGRPCWebServer(grpcServer, server.GRPCWebConfig{
+			Port:           cfg.GRPCWebPort,
+			AllowedOrigins: allowedOrigins,
+		})
+		go func() {
+			if err := grpcWebServer.Start(); err != nil {
+				log.Fatalf("Failed to start grpc-web server: %v", err)
+			}
+		}()
+	}
+
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	slaBreachWorker := services.NewSLABreachWorker(issuRepo, redisRepo, eventPublisher, ondcClient, serviceConfig, services.SLABreachWorkerConfig{
+		Interval:     cfg.SLAWorkerInterval,
+		AutoEscalate: cfg.SLAAutoEscalate,
+	})
+	go slaBreachWorker.Start(workerCtx)
 
-	grpcServer := server.NewGRPCServer(cfg.GRPCPort, issueHandler)
+	autoCloseWorker := services.NewAutoCloseWorker(issuRepo, redisRepo, eventPublisher, ondcClient, serviceConfig, services.AutoCloseWorkerConfig{
+		Interval: cfg.AutoCloseInterval,
+		Window:   cfg.AutoCloseWindow,
+		Rating:   cfg.AutoCloseRating,
+	})
+	go autoCloseWorker.Start(workerCtx)
+
+	var eventHandlers []events.Handler
+	if cfg.WebhooksEnabled {
+		eventHandlers = append(eventHandlers, webhookService.HandleEvent)
+		webhookDispatcher := services.NewWebhookDispatcher(webhookRepo, services.WebhookDispatcherConfig{
+			Interval:     cfg.WebhookWorkerInterval,
+			Timeout:      cfg.WebhookTimeout,
+			MaxAttempts:  cfg.WebhookMaxAttempts,
+			DisableAfter: cfg.WebhookDisableAfter,
+		})
+		go webhookDispatcher.Start(workerCtx)
+	}
+	if cfg.NotificationsEnabled {
+		eventHandlers = append(eventHandlers, notificationService.HandleEvent)
+		notificationWorker := services.NewNotificationWorker(notificationService, services.NotificationWorkerConfig{
+			Interval: cfg.NotificationWorkerInterval,
+		})
+		go notificationWorker.Start(workerCtx)
+	}
+	outboxRelay := services.NewOutboxRelay(outboxRepo, events.Fanout(eventBackend, eventHandlers...), services.OutboxRelayConfig{
+		Interval: cfg.OutboxRelayInterval,
+	})
+	go outboxRelay.Start(workerCtx)
+
+	if cfg.StatusPollEnabled {
+		statusScheduler := services.NewIssueStatusScheduler(issuRepo, OnIssueRepo, issueStatusService, services.IssueStatusSchedulerConfig{
+			Interval:            cfg.StatusPollInterval,
+			Concurrency:         cfg.StatusPollConcurrency,
+			PerBPPRatePerMinute: cfg.StatusPollBPPRatePerMinute,
+			MinPollInterval:     cfg.StatusPollMinInterval,
+			MaxPollInterval:     cfg.StatusPollMaxInterval,
+			FreshWindow:         cfg.StatusPollFreshWindow,
+		})
+		go statusScheduler.Start(workerCtx)
+	}
 
 	go func() {
 		sigChan := make(chan os.Signal, 1)
@@ -57,7 +256,17 @@ func main() {
 		<-sigChan
 
 		log.Println("\nReceived shutdown signal")
+		stopWorkers()
+		if gatewayServer != nil {
+			gatewayServer.Stop()
+		}
+		if grpcWebServer != nil {
+			grpcWebServer.Stop()
+		}
 		grpcServer.Stop()
+		if err := eventBackend.Close(); err != nil {
+			log.Printf("failed to close event publisher: %v", err)
+		}
 		os.Exit(0)
 	}()
 
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func b64(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func testKeys(t *testing.T) (*rsa.PrivateKey, *ecdsa.PrivateKey, *KeySet) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	doc, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": b64(rsaKey.N), "e": b64(big.NewInt(int64(rsaKey.E)))},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": b64(ecKey.X), "y": b64(ecKey.Y)},
+		{"kty": "RSA", "kid": "enc-1", "use": "enc", "n": b64(rsaKey.N), "e": "AQAB"},
+	}})
+	require.NoError(t, err)
+	keys, err := ParseJWKS(doc)
+	require.NoError(t, err)
+	return rsaKey, ecKey, keys
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any, claims Claims) string {
+	tok := jwt.NewWithClaims(method, claims)
+	tok.Header["kid"] = kid
+	s, err := tok.SignedString(key)
+	require.NoError(t, err)
+	return s
+}
+
+func claimsFor(sub, role string) Claims {
+	return Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   sub,
+			Issuer:    "https://auth.example",
+			Audience:  jwt.ClaimStrings{"igm"},
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Role: role,
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	_, _, keys := testKeys(t)
+	_, ok := keys.Key("rsa-1")
+	assert.True(t, ok)
+	_, ok = keys.Key("ec-1")
+	assert.True(t, ok)
+	_, ok = keys.Key("enc-1")
+	assert.False(t, ok, "encryption keys are skipped")
+
+	_, err := ParseJWKS([]byte(`{"keys":[]}`))
+	assert.Error(t, err)
+	_, err = ParseJWKS([]byte(`{"keys":[{"kty":"RSA","n":"AQAB","e":"AQAB"}]}`))
+	assert.Error(t, err, "kid is required")
+	_, err = ParseJWKS([]byte(`{"keys":[{"kty":"EC","kid":"k","crv":"P-256","x":"AQ","y":"AQ"}]}`))
+	assert.Error(t, err, "point must be on the curve")
+}
+
+func TestJWTVerifier(t *testing.T) {
+	rsaKey, ecKey, keys := testKeys(t)
+	v := NewJWTVerifier(keys, JWTConfig{Issuer: "https://auth.example", Audience: "igm"})
+	ctx := context.Background()
+	userID := uuid.New()
+
+	p, err := v.Verify(ctx, sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claimsFor(userID.String(), "")))
+	require.NoError(t, err)
+	assert.Equal(t, RoleUser, p.Role)
+	assert.Equal(t, userID, p.UserID)
+
+	p, err = v.Verify(ctx, sign(t, jwt.SigningMethodES256, "ec-1", ecKey, claimsFor("agent-7", "support")))
+	require.NoError(t, err)
+	assert.Equal(t, RoleSupport, p.Role)
+	assert.Equal(t, "agent-7", p.Subject)
+
+	withUserID := claimsFor("buyer@example.com", "user")
+	withUserID.UserID = userID.String()
+	p, err = v.Verify(ctx, sign(t, jwt.SigningMethodPS256, "rsa-1", rsaKey, withUserID))
+	require.NoError(t, err)
+	assert.Equal(t, userID, p.UserID)
+
+	expired := claimsFor(userID.String(), "")
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	wrongAud := claimsFor(userID.String(), "")
+	wrongAud.Audience = jwt.ClaimStrings{"other"}
+	noExp := claimsFor(userID.String(), "")
+	noExp.ExpiresAt = nil
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	for name, token := range map[string]string{
+		"empty":            "",
+		"expired":          sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, expired),
+		"wrong audience":   sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, wrongAud),
+		"no expiry":        sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, noExp),
+		"unknown kid":      sign(t, jwt.SigningMethodRS256, "rsa-2", rsaKey, claimsFor(userID.String(), "")),
+		"wrong key":        sign(t, jwt.SigningMethodRS256, "rsa-1", otherKey, claimsFor(userID.String(), "")),
+		"alg/key mismatch": sign(t, jwt.SigningMethodRS256, "ec-1", rsaKey, claimsFor(userID.String(), "")),
+		"hmac":             sign(t, jwt.SigningMethodHS256, "rsa-1", []byte("secret"), claimsFor(userID.String(), "")),
+		"unknown role":     sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claimsFor(userID.String(), "admin")),
+		"user without id":  sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claimsFor("buyer@example.com", "")),
+	} {
+		_, err := v.Verify(ctx, token)
+		assert.Error(t, err, name)
+	}
+}
+
+// the development keys in dev/ back AUTH_JWKS_FILE in .env
+func TestDevKeysSignAndVerify(t *testing.T) {
+	keys, err := LoadJWKSFile("../../dev/jwks.json")
+	require.NoError(t, err)
+	pemKey, err := os.ReadFile("../../dev/jwt-signing-key.pem")
+	require.NoError(t, err)
+	signingKey, err := jwt.ParseECPrivateKeyFromPEM(pemKey)
+	require.NoError(t, err)
+
+	userID := uuid.New()
+	v := NewJWTVerifier(keys, JWTConfig{})
+	p, err := v.Verify(context.Background(), sign(t, jwt.SigningMethodES256, "igm-dev", signingKey, claimsFor(userID.String(), "")))
+	require.NoError(t, err)
+	assert.Equal(t, userID, p.UserID)
+}
+
+func TestResolveUserID(t *testing.T) {
+	userID := uuid.New()
+	other := uuid.New()
+	userCtx := WithPrincipal(context.Background(), &Principal{Subject: userID.String(), Role: RoleUser, UserID: userID})
+	supportCtx := WithPrincipal(context.Background(), &Principal{Subject: "agent-7", Role: RoleSupport})
+
+	got, err := ResolveUserID(userCtx, "")
+	require.NoError(t, err)
+	assert.Equal(t, userID, got)
+	got, err = ResolveUserID(userCtx, userID.String())
+	require.NoError(t, err)
+	assert.Equal(t, userID, got)
+	_, err = ResolveUserID(userCtx, other.String())
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	got, err = ResolveUserID(supportCtx, other.String())
+	require.NoError(t, err)
+	assert.Equal(t, other, got)
+	_, err = ResolveUserID(supportCtx, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = ResolveUserID(context.Background(), userID.String())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestResolveUserFilter(t *testing.T) {
+	userID := uuid.New()
+	userCtx := WithPrincipal(context.Background(), &Principal{Role: RoleUser, UserID: userID})
+	supportCtx := WithPrincipal(context.Background(), &Principal{Role: RoleSupport})
+
+	got, err := ResolveUserFilter(userCtx, "")
+	require.NoError(t, err)
+	assert.Equal(t, userID, got, "users never search across users")
+	got, err = ResolveUserFilter(supportCtx, "")
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, got)
+	got, err = ResolveUserFilter(supportCtx, userID.String())
+	require.NoError(t, err)
+	assert.Equal(t, userID, got)
+}
+
+func TestAuthorizeOwnerAndRequireRole(t *testing.T) {
+	userID := uuid.New()
+	userCtx := WithPrincipal(context.Background(), &Principal{Role: RoleUser, UserID: userID})
+	serviceCtx := WithPrincipal(context.Background(), &Principal{Role: RoleService})
+
+	assert.NoError(t, AuthorizeOwner(userCtx, userID))
+	assert.Equal(t, codes.PermissionDenied, status.Code(AuthorizeOwner(userCtx, uuid.New())))
+	assert.NoError(t, AuthorizeOwner(serviceCtx, uuid.New()))
+	assert.Equal(t, codes.Unauthenticated, status.Code(AuthorizeOwner(context.Background(), userID)))
+
+	assert.NoError(t, RequireRole(serviceCtx, RoleService))
+	assert.Equal(t, codes.PermissionDenied, status.Code(RequireRole(userCtx, RoleSupport, RoleService)))
+}
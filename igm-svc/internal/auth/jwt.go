@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// Verifier turns the bearer token of a request into a Principal. token is
+// empty when the request carried none.
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*Principal, error)
+}
+
+type JWTConfig struct {
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+}
+
+// Claims are the token claims IGM reads. role defaults to user; user_id
+// defaults to sub for users.
+type Claims struct {
+	jwt.RegisteredClaims
+	Role   string `json:"role,omitempty"`
+	UserID string `json:"user_id,omitempty"`
+}
+
+// JWTVerifier checks RS*/PS*/ES* tokens against a local JWKS.
+type JWTVerifier struct {
+	keys   *KeySet
+	parser *jwt.Parser
+}
+
+func NewJWTVerifier(keys *KeySet, cfg JWTConfig) *JWTVerifier {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(cfg.Leeway),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	return &JWTVerifier{keys: keys, parser: jwt.NewParser(opts...)}
+}
+
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (*Principal, error) {
+	if token == "" {
+		return nil, fmt.Errorf("missing bearer token")
+	}
+	var claims Claims
+	_, err := v.parser.ParseWithClaims(token, &claims, v.keyFunc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return principalFromClaims(&claims)
+}
+
+func (v *JWTVerifier) keyFunc(t *jwt.Token) (interface{}, error) {
+	kid, _ := t.Header["kid"].(string)
+	key, ok := v.keys.Key(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	// the key type must match the algorithm family
+	switch t.Method.(type) {
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		if _, ok := key.(*rsa.PublicKey); !ok {
+			return nil, fmt.Errorf("kid %q is not an RSA key", kid)
+		}
+	case *jwt.SigningMethodECDSA:
+		if _, ok := key.(*ecdsa.PublicKey); !ok {
+			return nil, fmt.Errorf("kid %q is not an EC key", kid)
+		}
+	}
+	return key, nil
+}
+
+func principalFromClaims(c *Claims) (*Principal, error) {
+	if c.Subject == "" {
+		return nil, fmt.Errorf("token has no sub")
+	}
+	p := &Principal{Subject: c.Subject, Role: Role(c.Role)}
+	switch p.Role {
+	case "":
+		p.Role = RoleUser
+	case RoleUser, RoleSupport, RoleService:
+	default:
+		return nil, fmt.Errorf("unknown role %q", c.Role)
+	}
+	if p.Role == RoleUser {
+		raw := c.UserID
+		if raw == "" {
+			raw = c.Subject
+		}
+		userID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, fmt.Errorf("user token needs a uuid user_id or sub")
+		}
+		p.UserID = userID
+	}
+	return p, nil
+}
+
+// TrustAll accepts every request as a service principal. It keeps the
+// pre-auth behaviour for local development and must not be used in
+// deployed environments; config.Load refuses it while the HTTP gateway or
+// gRPC-Web listeners are enabled.
+type TrustAll struct{}
+
+func (TrustAll) Verify(ctx context.Context, token string) (*Principal, error) {
+	return &Principal{Subject: "anonymous", Role: RoleService}, nil
+}
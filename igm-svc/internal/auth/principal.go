@@ -0,0 +1,111 @@
+// Package auth carries the authenticated caller through the request context
+// and holds the ownership checks services apply to issues.
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Role string
+
+const (
+	// RoleUser is a buyer acting on their own issues.
+	RoleUser Role = "user"
+	// RoleSupport is a support agent acting on any issue.
+	RoleSupport Role = "support"
+	// RoleService is another backend (gateway, scheduler, ONDC callback
+	// relay) acting on any issue.
+	RoleService Role = "service"
+)
+
+// Principal is the authenticated caller.
+type Principal struct {
+	Subject string
+	Role    Role
+	// UserID is set for RoleUser.
+	UserID uuid.UUID
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+func principal(ctx context.Context) (*Principal, error) {
+	p, ok := FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing caller identity")
+	}
+	return p, nil
+}
+
+// ResolveUserID returns the user a request acts for. Users always act for
+// themselves and may not name anyone else; support agents and services act
+// for the user named in the request.
+func ResolveUserID(ctx context.Context, requested string) (uuid.UUID, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if p.Role == RoleUser {
+		if requested != "" && requested != p.UserID.String() {
+			return uuid.Nil, status.Error(codes.PermissionDenied, "user_id does not match caller")
+		}
+		return p.UserID, nil
+	}
+	userID, err := uuid.Parse(requested)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid user_id")
+	}
+	return userID, nil
+}
+
+// ResolveUserFilter is ResolveUserID for queries across issues: support
+// agents and services that name no user get uuid.Nil, meaning every user.
+func ResolveUserFilter(ctx context.Context, requested string) (uuid.UUID, error) {
+	p, err := principal(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if p.Role != RoleUser && requested == "" {
+		return uuid.Nil, nil
+	}
+	return ResolveUserID(ctx, requested)
+}
+
+// AuthorizeOwner allows users only on resources they own; support agents and
+// services may act on any.
+func AuthorizeOwner(ctx context.Context, ownerID uuid.UUID) error {
+	p, err := principal(ctx)
+	if err != nil {
+		return err
+	}
+	if p.Role == RoleUser && p.UserID != ownerID {
+		return status.Error(codes.PermissionDenied, "issue does not belong to caller")
+	}
+	return nil
+}
+
+// RequireRole allows only the given roles.
+func RequireRole(ctx context.Context, roles ...Role) error {
+	p, err := principal(ctx)
+	if err != nil {
+		return err
+	}
+	for _, r := range roles {
+		if p.Role == r {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "role %s may not call this method", p.Role)
+}
@@ -3,14 +3,73 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 type Config struct{
 	DatabaseURL string
 	RedisURL string
 	GRPCPort string
+	HTTPGatewayEnabled bool
+	HTTPPort string
+	CORSAllowedOrigins string
+	GRPCWebEnabled bool
+	GRPCWebPort string
+	GRPCWebAllowedOrigins string
 	SubscriberID string
 	BapURI string
+	Domain string
+	SLAPolicyFile string
+	SLAWorkerInterval time.Duration
+	SLAAutoEscalate bool
+	StatusPollEnabled bool
+	StatusPollInterval time.Duration
+	StatusPollConcurrency int
+	StatusPollBPPRatePerMinute int
+	StatusPollMinInterval time.Duration
+	StatusPollMaxInterval time.Duration
+	StatusPollFreshWindow time.Duration
+	AutoCloseInterval time.Duration
+	AutoCloseWindow time.Duration
+	AutoCloseRating string
+	OdrProvidersFile string
+	EventStreamMaxLen int
+	EventIssueStreamMaxLen int
+	EventIssueStreamTTL time.Duration
+	EventPublisher string
+	DomainEventStream string
+	KafkaBrokers string
+	KafkaTopic string
+	OutboxRelayInterval time.Duration
+	NotificationsEnabled bool
+	NotificationSink string
+	NotificationFile string
+	NotificationTemplatesFile string
+	NotificationWorkerInterval time.Duration
+	WebhooksEnabled bool
+	WebhookWorkerInterval time.Duration
+	WebhookTimeout time.Duration
+	WebhookMaxAttempts int
+	WebhookDisableAfter int
+	AuthEnabled bool
+	AuthJWKSFile string
+	AuthIssuer string
+	AuthAudience string
+	AuthLeeway time.Duration
+	AttachmentStore string
+	AttachmentDir string
+	AttachmentPublicBaseURL string
+	AttachmentSigningKey string
+	AttachmentS3Endpoint string
+	AttachmentS3Region string
+	AttachmentS3Bucket string
+	AttachmentS3AccessKey string
+	AttachmentS3SecretKey string
+	AttachmentS3UseSSL bool
+	AttachmentMaxBytes int
+	AttachmentMaxImageDimension int
+	AttachmentURLTTL time.Duration
 	
 }
 
@@ -19,13 +78,106 @@ func Load()(*Config,error){
 		DatabaseURL: getEnv("DATABASE_URL",""),
 		RedisURL: getEnv("REDIS_URL","localhost:6379"),
 		GRPCPort: getEnv("GRPC_PORT",":50053"),
+		HTTPGatewayEnabled: getEnvBool("HTTP_GATEWAY_ENABLED",true),
+		HTTPPort: getEnv("HTTP_PORT",":8080"),
+		CORSAllowedOrigins: getEnv("CORS_ALLOWED_ORIGINS",""),
+		GRPCWebEnabled: getEnvBool("GRPC_WEB_ENABLED",true),
+		GRPCWebPort: getEnv("GRPC_WEB_PORT",":8081"),
+		GRPCWebAllowedOrigins: getEnv("GRPC_WEB_ALLOWED_ORIGINS",""),
 		SubscriberID: getEnv("SUBSCRIBER_ID","preprod.effimove.in"),
 		BapURI: getEnv("BAP_URI","https://preprod.effimove.in"),
+		Domain: getEnv("ONDC_DOMAIN","nic2004:60232"),
+		SLAPolicyFile: getEnv("SLA_POLICY_FILE",""),
+		SLAWorkerInterval: getEnvDuration("SLA_WORKER_INTERVAL",time.Minute),
+		SLAAutoEscalate: getEnvBool("SLA_AUTO_ESCALATE",true),
+		StatusPollEnabled: getEnvBool("STATUS_POLL_ENABLED",true),
+		StatusPollInterval: getEnvDuration("STATUS_POLL_INTERVAL",30*time.Second),
+		StatusPollConcurrency: getEnvInt("STATUS_POLL_CONCURRENCY",10),
+		StatusPollBPPRatePerMinute: getEnvInt("STATUS_POLL_BPP_RATE_PER_MIN",60),
+		StatusPollMinInterval: getEnvDuration("STATUS_POLL_MIN_INTERVAL",5*time.Minute),
+		StatusPollMaxInterval: getEnvDuration("STATUS_POLL_MAX_INTERVAL",time.Hour),
+		StatusPollFreshWindow: getEnvDuration("STATUS_POLL_FRESH_WINDOW",10*time.Minute),
+		AutoCloseInterval: getEnvDuration("AUTO_CLOSE_INTERVAL",5*time.Minute),
+		AutoCloseWindow: getEnvDuration("AUTO_CLOSE_WINDOW",24*time.Hour),
+		AutoCloseRating: getEnv("AUTO_CLOSE_RATING","THUMBS-UP"),
+		OdrProvidersFile: getEnv("ODR_PROVIDERS_FILE","odr_providers.json"),
+		EventStreamMaxLen: getEnvInt("EVENT_STREAM_MAXLEN",100000),
+		EventIssueStreamMaxLen: getEnvInt("EVENT_ISSUE_STREAM_MAXLEN",1000),
+		EventIssueStreamTTL: getEnvDuration("EVENT_ISSUE_STREAM_TTL",30*24*time.Hour),
+		EventPublisher: getEnv("EVENT_PUBLISHER","redis"),
+		DomainEventStream: getEnv("DOMAIN_EVENT_STREAM","igm:domain-events"),
+		KafkaBrokers: getEnv("KAFKA_BROKERS","localhost:9092"),
+		KafkaTopic: getEnv("KAFKA_TOPIC","igm.issue-events"),
+		OutboxRelayInterval: getEnvDuration("OUTBOX_RELAY_INTERVAL",time.Second),
+		NotificationsEnabled: getEnvBool("NOTIFICATIONS_ENABLED",true),
+		NotificationSink: getEnv("NOTIFICATION_SINK","log"),
+		NotificationFile: getEnv("NOTIFICATION_FILE","notifications.log"),
+		NotificationTemplatesFile: getEnv("NOTIFICATION_TEMPLATES_FILE",""),
+		NotificationWorkerInterval: getEnvDuration("NOTIFICATION_WORKER_INTERVAL",5*time.Second),
+		WebhooksEnabled: getEnvBool("WEBHOOKS_ENABLED",true),
+		WebhookWorkerInterval: getEnvDuration("WEBHOOK_WORKER_INTERVAL",5*time.Second),
+		WebhookTimeout: getEnvDuration("WEBHOOK_TIMEOUT",10*time.Second),
+		WebhookMaxAttempts: getEnvInt("WEBHOOK_MAX_ATTEMPTS",8),
+		WebhookDisableAfter: getEnvInt("WEBHOOK_DISABLE_AFTER_FAILURES",20),
+		AuthEnabled: getEnvBool("AUTH_ENABLED",true),
+		AuthJWKSFile: getEnv("AUTH_JWKS_FILE","jwks.json"),
+		AuthIssuer: getEnv("AUTH_ISSUER",""),
+		AuthAudience: getEnv("AUTH_AUDIENCE",""),
+		AuthLeeway: getEnvDuration("AUTH_LEEWAY",30*time.Second),
+		AttachmentStore: getEnv("ATTACHMENT_STORE","local"),
+		AttachmentDir: getEnv("ATTACHMENT_DIR","attachments"),
+		AttachmentPublicBaseURL: getEnv("ATTACHMENT_PUBLIC_BASE_URL","http://localhost:8080"),
+		AttachmentSigningKey: getEnv("ATTACHMENT_SIGNING_KEY",""),
+		AttachmentS3Endpoint: getEnv("ATTACHMENT_S3_ENDPOINT",""),
+		AttachmentS3Region: getEnv("ATTACHMENT_S3_REGION",""),
+		AttachmentS3Bucket: getEnv("ATTACHMENT_S3_BUCKET",""),
+		AttachmentS3AccessKey: getEnv("ATTACHMENT_S3_ACCESS_KEY",""),
+		AttachmentS3SecretKey: getEnv("ATTACHMENT_S3_SECRET_KEY",""),
+		AttachmentS3UseSSL: getEnvBool("ATTACHMENT_S3_USE_SSL",true),
+		AttachmentMaxBytes: getEnvInt("ATTACHMENT_MAX_BYTES",10<<20),
+		AttachmentMaxImageDimension: getEnvInt("ATTACHMENT_MAX_IMAGE_DIMENSION",8000),
+		AttachmentURLTTL: getEnvDuration("ATTACHMENT_URL_TTL",7*24*time.Hour),
 		
 	}
 	if cfg.DatabaseURL==""{
 		return nil,fmt.Errorf("DATABASE_URL is required")
 	}
+	switch cfg.EventPublisher{
+	case "redis","kafka","memory":
+	default:
+		return nil,fmt.Errorf("EVENT_PUBLISHER must be redis, kafka or memory, got %q",cfg.EventPublisher)
+	}
+	switch cfg.NotificationSink{
+	case "log","file":
+	default:
+		return nil,fmt.Errorf("NOTIFICATION_SINK must be log or file, got %q",cfg.NotificationSink)
+	}
+	if cfg.AuthEnabled && cfg.AuthJWKSFile==""{
+		return nil,fmt.Errorf("AUTH_JWKS_FILE is required when AUTH_ENABLED is true")
+	}
+	// without auth every caller is a trusted service, so only local gRPC
+	// clients may reach the service
+	if !cfg.AuthEnabled && (cfg.HTTPGatewayEnabled || cfg.GRPCWebEnabled){
+		return nil,fmt.Errorf("AUTH_ENABLED=false requires HTTP_GATEWAY_ENABLED=false and GRPC_WEB_ENABLED=false")
+	}
+	switch cfg.AttachmentStore{
+	case "local":
+		if cfg.AttachmentSigningKey==""{
+			return nil,fmt.Errorf("ATTACHMENT_SIGNING_KEY is required when ATTACHMENT_STORE is local, generate one with openssl rand -hex 32")
+		}
+		if !cfg.HTTPGatewayEnabled{
+			return nil,fmt.Errorf("ATTACHMENT_STORE=local serves attachment URLs from the http gateway, set HTTP_GATEWAY_ENABLED=true")
+		}
+	case "s3":
+		if cfg.AttachmentS3Endpoint=="" || cfg.AttachmentS3Bucket==""{
+			return nil,fmt.Errorf("ATTACHMENT_S3_ENDPOINT and ATTACHMENT_S3_BUCKET are required when ATTACHMENT_STORE is s3")
+		}
+		if cfg.AttachmentURLTTL>7*24*time.Hour{
+			return nil,fmt.Errorf("ATTACHMENT_URL_TTL must be at most 168h with ATTACHMENT_STORE=s3")
+		}
+	default:
+		return nil,fmt.Errorf("ATTACHMENT_STORE must be local or s3, got %q",cfg.AttachmentStore)
+	}
 	
 	return cfg,nil
 }
@@ -36,4 +188,28 @@ func getEnv(key,defaultValue string)string{
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
+
+func getEnvDuration(key string,defaultValue time.Duration)time.Duration{
+	value,err:=time.ParseDuration(os.Getenv(key))
+	if err!=nil{
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvBool(key string,defaultValue bool)bool{
+	value,err:=strconv.ParseBool(os.Getenv(key))
+	if err!=nil{
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvInt(key string,defaultValue int)int{
+	value,err:=strconv.Atoi(os.Getenv(key))
+	if err!=nil{
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *IssueHandler) UploadAttachment(stream grpc.ClientStreamingServer[pb.UploadAttachmentRequest, pb.Attachment]) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return toStatusError(err, "failed to receive attachment")
+	}
+	meta := first.GetMetadata()
+	if meta == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry metadata")
+	}
+	log.Printf("[Handler] UploadAttachment called for user:%s, file:%s, type:%s, size:%d", meta.UserId, meta.FileName, meta.ContentType, meta.SizeBytes)
+	resp, err := h.attachmentService.UploadAttachment(stream.Context(), meta, &attachmentChunks{stream: stream})
+	if err != nil {
+		log.Printf("[handler] UploadAttachment failed :%v", err)
+		return toStatusError(err, "failed to upload attachment")
+	}
+	return stream.SendAndClose(resp)
+}
+
+func (h *IssueHandler) GetAttachment(ctx context.Context, req *pb.GetAttachmentRequest) (*pb.Attachment, error) {
+	log.Printf("[Handler] GetAttachment called for user:%s, attachment:%s", req.UserId, req.AttachmentId)
+	resp, err := h.attachmentService.GetAttachment(ctx, req)
+	if err != nil {
+		log.Printf("[handler] GetAttachment failed :%v", err)
+		return nil, toStatusError(err, "failed to get attachment")
+	}
+	return resp, nil
+}
+
+// attachmentChunks reads the file from the chunk messages following the
+// metadata; the end of the client stream is io.EOF.
+type attachmentChunks struct {
+	stream grpc.ClientStreamingServer[pb.UploadAttachmentRequest, pb.Attachment]
+	buf    []byte
+}
+
+func (r *attachmentChunks) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if req.GetMetadata() != nil {
+			return 0, status.Error(codes.InvalidArgument, "metadata may only be sent in the first message")
+		}
+		r.buf = req.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
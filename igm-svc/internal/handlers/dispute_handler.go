@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+func (h *IssueHandler) ListOdrProviders(ctx context.Context, req *pb.ListOdrProvidersRequest) (*pb.ListOdrProvidersResponse, error) {
+	log.Printf("[Handler] ListOdrProviders called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.disputeService.ListOdrProviders(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ListOdrProviders failed :%v", err)
+		return nil, toStatusError(err, "failed to list odr providers")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) SelectOdr(ctx context.Context, req *pb.SelectOdrRequest) (*pb.SelectOdrResponse, error) {
+	log.Printf("[Handler] SelectOdr called for user:%s, issue:%s, odr:%s", req.UserId, req.IssueId, req.OdrId)
+	resp, err := h.disputeService.SelectOdr(ctx, req)
+	if err != nil {
+		log.Printf("[handler] SelectOdr failed :%v", err)
+		return nil, toStatusError(err, "failed to raise dispute")
+	}
+	return resp, nil
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"igm-svc/internal/services"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError maps a service error onto a gRPC status. Status errors pass
+// through, the service sentinels get their own codes and validation errors
+// carry a BadRequest with one violation per field. Anything else is an
+// internal error described by msg.
+func toStatusError(err error, msg string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var verr *services.ValidationError
+	if errors.As(err, &verr) {
+		return validationStatus(err, verr)
+	}
+	switch {
+	case errors.Is(err, services.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, services.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, services.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, services.ErrFailedPrecondition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, services.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%s :%v", msg, err)
+}
+
+func validationStatus(err error, verr *services.ValidationError) error {
+	br := &errdetails.BadRequest{}
+	for _, v := range verr.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(br)
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
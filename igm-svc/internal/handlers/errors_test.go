@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"igm-svc/internal/repository"
+	"igm-svc/internal/services"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatusError_Codes(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		want codes.Code
+	}{
+		{fmt.Errorf("failed to load issue i-1: %w", repository.ErrIssueNotFound), codes.NotFound},
+		{fmt.Errorf("%w: active issue already exists", services.ErrAlreadyExists), codes.AlreadyExists},
+		{fmt.Errorf("%w: issue i-1 is already closed", services.ErrFailedPrecondition), codes.FailedPrecondition},
+		{services.ErrPermissionDenied, codes.PermissionDenied},
+		{status.Error(codes.Unauthenticated, "missing bearer token"), codes.Unauthenticated},
+		{fmt.Errorf("failed to list issues: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{errors.New("connection refused"), codes.Internal},
+	} {
+		assert.Equal(t, tc.want, status.Code(toStatusError(tc.err, "failed")), tc.err.Error())
+	}
+
+	st := status.Convert(toStatusError(errors.New("connection refused"), "failed to update issue"))
+	assert.Equal(t, "failed to update issue :connection refused", st.Message())
+}
+
+func TestToStatusError_FieldViolations(t *testing.T) {
+	err := fmt.Errorf("validation failed :%w", &services.ValidationError{Violations: []services.FieldViolation{
+		{Field: "order_id", Description: "missing required field: order_id"},
+	}})
+
+	st := status.Convert(toStatusError(err, "failed to create issue"))
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	require.Len(t, br.FieldViolations, 1)
+	assert.Equal(t, "order_id", br.FieldViolations[0].Field)
+	assert.Equal(t, "missing required field: order_id", br.FieldViolations[0].Description)
+}
@@ -5,24 +5,35 @@ import (
 	"igm-svc/internal/services"
 	"log"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	pb "igm-svc/api/proto/igm/v1"
 )
 
 type IssueHandler struct {
 	pb.UnimplementedIssueServiceServer
-	issueService       *services.IssueService
-	onIssueService     *services.OnIssueService
-	issueStatusService *services.IssueStatusService
+	issueService        *services.IssueService
+	onIssueService      *services.OnIssueService
+	issueStatusService  *services.IssueStatusService
+	disputeService      *services.DisputeService
+	issueInfoService    *services.IssueInfoService
+	timelineService     *services.IssueTimelineService
+	watchService        *services.IssueWatchService
+	notificationService *services.NotificationService
+	webhookService      *services.WebhookService
+	attachmentService   *services.AttachmentService
 }
 
-func NewIssueHandler(issueService *services.IssueService, onIssueService *services.OnIssueService, issueStatusService *services.IssueStatusService) *IssueHandler {
+func NewIssueHandler(issueService *services.IssueService, onIssueService *services.OnIssueService, issueStatusService *services.IssueStatusService, disputeService *services.DisputeService, issueInfoService *services.IssueInfoService, timelineService *services.IssueTimelineService, watchService *services.IssueWatchService, notificationService *services.NotificationService, webhookService *services.WebhookService, attachmentService *services.AttachmentService) *IssueHandler {
 	return &IssueHandler{
-		issueService:       issueService,
-		onIssueService:     onIssueService,
-		issueStatusService: issueStatusService,
+		issueService:        issueService,
+		onIssueService:      onIssueService,
+		issueStatusService:  issueStatusService,
+		disputeService:      disputeService,
+		issueInfoService:    issueInfoService,
+		timelineService:     timelineService,
+		watchService:        watchService,
+		notificationService: notificationService,
+		webhookService:      webhookService,
+		attachmentService:   attachmentService,
 	}
 }
 
@@ -32,12 +43,9 @@ func (h *IssueHandler) CreateIssue(ctx context.Context, req *pb.CreateIssueReque
 	resp, err := h.issueService.CreateIssue(ctx, req)
 	if err != nil {
 		log.Printf("[Handler] CreateIssue Failed :%v", err)
-		return nil, err
+		return nil, toStatusError(err, "failed to create issue")
 	}
 
-	// if isValidationError(err){
-	// return nil, status.Errorf(codes.InvalidArgument, "validation failed: %v", err)}
-	// }
 	log.Printf("[Handler] Create issue sucecces issue_id:%s", resp.IssueId)
 	return resp, nil
 }
@@ -47,7 +55,7 @@ func (h *IssueHandler) UpdateIssue(ctx context.Context, req *pb.UpdateIssueReque
 	resp, err := h.issueService.UpdateIssue(ctx, req)
 	if err != nil {
 		log.Printf("[handler] Update Issue failed:%v", err)
-		return nil, status.Errorf(codes.Internal, "failed to update issue :%v", err)
+		return nil, toStatusError(err, "failed to update issue")
 	}
 	return resp, nil
 }
@@ -57,9 +65,29 @@ func (h *IssueHandler) CloseIssue(ctx context.Context, req *pb.CloseIssueRequest
 	resp, err := h.issueService.CloseIssue(ctx, req)
 	if err != nil {
 		log.Printf("[handler] Close Issue failed :%v", err)
-		return nil, status.Errorf(codes.Internal, "failed to close issue :%v", err)
+		return nil, toStatusError(err, "failed to close issue")
+	}
+
+	return resp, nil
+}
+
+func (h *IssueHandler) AcceptResolution(ctx context.Context, req *pb.AcceptResolutionRequest) (*pb.AcceptResolutionResponse, error) {
+	log.Printf("[Handler] AcceptResolution called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueService.AcceptResolution(ctx, req)
+	if err != nil {
+		log.Printf("[handler] AcceptResolution failed :%v", err)
+		return nil, toStatusError(err, "failed to accept resolution")
 	}
+	return resp, nil
+}
 
+func (h *IssueHandler) RejectResolution(ctx context.Context, req *pb.RejectResolutionRequest) (*pb.RejectResolutionResponse, error) {
+	log.Printf("[Handler] RejectResolution called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueService.RejectResolution(ctx, req)
+	if err != nil {
+		log.Printf("[handler] RejectResolution failed :%v", err)
+		return nil, toStatusError(err, "failed to reject resolution")
+	}
 	return resp, nil
 }
 
@@ -68,7 +96,7 @@ func (h *IssueHandler) GetIssue(ctx context.Context, req *pb.GetIssueRequest) (*
 	resp, err := h.issueService.GetIssue(ctx, req)
 	if err != nil {
 		log.Printf("[handler] Get Issues failed :%v", err)
-		return nil, status.Errorf(codes.Internal, "failed to Get Issues issue :%v", err)
+		return nil, toStatusError(err, "failed to Get Issues issue")
 	}
 	return resp, nil
 }
@@ -78,7 +106,17 @@ func (h *IssueHandler) ListIssues(ctc context.Context, req *pb.ListIssueRequest)
 	resp, err := h.issueService.GetIssuesByUser(ctc, req)
 	if err != nil {
 		log.Printf("[Handler ListIssues failed:%v]", err)
-		return nil, status.Errorf(codes.Internal, "failed to List Issue for user :%v", err)
+		return nil, toStatusError(err, "failed to List Issue for user")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) SearchIssues(ctx context.Context, req *pb.IssueSearchRequest) (*pb.IssueSearchResponse, error) {
+	log.Printf("[Handler] SearchIssues called by user:%s query:%q", req.UserId, req.Query)
+	resp, err := h.issueService.SearchIssues(ctx, req)
+	if err != nil {
+		log.Printf("[handler] SearchIssues failed :%v", err)
+		return nil, toStatusError(err, "failed to search issues")
 	}
 	return resp, nil
 }
@@ -88,7 +126,7 @@ func (h *IssueHandler) ListIssueByOrder(ctx context.Context, req *pb.ListIssueBy
 	resp, err := h.issueService.GetIssueByOrder(ctx, req)
 	if err != nil {
 		log.Printf("[handler] Get Issues by Order failed :%v", err)
-		return nil, status.Errorf(codes.Internal, "failed to Get Issues for order :%v", err)
+		return nil, toStatusError(err, "failed to Get Issues for order")
 	}
 	return resp, nil
 }
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+func (h *IssueHandler) ProvideIssueInfo(ctx context.Context, req *pb.ProvideIssueInfoRequest) (*pb.ProvideIssueInfoResponse, error) {
+	log.Printf("[Handler] ProvideIssueInfo called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueInfoService.ProvideIssueInfo(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ProvideIssueInfo failed :%v", err)
+		return nil, toStatusError(err, "failed to provide issue info")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) GetIssueInfoThread(ctx context.Context, req *pb.GetIssueInfoThreadRequest) (*pb.GetIssueInfoThreadResponse, error) {
+	log.Printf("[Handler] GetIssueInfoThread called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueInfoService.GetIssueInfoThread(ctx, req)
+	if err != nil {
+		log.Printf("[handler] GetIssueInfoThread failed :%v", err)
+		return nil, toStatusError(err, "failed to get issue info thread")
+	}
+	return resp, nil
+}
@@ -5,7 +5,8 @@ import (
 	"fmt"
 	"log"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
+	pb "igm-svc/api/proto/igm/v1"
+	"igm-svc/internal/auth"
 )
 
 func (h *IssueHandler) HandleIssueStatus(ctx context.Context, req *pb.IssueStatusRequest) (*pb.IssueStatusResponse, error) {
@@ -13,7 +14,7 @@ func (h *IssueHandler) HandleIssueStatus(ctx context.Context, req *pb.IssueStatu
 	log.Printf("[Handler] HandleIssueStatus called for issue: %s", req.IssueId)
 	err := h.issueStatusService.ProcessIssueStatus(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to process issue_status: %w", err)
+		return nil, toStatusError(err, "failed to process issue_status")
 	}
 
 	return &pb.IssueStatusResponse{Status: "SUCCESS", Message: "issue_status processed"}, nil
@@ -22,6 +23,10 @@ func (h *IssueHandler) HandleIssueStatus(ctx context.Context, req *pb.IssueStatu
 func (h *IssueHandler) HandleOnIssueStatus(ctx context.Context, req *pb.OnIssueStatusRequest) (*pb.OnIssueStatusResponse, error) {
 	log.Printf("[ONDC] Received on_issue_status callback: issue_id=%s,transaction_id=%s, message_id=%s", req.IssueId, req.TransactionId, req.MessageId)
 
+	if err := auth.RequireRole(ctx, auth.RoleService); err != nil {
+		return nil, err
+	}
+
 	if req.Payload == nil {
 		return &pb.OnIssueStatusResponse{Status: "ERROR", Message: "empty payload"}, nil
 	}
@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+func (h *IssueHandler) GetIssueTimeline(ctx context.Context, req *pb.GetIssueTimelineRequest) (*pb.GetIssueTimelineResponse, error) {
+	log.Printf("[Handler] GetIssueTimeline called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.timelineService.GetIssueTimeline(ctx, req)
+	if err != nil {
+		log.Printf("[handler] GetIssueTimeline failed :%v", err)
+		return nil, toStatusError(err, "failed to get issue timeline")
+	}
+	return resp, nil
+}
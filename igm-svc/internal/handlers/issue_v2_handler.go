@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/services"
+	"log"
+
+	igmv2 "igm-svc/api/proto/igm/v2"
+)
+
+// IssueV2Handler serves igm.v2.IssueService on top of the v1 services. The
+// mapper converts the enums to and from the ONDC wire strings.
+type IssueV2Handler struct {
+	igmv2.UnimplementedIssueServiceServer
+	issueService *services.IssueService
+}
+
+func NewIssueV2Handler(issueService *services.IssueService) *IssueV2Handler {
+	return &IssueV2Handler{issueService: issueService}
+}
+
+func (h *IssueV2Handler) CreateIssue(ctx context.Context, req *igmv2.CreateIssueRequest) (*igmv2.CreateIssueResponse, error) {
+	log.Printf("[Handler] v2 CreateIssue called for user:%s, order:%s", req.UserId, req.OrderId)
+	resp, err := h.issueService.CreateIssue(ctx, mapper.FromV2CreateIssueRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 CreateIssue failed :%v", err)
+		return nil, toStatusError(err, "failed to create issue")
+	}
+	return mapper.ToV2CreateIssueResponse(resp), nil
+}
+
+func (h *IssueV2Handler) UpdateIssue(ctx context.Context, req *igmv2.UpdateIssueRequest) (*igmv2.UpdateIssueResponse, error) {
+	log.Printf("[Handler] v2 UpdateIssue called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueService.UpdateIssue(ctx, mapper.FromV2UpdateIssueRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 UpdateIssue failed :%v", err)
+		return nil, toStatusError(err, "failed to update issue")
+	}
+	return mapper.ToV2UpdateIssueResponse(resp), nil
+}
+
+func (h *IssueV2Handler) CloseIssue(ctx context.Context, req *igmv2.CloseIssueRequest) (*igmv2.CloseIssueResponse, error) {
+	log.Printf("[Handler] v2 CloseIssue called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueService.CloseIssue(ctx, mapper.FromV2CloseIssueRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 CloseIssue failed :%v", err)
+		return nil, toStatusError(err, "failed to close issue")
+	}
+	return mapper.ToV2CloseIssueResponse(resp), nil
+}
+
+func (h *IssueV2Handler) AcceptResolution(ctx context.Context, req *igmv2.AcceptResolutionRequest) (*igmv2.AcceptResolutionResponse, error) {
+	log.Printf("[Handler] v2 AcceptResolution called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueService.AcceptResolution(ctx, mapper.FromV2AcceptResolutionRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 AcceptResolution failed :%v", err)
+		return nil, toStatusError(err, "failed to accept resolution")
+	}
+	return mapper.ToV2AcceptResolutionResponse(resp), nil
+}
+
+func (h *IssueV2Handler) RejectResolution(ctx context.Context, req *igmv2.RejectResolutionRequest) (*igmv2.RejectResolutionResponse, error) {
+	log.Printf("[Handler] v2 RejectResolution called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueService.RejectResolution(ctx, mapper.FromV2RejectResolutionRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 RejectResolution failed :%v", err)
+		return nil, toStatusError(err, "failed to reject resolution")
+	}
+	return mapper.ToV2RejectResolutionResponse(resp), nil
+}
+
+func (h *IssueV2Handler) GetIssue(ctx context.Context, req *igmv2.GetIssueRequest) (*igmv2.GetIssueResponse, error) {
+	log.Printf("[Handler] v2 GetIssue called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.issueService.GetIssue(ctx, mapper.FromV2GetIssueRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 GetIssue failed :%v", err)
+		return nil, toStatusError(err, "failed to get issue")
+	}
+	return &igmv2.GetIssueResponse{Issue: mapper.ToV2Issue(resp.Issue)}, nil
+}
+
+func (h *IssueV2Handler) ListIssues(ctx context.Context, req *igmv2.ListIssuesRequest) (*igmv2.ListIssuesResponse, error) {
+	log.Printf("[Handler] v2 ListIssues called by user:%s", req.UserId)
+	resp, err := h.issueService.GetIssuesByUser(ctx, mapper.FromV2ListIssuesRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 ListIssues failed :%v", err)
+		return nil, toStatusError(err, "failed to list issues")
+	}
+	return mapper.ToV2ListIssuesResponse(resp), nil
+}
+
+func (h *IssueV2Handler) SearchIssues(ctx context.Context, req *igmv2.SearchIssuesRequest) (*igmv2.SearchIssuesResponse, error) {
+	log.Printf("[Handler] v2 SearchIssues called by user:%s", req.UserId)
+	resp, err := h.issueService.SearchIssues(ctx, mapper.FromV2SearchIssuesRequest(req))
+	if err != nil {
+		log.Printf("[handler] v2 SearchIssues failed :%v", err)
+		return nil, toStatusError(err, "failed to search issues")
+	}
+	return mapper.ToV2SearchIssuesResponse(resp), nil
+}
@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"google.golang.org/grpc"
+)
+
+func (h *IssueHandler) WatchIssue(req *pb.WatchIssueRequest, stream grpc.ServerStreamingServer[pb.IssueEvent]) error {
+	log.Printf("[Handler] WatchIssue called for user:%s, issue:%s, after:%s", req.UserId, req.IssueId, req.AfterCursor)
+	err := h.watchService.WatchIssue(stream.Context(), req, stream.Send)
+	if err != nil {
+		log.Printf("[handler] WatchIssue ended :%v", err)
+		return toStatusError(err, "failed to watch issue")
+	}
+	return nil
+}
+
+func (h *IssueHandler) WatchUserIssues(req *pb.WatchUserIssuesRequest, stream grpc.ServerStreamingServer[pb.IssueEvent]) error {
+	log.Printf("[Handler] WatchUserIssues called for user:%s, after:%s", req.UserId, req.AfterCursor)
+	err := h.watchService.WatchUserIssues(stream.Context(), req, stream.Send)
+	if err != nil {
+		log.Printf("[handler] WatchUserIssues ended :%v", err)
+		return toStatusError(err, "failed to watch user issues")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+func (h *IssueHandler) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+	log.Printf("[Handler] GetNotificationPreferences called for user:%s", req.UserId)
+	resp, err := h.notificationService.GetNotificationPreferences(ctx, req)
+	if err != nil {
+		log.Printf("[handler] GetNotificationPreferences failed :%v", err)
+		return nil, toStatusError(err, "failed to get notification preferences")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+	log.Printf("[Handler] UpdateNotificationPreferences called for user:%s", req.GetPreferences().GetUserId())
+	resp, err := h.notificationService.UpdateNotificationPreferences(ctx, req)
+	if err != nil {
+		log.Printf("[handler] UpdateNotificationPreferences failed :%v", err)
+		return nil, toStatusError(err, "failed to update notification preferences")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	log.Printf("[Handler] ListNotifications called for user:%s, issue:%s", req.UserId, req.IssueId)
+	resp, err := h.notificationService.ListNotifications(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ListNotifications failed :%v", err)
+		return nil, toStatusError(err, "failed to list notifications")
+	}
+	return resp, nil
+}
@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"log"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
+	pb "igm-svc/api/proto/igm/v1"
+	"igm-svc/internal/auth"
 )
 
 func (h *IssueHandler) HandleOnIssue(ctx context.Context, req *pb.OnIssueRequest) (*pb.OnIssueResponse, error) {
 
 	log.Printf("[ONDC] Received in_issue callback: transaction_id=%s,message_id:=%s", req.TransactionId, req.MessageId)
 
+	if err := auth.RequireRole(ctx, auth.RoleService); err != nil {
+		return nil, err
+	}
+
 	if req.Payload == nil {
 		return &pb.OnIssueResponse{Status: "ERROR", Message: "empty payload"}, nil
 	}
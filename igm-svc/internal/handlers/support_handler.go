@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"context"
+	"igm-svc/internal/services"
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+type SupportHandler struct {
+	pb.UnimplementedSupportServiceServer
+	supportService *services.SupportService
+}
+
+func NewSupportHandler(supportService *services.SupportService) *SupportHandler {
+	return &SupportHandler{supportService: supportService}
+}
+
+func (h *SupportHandler) SearchIssues(ctx context.Context, req *pb.SearchIssuesRequest) (*pb.SearchIssuesResponse, error) {
+	log.Printf("[Handler] SearchIssues called status:%s, bpp:%s, category:%s", req.Status, req.BppId, req.Category)
+	resp, err := h.supportService.SearchIssues(ctx, req)
+	if err != nil {
+		log.Printf("[handler] SearchIssues failed :%v", err)
+		return nil, toStatusError(err, "failed to search issues")
+	}
+	return resp, nil
+}
+
+func (h *SupportHandler) GetIssueDetails(ctx context.Context, req *pb.GetIssueDetailsRequest) (*pb.IssueDetails, error) {
+	log.Printf("[Handler] GetIssueDetails called for issue:%s", req.IssueId)
+	resp, err := h.supportService.GetIssueDetails(ctx, req)
+	if err != nil {
+		log.Printf("[handler] GetIssueDetails failed :%v", err)
+		return nil, toStatusError(err, "failed to get issue details")
+	}
+	return resp, nil
+}
+
+func (h *SupportHandler) AddInternalNote(ctx context.Context, req *pb.AddInternalNoteRequest) (*pb.InternalNote, error) {
+	log.Printf("[Handler] AddInternalNote called for issue:%s", req.IssueId)
+	resp, err := h.supportService.AddInternalNote(ctx, req)
+	if err != nil {
+		log.Printf("[handler] AddInternalNote failed :%v", err)
+		return nil, toStatusError(err, "failed to add internal note")
+	}
+	return resp, nil
+}
+
+func (h *SupportHandler) ReassignIssue(ctx context.Context, req *pb.ReassignIssueRequest) (*pb.ReassignIssueResponse, error) {
+	log.Printf("[Handler] ReassignIssue called for issue:%s, assignee:%s", req.IssueId, req.Assignee)
+	resp, err := h.supportService.ReassignIssue(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ReassignIssue failed :%v", err)
+		return nil, toStatusError(err, "failed to reassign issue")
+	}
+	return resp, nil
+}
+
+func (h *SupportHandler) ForceIssueStatus(ctx context.Context, req *pb.ForceIssueStatusRequest) (*pb.ForceIssueStatusResponse, error) {
+	log.Printf("[Handler] ForceIssueStatus called for issue:%s, status:%s", req.IssueId, req.Status)
+	resp, err := h.supportService.ForceIssueStatus(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ForceIssueStatus failed :%v", err)
+		return nil, toStatusError(err, "failed to force issue status")
+	}
+	return resp, nil
+}
+
+func (h *SupportHandler) ListSupportAuditLog(ctx context.Context, req *pb.ListSupportAuditLogRequest) (*pb.ListSupportAuditLogResponse, error) {
+	log.Printf("[Handler] ListSupportAuditLog called for issue:%s, actor:%s", req.IssueId, req.Actor)
+	resp, err := h.supportService.ListSupportAuditLog(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ListSupportAuditLog failed :%v", err)
+		return nil, toStatusError(err, "failed to list support audit log")
+	}
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"log"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+func (h *IssueHandler) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	log.Printf("[Handler] CreateWebhookSubscription called for name:%s, url:%s", req.Name, req.Url)
+	resp, err := h.webhookService.CreateWebhookSubscription(ctx, req)
+	if err != nil {
+		log.Printf("[handler] CreateWebhookSubscription failed :%v", err)
+		return nil, toStatusError(err, "failed to create webhook subscription")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) GetWebhookSubscription(ctx context.Context, req *pb.GetWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	log.Printf("[Handler] GetWebhookSubscription called for id:%s", req.Id)
+	resp, err := h.webhookService.GetWebhookSubscription(ctx, req)
+	if err != nil {
+		log.Printf("[handler] GetWebhookSubscription failed :%v", err)
+		return nil, toStatusError(err, "failed to get webhook subscription")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) ListWebhookSubscriptions(ctx context.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	log.Printf("[Handler] ListWebhookSubscriptions called active_only:%v", req.ActiveOnly)
+	resp, err := h.webhookService.ListWebhookSubscriptions(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ListWebhookSubscriptions failed :%v", err)
+		return nil, toStatusError(err, "failed to list webhook subscriptions")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) UpdateWebhookSubscription(ctx context.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	log.Printf("[Handler] UpdateWebhookSubscription called for id:%s", req.Id)
+	resp, err := h.webhookService.UpdateWebhookSubscription(ctx, req)
+	if err != nil {
+		log.Printf("[handler] UpdateWebhookSubscription failed :%v", err)
+		return nil, toStatusError(err, "failed to update webhook subscription")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	log.Printf("[Handler] DeleteWebhookSubscription called for id:%s", req.Id)
+	resp, err := h.webhookService.DeleteWebhookSubscription(ctx, req)
+	if err != nil {
+		log.Printf("[handler] DeleteWebhookSubscription failed :%v", err)
+		return nil, toStatusError(err, "failed to delete webhook subscription")
+	}
+	return resp, nil
+}
+
+func (h *IssueHandler) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	log.Printf("[Handler] ListWebhookDeliveries called for subscription:%s", req.SubscriptionId)
+	resp, err := h.webhookService.ListWebhookDeliveries(ctx, req)
+	if err != nil {
+		log.Printf("[handler] ListWebhookDeliveries failed :%v", err)
+		return nil, toStatusError(err, "failed to list webhook deliveries")
+	}
+	return resp, nil
+}
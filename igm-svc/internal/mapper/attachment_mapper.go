@@ -0,0 +1,28 @@
+package mapper
+
+import (
+	"igm-svc/internal/models"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+// ToProtoAttachment takes the signed URL separately since it is minted per
+// response.
+func ToProtoAttachment(a *models.Attachment, url string, urlExpiresAt time.Time) *pb.Attachment {
+	if a == nil {
+		return nil
+	}
+	return &pb.Attachment{
+		AttachmentId: a.ID.String(),
+		UserId:       a.UserID.String(),
+		FileName:     a.FileName,
+		ContentType:  a.ContentType,
+		SizeBytes:    a.SizeBytes,
+		Width:        int32(a.Width),
+		Height:       int32(a.Height),
+		Url:          url,
+		UrlExpiresAt: urlExpiresAt.Format(time.RFC3339),
+		CreatedAt:    a.CreatedAt.Format(time.RFC3339),
+	}
+}
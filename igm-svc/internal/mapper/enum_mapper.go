@@ -0,0 +1,81 @@
+package mapper
+
+import (
+	igmv2 "igm-svc/api/proto/igm/v2"
+)
+
+// wireEnum converts a v2 proto enum to and from its ONDC wire string. The
+// tables below are the only place the wire strings for status, category,
+// issue type and rating are listed; v1 validation checks against them too.
+type wireEnum[E ~int32] struct {
+	values   []string
+	toWire   map[E]string
+	fromWire map[string]E
+}
+
+type pair[E ~int32] struct {
+	enum E
+	wire string
+}
+
+func newWireEnum[E ~int32](pairs ...pair[E]) wireEnum[E] {
+	w := wireEnum[E]{toWire: map[E]string{}, fromWire: map[string]E{}}
+	for _, p := range pairs {
+		w.values = append(w.values, p.wire)
+		w.toWire[p.enum] = p.wire
+		w.fromWire[p.wire] = p.enum
+	}
+	return w
+}
+
+var (
+	issueStatuses = newWireEnum(
+		pair[igmv2.IssueStatus]{igmv2.IssueStatus_ISSUE_STATUS_OPEN, "OPEN"},
+		pair[igmv2.IssueStatus]{igmv2.IssueStatus_ISSUE_STATUS_CLOSED, "CLOSED"},
+	)
+	issueCategories = newWireEnum(
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_ORDER, "ORDER"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_FULFILLMENT, "FULFILLMENT"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_PAYMENT, "PAYMENT"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_ITEM, "ITEM"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_AGENT, "AGENT"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_CUSTOMER, "CUSTOMER"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_TECHNICAL, "TECHNICAL"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_VISIBILITY, "VISIBILITY"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_POLICY_BREACH, "POLICY BREACH"},
+		pair[igmv2.IssueCategory]{igmv2.IssueCategory_ISSUE_CATEGORY_BUSINESS, "BUSINESS"},
+	)
+	issueTypes = newWireEnum(
+		pair[igmv2.IssueType]{igmv2.IssueType_ISSUE_TYPE_ISSUE, "ISSUE"},
+		pair[igmv2.IssueType]{igmv2.IssueType_ISSUE_TYPE_GRIEVANCE, "GRIEVANCE"},
+		pair[igmv2.IssueType]{igmv2.IssueType_ISSUE_TYPE_DISPUTE, "DISPUTE"},
+	)
+	ratings = newWireEnum(
+		pair[igmv2.Rating]{igmv2.Rating_RATING_THUMBS_UP, "THUMBS-UP"},
+		pair[igmv2.Rating]{igmv2.Rating_RATING_THUMBS_DOWN, "THUMBS-DOWN"},
+	)
+)
+
+// IssueStatusToWire returns "" for ISSUE_STATUS_UNSPECIFIED and unknown
+// values, which v1 treats as a missing field. The other ToWire functions
+// behave the same.
+func IssueStatusToWire(s igmv2.IssueStatus) string { return issueStatuses.toWire[s] }
+
+// IssueStatusFromWire returns ISSUE_STATUS_UNSPECIFIED for strings outside
+// the ONDC set. The other FromWire functions behave the same.
+func IssueStatusFromWire(s string) igmv2.IssueStatus { return issueStatuses.fromWire[s] }
+
+// IssueStatusWireValues lists the valid wire strings in enum order.
+func IssueStatusWireValues() []string { return issueStatuses.values }
+
+func IssueCategoryToWire(c igmv2.IssueCategory) string   { return issueCategories.toWire[c] }
+func IssueCategoryFromWire(s string) igmv2.IssueCategory { return issueCategories.fromWire[s] }
+func IssueCategoryWireValues() []string                  { return issueCategories.values }
+
+func IssueTypeToWire(t igmv2.IssueType) string   { return issueTypes.toWire[t] }
+func IssueTypeFromWire(s string) igmv2.IssueType { return issueTypes.fromWire[s] }
+func IssueTypeWireValues() []string              { return issueTypes.values }
+
+func RatingToWire(r igmv2.Rating) string   { return ratings.toWire[r] }
+func RatingFromWire(s string) igmv2.Rating { return ratings.fromWire[s] }
+func RatingWireValues() []string           { return ratings.values }
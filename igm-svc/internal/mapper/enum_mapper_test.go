@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"testing"
+
+	pb "igm-svc/api/proto/igm/v1"
+	igmv2 "igm-svc/api/proto/igm/v2"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWireEnums_RoundTrip(t *testing.T) {
+	for v := range igmv2.IssueCategory_name {
+		c := igmv2.IssueCategory(v)
+		if c == igmv2.IssueCategory_ISSUE_CATEGORY_UNSPECIFIED {
+			continue
+		}
+		assert.Equal(t, c, IssueCategoryFromWire(IssueCategoryToWire(c)), c.String())
+	}
+	for v := range igmv2.IssueType_name {
+		if it := igmv2.IssueType(v); it != igmv2.IssueType_ISSUE_TYPE_UNSPECIFIED {
+			assert.Equal(t, it, IssueTypeFromWire(IssueTypeToWire(it)), it.String())
+		}
+	}
+	assert.Equal(t, "POLICY BREACH", IssueCategoryToWire(igmv2.IssueCategory_ISSUE_CATEGORY_POLICY_BREACH))
+	assert.Equal(t, "THUMBS-DOWN", RatingToWire(igmv2.Rating_RATING_THUMBS_DOWN))
+	assert.Equal(t, igmv2.IssueStatus_ISSUE_STATUS_CLOSED, IssueStatusFromWire("CLOSED"))
+
+	assert.Equal(t, "", IssueStatusToWire(igmv2.IssueStatus_ISSUE_STATUS_UNSPECIFIED))
+	assert.Equal(t, "", RatingToWire(igmv2.Rating(42)))
+	assert.Equal(t, igmv2.IssueCategory_ISSUE_CATEGORY_UNSPECIFIED, IssueCategoryFromWire("order"))
+	assert.Len(t, IssueCategoryWireValues(), len(igmv2.IssueCategory_name)-1)
+}
+
+func TestToV2Issue(t *testing.T) {
+	issue := ToV2Issue(&pb.Issue{
+		IssueId:   "issue-1",
+		Category:  "FULFILLMENT",
+		IssueType: "GRIEVANCE",
+		Status:    "OPEN",
+		Rating:    "THUMBS-UP",
+	})
+	assert.Equal(t, igmv2.IssueCategory_ISSUE_CATEGORY_FULFILLMENT, issue.Category)
+	assert.Equal(t, igmv2.IssueType_ISSUE_TYPE_GRIEVANCE, issue.IssueType)
+	assert.Equal(t, igmv2.IssueStatus_ISSUE_STATUS_OPEN, issue.Status)
+	assert.Equal(t, igmv2.Rating_RATING_THUMBS_UP, issue.Rating)
+
+	req := FromV2UpdateIssueRequest(&igmv2.UpdateIssueRequest{IssueId: "issue-1", Status: igmv2.IssueStatus_ISSUE_STATUS_OPEN})
+	assert.Equal(t, "OPEN", req.Status)
+	assert.Equal(t, "", req.IssueType, "unspecified keeps the current type")
+}
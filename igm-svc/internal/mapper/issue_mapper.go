@@ -5,7 +5,10 @@ import (
 	"igm-svc/internal/models"
 	"time"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
+	pb "igm-svc/api/proto/igm/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 func ToProtoIssue(m *models.Issue) *pb.Issue {
@@ -14,28 +17,65 @@ func ToProtoIssue(m *models.Issue) *pb.Issue {
 	}
 
 	proto := &pb.Issue{
-		IssueId:          m.IssueID,
-		OrderId:          m.OrderID,
-		UserId:           m.UserID.String(),
-		TransactionId:    m.TransactionID,
-		Category:         m.Category,
-		SubCategory:      m.SubCategory,
-		IssueType:        m.IssueType,
-		Status:           m.Status,
-		DescriptionShort: m.DescriptionShort,
-		DescriptionLong:  m.DescriptionLong,
-		ImageUrls:        []string{},
-		BppId:            m.BPPID,
-		BppUri:           m.BPPURI,
-		CreatedAt:        m.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:        m.UpdatedAt.Format(time.RFC3339),
+		IssueId:                m.IssueID,
+		OrderId:                m.OrderID,
+		UserId:                 m.UserID.String(),
+		TransactionId:          m.TransactionID,
+		Category:               m.Category,
+		SubCategory:            m.SubCategory,
+		IssueType:              m.IssueType,
+		Status:                 m.Status,
+		DescriptionShort:       m.DescriptionShort,
+		DescriptionLong:        m.DescriptionLong,
+		ImageUrls:              []string{},
+		BppId:                  m.BPPID,
+		BppUri:                 m.BPPURI,
+		CreatedAt:              m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:              m.UpdatedAt.Format(time.RFC3339),
+		CascadedLevel:          int32(m.CascadedLevel),
+		RespondentStatus:       m.RespondentStatus,
+		Rating:                 m.Rating,
+		ExpectedResponseTime:   m.ExpectedResponseTime,
+		ExpectedResolutionTime: m.ExpectedResolutionTime,
+		RespondBy:              formatTime(m.RespondBy),
+		ResolveBy:              formatTime(m.ResolveBy),
+		ResponseBreachedAt:     formatTime(m.ResponseBreachedAt),
+		ResolutionBreachedAt:   formatTime(m.ResolutionBreachedAt),
+		ResolvedAt:             formatTime(m.ResolvedAt),
+		AutoClosedAt:           formatTime(m.AutoClosedAt),
+		OdrProviderId:          m.OdrProviderID,
+		DisputeRaisedAt:        formatTime(m.DisputeRaisedAt),
 	}
 	if len(m.Images) > 0 {
 		var imgs []string
-		if err := json.Unmarshal(m.Images, &imgs); err == nil {
+		if err := json.Unmarshal(m.Images, &imgs); err == nil && imgs != nil {
 			proto.ImageUrls = imgs
 		}
 	}
+	if m.DescriptionURL != "" {
+		proto.AdditionalDesc = &pb.AdditionalDescription{
+			Url:         m.DescriptionURL,
+			ContentType: m.DescriptionContentType,
+		}
+	}
+	if m.UserName != "" || m.UserPhone != "" || m.UserEmail != "" {
+		proto.ComplainantInfo = &pb.ComplainantInfo{
+			Person:  &pb.Person{Name: m.UserName},
+			Contact: &pb.Contact{Phone: m.UserPhone, Email: m.UserEmail},
+		}
+	}
+	proto.OrderDetails = toProtoOrderDetails(m.OrderDetails)
+	proto.ComplainantActions = toProtoComplainantActions(m.ComplainantActions)
+
+	proto.RespondentActions = ParseRespondentActions(m.RespondentActions)
+	if len(m.ResolutionProvider) > 0 {
+		var rp pb.ResolutionProvider
+		if err := unmarshalJSONB(m.ResolutionProvider, &rp); err == nil {
+			proto.ResolutionProvider = &rp
+			proto.Gro = displayGro(rp.GetRespondentInfo().GetResolutionSupport().GetGros())
+		}
+	}
+	proto.Resolution = ParseResolution(m.Resolution)
 	return proto
 }
 
@@ -44,10 +84,226 @@ func ToProtoIssues(ms []*models.Issue) []*pb.Issue {
 		return nil
 	}
 
-	out := make([]*pb.Issue, len(ms))
+	out := make([]*pb.Issue, 0, len(ms))
 
 	for _, m := range ms {
 		out = append(out, ToProtoIssue(m))
 	}
 	return out
 }
+
+// JSONB columns written by the callbacks hold protojson output; older rows and
+// our own complainant actions use snake_case keys. protojson accepts both.
+var jsonbUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+func unmarshalJSONB(raw []byte, msg proto.Message) error {
+	return jsonbUnmarshaler.Unmarshal(raw, msg)
+}
+
+// ComplainantActionEntry is a stored complainant action; Internal entries are
+// audit records that are never sent to the BPP.
+type ComplainantActionEntry struct {
+	Action   *pb.ComplainantAction
+	Internal bool
+}
+
+func ParseComplainantActions(raw []byte) []ComplainantActionEntry {
+	if len(raw) == 0 {
+		return nil
+	}
+	var stored []map[string]interface{}
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		return nil
+	}
+	out := make([]ComplainantActionEntry, 0, len(stored))
+	for _, entry := range stored {
+		internal, _ := entry["internal"].(bool)
+		delete(entry, "internal")
+		// UpdateIssue used to store the action under this misspelt key
+		if action, ok := entry["complaint_action"]; ok {
+			entry["complainant_action"] = action
+			delete(entry, "complaint_action")
+		}
+		b, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		var action pb.ComplainantAction
+		if err := unmarshalJSONB(b, &action); err != nil {
+			continue
+		}
+		out = append(out, ComplainantActionEntry{Action: &action, Internal: internal})
+	}
+	return out
+}
+
+// ParseRespondentActions reads the respondent_actions JSONB, stored as an
+// IssueActions message.
+func ParseRespondentActions(raw []byte) []*pb.RespondentAction {
+	if len(raw) == 0 {
+		return nil
+	}
+	var ia pb.IssueActions
+	if err := unmarshalJSONB(raw, &ia); err != nil {
+		return nil
+	}
+	return ia.RespondentActions
+}
+
+func ParseResolution(raw []byte) *pb.Resolution {
+	if len(raw) == 0 {
+		return nil
+	}
+	var res pb.Resolution
+	if err := unmarshalJSONB(raw, &res); err != nil {
+		return nil
+	}
+	return &res
+}
+
+// toProtoComplainantActions returns the actions the complainant can see;
+// internal audit entries are left out.
+func toProtoComplainantActions(raw []byte) []*pb.ComplainantAction {
+	entries := ParseComplainantActions(raw)
+	if entries == nil {
+		return nil
+	}
+	out := make([]*pb.ComplainantAction, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Internal {
+			continue
+		}
+		out = append(out, entry.Action)
+	}
+	return out
+}
+
+func toProtoOrderDetails(raw []byte) *pb.OrderDetails {
+	if len(raw) == 0 {
+		return nil
+	}
+	var details struct {
+		ID         string `json:"id"`
+		ProviderID string `json:"provider_id"`
+		Items      []struct {
+			ID       string `json:"id"`
+			Quantity int32  `json:"quantity"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &details); err != nil {
+		return nil
+	}
+	out := &pb.OrderDetails{Id: details.ID, ProviderId: details.ProviderID}
+	for _, item := range details.Items {
+		out.Items = append(out.Items, &pb.IssueItem{Id: item.ID, Quantity: item.Quantity})
+	}
+	return out
+}
+
+// displayGro picks the GRO the complainant should contact: the interfacing
+// NP's own GRO when given, otherwise the first one listed.
+func displayGro(gros []*pb.Gro) *pb.Gro {
+	for _, gro := range gros {
+		if gro.GetGroType() == "INTERFACING-NP-GRO" {
+			return gro
+		}
+	}
+	if len(gros) > 0 {
+		return gros[0]
+	}
+	return nil
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func ToProtoInfoMessage(m *models.IssueInfoMessage) *pb.InfoMessage {
+	if m == nil {
+		return nil
+	}
+
+	proto := &pb.InfoMessage{
+		Action:    m.Action,
+		Actor:     m.Actor,
+		ShortDesc: m.ShortDesc,
+		LongDesc:  m.LongDesc,
+		ImageUrls: []string{},
+		UpdatedBy: m.UpdatedBy,
+		CreatedAt: m.ActionAt.Format(time.RFC3339),
+	}
+	if m.AdditionalDescURL != "" {
+		proto.AdditionalDesc = &pb.AdditionalDescription{
+			Url:         m.AdditionalDescURL,
+			ContentType: m.AdditionalDescContentType,
+		}
+	}
+	if len(m.Images) > 0 {
+		var imgs []string
+		if err := json.Unmarshal(m.Images, &imgs); err == nil && imgs != nil {
+			proto.ImageUrls = imgs
+		}
+	}
+	return proto
+}
+
+func ToProtoInfoMessages(ms []*models.IssueInfoMessage) []*pb.InfoMessage {
+	out := make([]*pb.InfoMessage, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, ToProtoInfoMessage(m))
+	}
+	return out
+}
+
+func ToProtoRespondentParty(m *models.IssueRespondent) *pb.RespondentParty {
+	if m == nil {
+		return nil
+	}
+
+	proto := &pb.RespondentParty{
+		CascadedLevel: int32(m.CascadedLevel),
+		Organization: &pb.Organization{
+			Org:     &pb.Org{Name: m.OrgName},
+			Person:  &pb.Person{Name: m.PersonName},
+			Contact: &pb.Contact{Phone: m.Phone, Email: m.Email},
+		},
+		LastAction: m.LastAction,
+	}
+	if m.GroName != "" || m.GroPhone != "" || m.GroEmail != "" {
+		proto.Gro = &pb.Gro{
+			Person:  &pb.Person{Name: m.GroName},
+			Contact: &pb.Contact{Phone: m.GroPhone, Email: m.GroEmail},
+			GroType: m.GroType,
+		}
+	}
+	if m.LastActionAt != nil {
+		proto.UpdatedAt = m.LastActionAt.Format(time.RFC3339)
+	}
+	return proto
+}
+
+// AttachRespondentChain sets the respondent chain on an issue and picks the
+// party at the issue's current cascade level as the one responsible.
+func AttachRespondentChain(issue *pb.Issue, chain []*models.IssueRespondent, currentLevel int) {
+	if issue == nil || len(chain) == 0 {
+		return
+	}
+	issue.RespondentChain = make([]*pb.RespondentParty, 0, len(chain))
+	for _, m := range chain {
+		party := ToProtoRespondentParty(m)
+		issue.RespondentChain = append(issue.RespondentChain, party)
+		if m.CascadedLevel == currentLevel {
+			issue.CurrentRespondent = party
+		}
+	}
+	if issue.CurrentRespondent == nil {
+		issue.CurrentRespondent = issue.RespondentChain[len(issue.RespondentChain)-1]
+	}
+	// after a cascade the responsible party's GRO takes over
+	if issue.CurrentRespondent.Gro != nil {
+		issue.Gro = issue.CurrentRespondent.Gro
+	}
+}
@@ -0,0 +1,55 @@
+package mapper
+
+import (
+	"testing"
+	"time"
+
+	"igm-svc/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestToProtoIssueJSONBColumns(t *testing.T) {
+	resolvedAt := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	m := &models.Issue{
+		IssueID:          "issue-1",
+		UserID:           uuid.New(),
+		Status:           "OPEN",
+		RespondentStatus: "RESOLVED",
+		ResolvedAt:       &resolvedAt,
+		ComplainantActions: datatypes.JSON(`[
+			{"complainant_action":"OPEN","short_desc":"item damaged","updated_at":"2026-01-01T10:00:00Z"},
+			{"complainant_action":"SLA_BREACHED","short_desc":"audit","internal":true},
+			{"complaint_action":"ESCALATE","short_desc":"no reply"}
+		]`),
+		RespondentActions:  datatypes.JSON(`{"respondentActions":[{"respondentAction":"RESOLVED","cascadedLevel":1}]}`),
+		Resolution:         datatypes.JSON(`{"shortDesc":"refund issued","refundAmount":"100"}`),
+		ResolutionProvider: datatypes.JSON(`{"respondentInfo":{"resolutionSupport":{"gros":[{"person":{"name":"Counterparty GRO"},"groType":"TRANSACTION-COUNTERPARTY-NP-GRO"},{"person":{"name":"Seller GRO"},"contact":{"phone":"9999999999"},"groType":"INTERFACING-NP-GRO"}]}}}`),
+		OrderDetails:       datatypes.JSON(`{"id":"order-1","provider_id":"bpp","items":[{"id":"item-1","quantity":2}]}`),
+	}
+
+	issue := ToProtoIssue(m)
+
+	require.Len(t, issue.ComplainantActions, 2)
+	assert.Equal(t, "OPEN", issue.ComplainantActions[0].ComplainantAction)
+	assert.Equal(t, "ESCALATE", issue.ComplainantActions[1].ComplainantAction)
+
+	require.Len(t, issue.RespondentActions, 1)
+	assert.Equal(t, "RESOLVED", issue.RespondentActions[0].RespondentAction)
+	assert.Equal(t, "100", issue.Resolution.GetRefundAmount())
+	assert.Equal(t, "Seller GRO", issue.Gro.GetPerson().GetName())
+	assert.Equal(t, "9999999999", issue.Gro.GetContact().GetPhone())
+	assert.Equal(t, "2026-01-02T10:00:00Z", issue.ResolvedAt)
+	require.Len(t, issue.OrderDetails.GetItems(), 1)
+	assert.Equal(t, int32(2), issue.OrderDetails.Items[0].Quantity)
+}
+
+func TestToProtoIssuesKeepsOrder(t *testing.T) {
+	issues := ToProtoIssues([]*models.Issue{{IssueID: "a"}, {IssueID: "b"}})
+	require.Len(t, issues, 2)
+	assert.Equal(t, "a", issues[0].IssueId)
+	assert.Equal(t, "b", issues[1].IssueId)
+}
@@ -0,0 +1,236 @@
+package mapper
+
+import (
+	pb "igm-svc/api/proto/igm/v1"
+	igmv2 "igm-svc/api/proto/igm/v2"
+)
+
+// The v2 API is served by the v1 services: requests are converted to v1
+// with the enums spelled as ONDC wire strings, and responses back. The
+// validation interceptor rejects unspecified and unknown enums where v2
+// requires them, so a "" wire string here means "not filtered".
+
+func FromV2CreateIssueRequest(req *igmv2.CreateIssueRequest) *pb.CreateIssueRequest {
+	return &pb.CreateIssueRequest{
+		UserId:          req.UserId,
+		OrderId:         req.OrderId,
+		Category:        IssueCategoryToWire(req.Category),
+		SubCategory:     req.SubCategory,
+		IssueType:       IssueTypeToWire(req.IssueType),
+		Description:     req.Description,
+		LongDescription: req.LongDescription,
+		ImageUrls:       req.ImageUrls,
+		AdditionalDesc:  req.AdditionalDesc,
+		Items:           req.Items,
+		AttachmentIds:   req.AttachmentIds,
+	}
+}
+
+func ToV2CreateIssueResponse(resp *pb.CreateIssueResponse) *igmv2.CreateIssueResponse {
+	return &igmv2.CreateIssueResponse{
+		IssueId:       resp.IssueId,
+		OrderId:       resp.OrderId,
+		Status:        IssueStatusFromWire(resp.Status),
+		TransactionId: resp.TransactionId,
+		CreatedAt:     resp.CreatedAt,
+		OndcSent:      resp.OndcSent,
+		OndcMessage:   resp.OndcMessage,
+	}
+}
+
+func FromV2UpdateIssueRequest(req *igmv2.UpdateIssueRequest) *pb.UpdateIssueRequest {
+	return &pb.UpdateIssueRequest{
+		UserId:                     req.UserId,
+		IssueId:                    req.IssueId,
+		OrderId:                    req.OrderId,
+		IssueType:                  IssueTypeToWire(req.IssueType),
+		Status:                     IssueStatusToWire(req.Status),
+		ComplainantActionShortDesc: req.ComplainantActionShortDesc,
+	}
+}
+
+func ToV2UpdateIssueResponse(resp *pb.UpdateIssueResponse) *igmv2.UpdateIssueResponse {
+	return &igmv2.UpdateIssueResponse{
+		IssueId:     resp.IssueId,
+		Status:      IssueStatusFromWire(resp.Status),
+		UpdatedAt:   resp.UpdatedAt,
+		OndcSent:    resp.OndcSent,
+		OndcMessage: resp.OndcMessage,
+	}
+}
+
+func FromV2CloseIssueRequest(req *igmv2.CloseIssueRequest) *pb.CloseIssueRequest {
+	return &pb.CloseIssueRequest{
+		UserId:                req.UserId,
+		IssueId:               req.IssueId,
+		OrderId:               req.OrderId,
+		Rating:                RatingToWire(req.Rating),
+		Status:                IssueStatusToWire(req.Status),
+		ComplaintActShortDesc: req.ComplaintActShortDesc,
+	}
+}
+
+func ToV2CloseIssueResponse(resp *pb.CloseIssueResponse) *igmv2.CloseIssueResponse {
+	return &igmv2.CloseIssueResponse{
+		IssueId:     resp.IssueId,
+		Status:      IssueStatusFromWire(resp.Status),
+		ClosedAt:    resp.ClosedAt,
+		OndcSent:    resp.OndcSent,
+		OndcMessage: resp.OndcMessage,
+	}
+}
+
+func FromV2AcceptResolutionRequest(req *igmv2.AcceptResolutionRequest) *pb.AcceptResolutionRequest {
+	return &pb.AcceptResolutionRequest{
+		UserId:    req.UserId,
+		IssueId:   req.IssueId,
+		Rating:    RatingToWire(req.Rating),
+		ShortDesc: req.ShortDesc,
+	}
+}
+
+func ToV2AcceptResolutionResponse(resp *pb.AcceptResolutionResponse) *igmv2.AcceptResolutionResponse {
+	return &igmv2.AcceptResolutionResponse{
+		IssueId:     resp.IssueId,
+		Status:      IssueStatusFromWire(resp.Status),
+		ClosedAt:    resp.ClosedAt,
+		OndcSent:    resp.OndcSent,
+		OndcMessage: resp.OndcMessage,
+	}
+}
+
+func FromV2RejectResolutionRequest(req *igmv2.RejectResolutionRequest) *pb.RejectResolutionRequest {
+	return &pb.RejectResolutionRequest{
+		UserId:              req.UserId,
+		IssueId:             req.IssueId,
+		Reason:              req.Reason,
+		EscalateToGrievance: req.EscalateToGrievance,
+	}
+}
+
+func ToV2RejectResolutionResponse(resp *pb.RejectResolutionResponse) *igmv2.RejectResolutionResponse {
+	return &igmv2.RejectResolutionResponse{
+		IssueId:     resp.IssueId,
+		Status:      IssueStatusFromWire(resp.Status),
+		IssueType:   IssueTypeFromWire(resp.IssueType),
+		UpdatedAt:   resp.UpdatedAt,
+		OndcSent:    resp.OndcSent,
+		OndcMessage: resp.OndcMessage,
+	}
+}
+
+func FromV2GetIssueRequest(req *igmv2.GetIssueRequest) *pb.GetIssueRequest {
+	return &pb.GetIssueRequest{UserId: req.UserId, IssueId: req.IssueId}
+}
+
+func FromV2ListIssuesRequest(req *igmv2.ListIssuesRequest) *pb.ListIssueRequest {
+	return &pb.ListIssueRequest{
+		UserId:        req.UserId,
+		PageSize:      req.PageSize,
+		Status:        IssueStatusToWire(req.Status),
+		Category:      IssueCategoryToWire(req.Category),
+		IssueType:     IssueTypeToWire(req.IssueType),
+		OrderId:       req.OrderId,
+		CreatedFrom:   req.CreatedFrom,
+		CreatedTo:     req.CreatedTo,
+		HasResolution: req.HasResolution,
+		SortBy:        req.SortBy,
+		SortOrder:     req.SortOrder,
+		Cursor:        req.Cursor,
+	}
+}
+
+func ToV2ListIssuesResponse(resp *pb.ListIssueResponse) *igmv2.ListIssuesResponse {
+	return &igmv2.ListIssuesResponse{
+		Issues:     ToV2Issues(resp.Issues),
+		TotalCount: resp.TotalCount,
+		NextCursor: resp.NextCursor,
+	}
+}
+
+func FromV2SearchIssuesRequest(req *igmv2.SearchIssuesRequest) *pb.IssueSearchRequest {
+	return &pb.IssueSearchRequest{
+		UserId:        req.UserId,
+		Query:         req.Query,
+		Status:        IssueStatusToWire(req.Status),
+		Category:      IssueCategoryToWire(req.Category),
+		IssueType:     IssueTypeToWire(req.IssueType),
+		OrderId:       req.OrderId,
+		CreatedFrom:   req.CreatedFrom,
+		CreatedTo:     req.CreatedTo,
+		HasResolution: req.HasResolution,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+	}
+}
+
+func ToV2SearchIssuesResponse(resp *pb.IssueSearchResponse) *igmv2.SearchIssuesResponse {
+	out := &igmv2.SearchIssuesResponse{
+		Hits:       make([]*igmv2.SearchIssueHit, 0, len(resp.Hits)),
+		TotalCount: resp.TotalCount,
+		Page:       resp.Page,
+		PageSize:   resp.PageSize,
+	}
+	for _, hit := range resp.Hits {
+		out.Hits = append(out.Hits, &igmv2.SearchIssueHit{
+			Issue:   ToV2Issue(hit.Issue),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		})
+	}
+	return out
+}
+
+func ToV2Issues(issues []*pb.Issue) []*igmv2.Issue {
+	out := make([]*igmv2.Issue, 0, len(issues))
+	for _, issue := range issues {
+		out = append(out, ToV2Issue(issue))
+	}
+	return out
+}
+
+func ToV2Issue(i *pb.Issue) *igmv2.Issue {
+	if i == nil {
+		return nil
+	}
+	return &igmv2.Issue{
+		IssueId:                i.IssueId,
+		OrderId:                i.OrderId,
+		UserId:                 i.UserId,
+		TransactionId:          i.TransactionId,
+		Category:               IssueCategoryFromWire(i.Category),
+		SubCategory:            i.SubCategory,
+		IssueType:              IssueTypeFromWire(i.IssueType),
+		Status:                 IssueStatusFromWire(i.Status),
+		DescriptionShort:       i.DescriptionShort,
+		DescriptionLong:        i.DescriptionLong,
+		ImageUrls:              i.ImageUrls,
+		BppId:                  i.BppId,
+		BppUri:                 i.BppUri,
+		CreatedAt:              i.CreatedAt,
+		UpdatedAt:              i.UpdatedAt,
+		CascadedLevel:          i.CascadedLevel,
+		CurrentRespondent:      i.CurrentRespondent,
+		RespondentChain:        i.RespondentChain,
+		RespondentStatus:       i.RespondentStatus,
+		Rating:                 RatingFromWire(i.Rating),
+		Resolution:             i.Resolution,
+		ResolutionProvider:     i.ResolutionProvider,
+		ComplainantActions:     i.ComplainantActions,
+		RespondentActions:      i.RespondentActions,
+		Gro:                    i.Gro,
+		AdditionalDesc:         i.AdditionalDesc,
+		ComplainantInfo:        i.ComplainantInfo,
+		OrderDetails:           i.OrderDetails,
+		ExpectedResponseTime:   i.ExpectedResponseTime,
+		ExpectedResolutionTime: i.ExpectedResolutionTime,
+		RespondBy:              i.RespondBy,
+		ResolveBy:              i.ResolveBy,
+		ResponseBreachedAt:     i.ResponseBreachedAt,
+		ResolutionBreachedAt:   i.ResolutionBreachedAt,
+		ResolvedAt:             i.ResolvedAt,
+		AutoClosedAt:           i.AutoClosedAt,
+		OdrProviderId:          i.OdrProviderId,
+		DisputeRaisedAt:        i.DisputeRaisedAt,
+	}
+}
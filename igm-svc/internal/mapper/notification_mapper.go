@@ -0,0 +1,53 @@
+package mapper
+
+import (
+	"igm-svc/internal/models"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+func ToProtoNotificationPreferences(p *models.NotificationPreference) *pb.NotificationPreferences {
+	if p == nil {
+		return nil
+	}
+	return &pb.NotificationPreferences{
+		UserId:          p.UserID.String(),
+		SmsEnabled:      p.SMSEnabled,
+		EmailEnabled:    p.EmailEnabled,
+		PushEnabled:     p.PushEnabled,
+		PushToken:       p.PushToken,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		Timezone:        p.Timezone,
+	}
+}
+
+func ToProtoNotification(n *models.Notification) *pb.Notification {
+	if n == nil {
+		return nil
+	}
+	return &pb.Notification{
+		Id:        uint64(n.ID),
+		IssueId:   n.IssueID,
+		EventId:   n.EventID,
+		Kind:      n.Kind,
+		Channel:   n.Channel,
+		Recipient: n.Recipient,
+		Subject:   n.Subject,
+		Body:      n.Body,
+		Status:    n.Status,
+		Error:     n.Error,
+		SendAfter: formatTime(n.SendAfter),
+		SentAt:    formatTime(n.SentAt),
+		CreatedAt: n.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToProtoNotifications(ns []*models.Notification) []*pb.Notification {
+	out := make([]*pb.Notification, 0, len(ns))
+	for _, n := range ns {
+		out = append(out, ToProtoNotification(n))
+	}
+	return out
+}
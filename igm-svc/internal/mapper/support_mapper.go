@@ -0,0 +1,99 @@
+package mapper
+
+import (
+	"encoding/json"
+	"igm-svc/internal/models"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+func ToProtoSupportIssue(m *models.Issue) *pb.SupportIssue {
+	if m == nil {
+		return nil
+	}
+	return &pb.SupportIssue{
+		Issue:      ToProtoIssue(m),
+		AssignedTo: m.AssignedTo,
+		AssignedAt: formatTime(m.AssignedAt),
+	}
+}
+
+func ToProtoSupportIssues(ms []*models.Issue) []*pb.SupportIssue {
+	out := make([]*pb.SupportIssue, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, ToProtoSupportIssue(m))
+	}
+	return out
+}
+
+func ToProtoRawCallback(m *models.OndcCallback) *pb.RawCallback {
+	if m == nil {
+		return nil
+	}
+	return &pb.RawCallback{
+		TransactionId: m.TransactionID,
+		MessageId:     m.MessageID,
+		PayloadJson:   string(m.Payload),
+		ReceivedAt:    m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToProtoRawCallbacks(ms []*models.OndcCallback) []*pb.RawCallback {
+	out := make([]*pb.RawCallback, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, ToProtoRawCallback(m))
+	}
+	return out
+}
+
+func ToProtoInternalNote(m *models.IssueNote) *pb.InternalNote {
+	if m == nil {
+		return nil
+	}
+	return &pb.InternalNote{
+		Id:        uint64(m.ID),
+		IssueId:   m.IssueID,
+		Author:    m.Author,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func ToProtoInternalNotes(ms []*models.IssueNote) []*pb.InternalNote {
+	out := make([]*pb.InternalNote, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, ToProtoInternalNote(m))
+	}
+	return out
+}
+
+func ToProtoSupportAuditEntry(m *models.SupportAuditEntry) *pb.SupportAuditEntry {
+	if m == nil {
+		return nil
+	}
+	proto := &pb.SupportAuditEntry{
+		Id:        uint64(m.ID),
+		IssueId:   m.IssueID,
+		Actor:     m.Actor,
+		ActorRole: m.ActorRole,
+		Action:    m.Action,
+		Reason:    m.Reason,
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+	}
+	if len(m.Details) > 0 {
+		var details map[string]string
+		if err := json.Unmarshal(m.Details, &details); err == nil {
+			proto.Details = details
+		}
+	}
+	return proto
+}
+
+func ToProtoSupportAuditEntries(ms []*models.SupportAuditEntry) []*pb.SupportAuditEntry {
+	out := make([]*pb.SupportAuditEntry, 0, len(ms))
+	for _, m := range ms {
+		out = append(out, ToProtoSupportAuditEntry(m))
+	}
+	return out
+}
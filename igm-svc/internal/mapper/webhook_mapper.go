@@ -0,0 +1,73 @@
+package mapper
+
+import (
+	"encoding/json"
+	"igm-svc/internal/models"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+// ToProtoWebhookSubscription leaves the secret out; only create returns it.
+func ToProtoWebhookSubscription(s *models.WebhookSubscription) *pb.WebhookSubscription {
+	if s == nil {
+		return nil
+	}
+	proto := &pb.WebhookSubscription{
+		Id:                  s.ID.String(),
+		Name:                s.Name,
+		Url:                 s.URL,
+		EventTypes:          []string{},
+		Active:              s.Active,
+		ConsecutiveFailures: int32(s.ConsecutiveFailures),
+		DisabledAt:          formatTime(s.DisabledAt),
+		DisabledReason:      s.DisabledReason,
+		CreatedAt:           s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           s.UpdatedAt.Format(time.RFC3339),
+	}
+	if len(s.EventTypes) > 0 {
+		var types []string
+		if err := json.Unmarshal(s.EventTypes, &types); err == nil && types != nil {
+			proto.EventTypes = types
+		}
+	}
+	return proto
+}
+
+func ToProtoWebhookSubscriptions(ss []*models.WebhookSubscription) []*pb.WebhookSubscription {
+	out := make([]*pb.WebhookSubscription, 0, len(ss))
+	for _, s := range ss {
+		out = append(out, ToProtoWebhookSubscription(s))
+	}
+	return out
+}
+
+func ToProtoWebhookDelivery(d *models.WebhookDelivery) *pb.WebhookDelivery {
+	if d == nil {
+		return nil
+	}
+	proto := &pb.WebhookDelivery{
+		Id:             uint64(d.ID),
+		SubscriptionId: d.SubscriptionID.String(),
+		EventId:        d.EventID,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		LastStatusCode: int32(d.LastStatusCode),
+		LastError:      d.LastError,
+		DeliveredAt:    formatTime(d.DeliveredAt),
+		CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.Status == models.WebhookDeliveryPending {
+		proto.NextAttemptAt = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	return proto
+}
+
+func ToProtoWebhookDeliveries(ds []*models.WebhookDelivery) []*pb.WebhookDelivery {
+	out := make([]*pb.WebhookDelivery, 0, len(ds))
+	for _, d := range ds {
+		out = append(out, ToProtoWebhookDelivery(d))
+	}
+	return out
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attachment is a file a user uploaded to reference from an issue. The bytes
+// live in the object store under StorageKey; URLs to it are signed on demand
+// because they expire.
+type Attachment struct {
+	ID          uuid.UUID `gorm:"primaryKey;type:uuid" json:"id"`
+	UserID      uuid.UUID `gorm:"column:user_id;type:uuid;not null" json:"user_id"`
+	FileName    string    `gorm:"column:file_name;not null" json:"file_name"`
+	ContentType string    `gorm:"column:content_type;not null" json:"content_type"`
+	SizeBytes   int64     `gorm:"column:size_bytes;not null" json:"size_bytes"`
+	Width       int       `gorm:"column:width" json:"width"`
+	Height      int       `gorm:"column:height" json:"height"`
+	SHA256      string    `gorm:"column:sha256;not null" json:"sha256"`
+	StorageKey  string    `gorm:"column:storage_key;not null" json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (Attachment) TableName() string {
+	return "attachments"
+}
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// DomainEventOutbox is a domain event stored before it is relayed to the
+// event publisher. Rows stay until published, so a crash or publisher outage
+// delays events instead of losing them.
+type DomainEventOutbox struct {
+	ID            uint           `gorm:"primaryKey;autoIncrement" json:"id"`
+	EventID       string         `gorm:"column:event_id;uniqueIndex;not null" json:"event_id"`
+	EventType     string         `gorm:"column:event_type;not null" json:"event_type"`
+	IssueID       string         `gorm:"column:issue_id" json:"issue_id"`
+	Payload       datatypes.JSON `gorm:"type:jsonb;not null" json:"payload"`
+	Attempts      int            `gorm:"column:attempts;not null;default:0" json:"attempts"`
+	LastError     string         `gorm:"column:last_error" json:"last_error"`
+	NextAttemptAt time.Time      `gorm:"column:next_attempt_at;not null" json:"next_attempt_at"`
+	PublishedAt   *time.Time     `gorm:"column:published_at" json:"published_at"`
+	CreatedAt     time.Time      `json:"created_at"`
+}
+
+func (DomainEventOutbox) TableName() string {
+	return "domain_event_outbox"
+}
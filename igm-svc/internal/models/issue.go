@@ -37,6 +37,10 @@ type Issue struct {
     DescriptionURL         string         `gorm:"column:description_url" json:"description_url"`
     DescriptionContentType string         `gorm:"column:description_content_type" json:"description_content_type"`
     Images                 datatypes.JSON `gorm:"type:jsonb" json:"images"`
+    // Uploaded files are kept as attachment ids, never as signed URLs,
+    // which expire; see AttachmentService.ImageURLs
+    AttachmentIDs           datatypes.JSON `gorm:"type:jsonb;column:attachment_ids" json:"attachment_ids"`
+    DescriptionAttachmentID *uuid.UUID     `gorm:"type:uuid;column:description_attachment_id" json:"description_attachment_id,omitempty"`
     
     // Source - Fix column name mapping
     SourceNPID string `gorm:"column:source_npid" json:"source_npid"`  
@@ -45,9 +49,38 @@ type Issue struct {
     // Expected timelines
     ExpectedResponseTime   string `gorm:"column:expected_response_time" json:"expected_response_time"`
     ExpectedResolutionTime string `gorm:"column:expected_resolution_time" json:"expected_resolution_time"`
+    RespondBy              *time.Time `gorm:"column:respond_by;index" json:"respond_by,omitempty"`
+    ResolveBy              *time.Time `gorm:"column:resolve_by;index" json:"resolve_by,omitempty"`
+
+    // SLA breaches
+    ResponseBreachedAt   *time.Time `gorm:"column:response_breached_at" json:"response_breached_at,omitempty"`
+    ResolutionBreachedAt *time.Time `gorm:"column:resolution_breached_at" json:"resolution_breached_at,omitempty"`
+
+    // issue_status polling
+    LastStatusPollAt *time.Time `gorm:"column:last_status_poll_at" json:"last_status_poll_at,omitempty"`
+    NextStatusPollAt *time.Time `gorm:"column:next_status_poll_at;index" json:"next_status_poll_at,omitempty"`
+
+    // Resolution acceptance
+    ResolvedAt   *time.Time `gorm:"column:resolved_at;index" json:"resolved_at,omitempty"`
+    AutoClosedAt *time.Time `gorm:"column:auto_closed_at" json:"auto_closed_at,omitempty"`
+
+    // Dispute (ODR)
+    OdrProviderID   string     `gorm:"column:odr_provider_id;index" json:"odr_provider_id,omitempty"`
+    OdrProviderURI  string     `gorm:"column:odr_provider_uri" json:"odr_provider_uri,omitempty"`
+    DisputeRaisedAt *time.Time `gorm:"column:dispute_raised_at" json:"dispute_raised_at,omitempty"`
+
+    // Support agent working the issue, see SupportService
+    AssignedTo string     `gorm:"column:assigned_to" json:"assigned_to,omitempty"`
+    AssignedAt *time.Time `gorm:"column:assigned_at" json:"assigned_at,omitempty"`
+
+    // Cascade level currently responsible, see IssueRespondent
+    CascadedLevel int `gorm:"column:cascaded_level;not null;default:1" json:"cascaded_level"`
     
     // Rating
     Rating string `json:"rating"`
+
+    // Latest resolution refund, also inside Resolution
+    RefundAmount string `gorm:"column:refund_amount" json:"refund_amount"`
     
     // JSONB fields
     OrderDetails       datatypes.JSON `gorm:"type:jsonb;column:order_details" json:"order_details"`
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+const (
+	InfoActionNeedMoreInfo = "NEED-MORE-INFO"
+	InfoActionInfoProvided = "INFO_PROVIDED"
+
+	InfoActorRespondent  = "RESPONDENT"
+	InfoActorComplainant = "COMPLAINANT"
+)
+
+// IssueInfoMessage is one round of the information exchange on an issue: a
+// respondent's NEED-MORE-INFO or the complainant's INFO_PROVIDED reply.
+type IssueInfoMessage struct {
+	ID                         uint           `gorm:"primaryKey;autoIncrement" json:"id"`
+	IssueID                    string         `gorm:"index;not null" json:"issue_id"`
+	Action                     string         `gorm:"not null" json:"action"`
+	Actor                      string         `gorm:"not null" json:"actor"`
+	ShortDesc                  string         `gorm:"column:short_desc" json:"short_desc"`
+	LongDesc                   string         `gorm:"column:long_desc" json:"long_desc"`
+	Images                     datatypes.JSON `gorm:"type:jsonb" json:"images"`
+	AdditionalDescURL          string         `gorm:"column:additional_desc_url" json:"additional_desc_url"`
+	AdditionalDescContentType  string         `gorm:"column:additional_desc_content_type" json:"additional_desc_content_type"`
+	AttachmentIDs              datatypes.JSON `gorm:"type:jsonb;column:attachment_ids" json:"attachment_ids"`
+	AdditionalDescAttachmentID *uuid.UUID     `gorm:"type:uuid;column:additional_desc_attachment_id" json:"additional_desc_attachment_id,omitempty"`
+	UpdatedBy                  string         `gorm:"column:updated_by" json:"updated_by"`
+	ActionAt                   time.Time      `gorm:"column:action_at;not null" json:"action_at"`
+	CreatedAt                  time.Time      `json:"created_at"`
+}
+
+func (IssueInfoMessage) TableName() string {
+	return "issue_info_messages"
+}
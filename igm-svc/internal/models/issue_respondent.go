@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// IssueRespondent is one level of an issue's respondent chain. Level 1 is the
+// BPP; a CASCADED respondent action hands the issue to the next level.
+type IssueRespondent struct {
+	ID            uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	IssueID       string     `gorm:"not null" json:"issue_id"`
+	CascadedLevel int        `gorm:"column:cascaded_level;not null" json:"cascaded_level"`
+	OrgName       string     `gorm:"column:org_name" json:"org_name"`
+	PersonName    string     `gorm:"column:person_name" json:"person_name"`
+	Phone         string     `gorm:"column:phone" json:"phone"`
+	Email         string     `gorm:"column:email" json:"email"`
+	GroName       string     `gorm:"column:gro_name" json:"gro_name"`
+	GroPhone      string     `gorm:"column:gro_phone" json:"gro_phone"`
+	GroEmail      string     `gorm:"column:gro_email" json:"gro_email"`
+	GroType       string     `gorm:"column:gro_type" json:"gro_type"`
+	LastAction    string     `gorm:"column:last_action" json:"last_action"`
+	LastActionAt  *time.Time `gorm:"column:last_action_at" json:"last_action_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func (IssueRespondent) TableName() string {
+	return "issue_respondents"
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	NotificationCreated              = "CREATED"
+	NotificationRespondentProcessing = "RESPONDENT_PROCESSING"
+	NotificationNeedMoreInfo         = "NEED_MORE_INFO"
+	NotificationResolved             = "RESOLVED"
+	NotificationAutoClosed           = "AUTO_CLOSED"
+	NotificationSLABreached          = "SLA_BREACHED"
+
+	NotificationStatusPending  = "PENDING"
+	NotificationStatusSending  = "SENDING"
+	NotificationStatusSent     = "SENT"
+	NotificationStatusDeferred = "DEFERRED"
+	NotificationStatusSkipped  = "SKIPPED"
+	NotificationStatusFailed   = "FAILED"
+)
+
+// NotificationPreference is a user's channel choice and quiet hours. Users
+// without a row get every channel and no quiet hours.
+type NotificationPreference struct {
+	UserID          uuid.UUID `gorm:"primaryKey;type:uuid" json:"user_id"`
+	SMSEnabled      bool      `gorm:"column:sms_enabled;not null;default:true" json:"sms_enabled"`
+	EmailEnabled    bool      `gorm:"column:email_enabled;not null;default:true" json:"email_enabled"`
+	PushEnabled     bool      `gorm:"column:push_enabled;not null;default:true" json:"push_enabled"`
+	PushToken       string    `gorm:"column:push_token" json:"push_token"`
+	QuietHoursStart string    `gorm:"column:quiet_hours_start" json:"quiet_hours_start"`
+	QuietHoursEnd   string    `gorm:"column:quiet_hours_end" json:"quiet_hours_end"`
+	Timezone        string    `gorm:"column:timezone" json:"timezone"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (NotificationPreference) TableName() string {
+	return "notification_preferences"
+}
+
+// Notification is the audit record of one message on one channel for one
+// domain event.
+type Notification struct {
+	ID        uint       `gorm:"primaryKey;autoIncrement" json:"id"`
+	EventID   string     `gorm:"column:event_id;not null" json:"event_id"`
+	IssueID   string     `gorm:"column:issue_id;index;not null" json:"issue_id"`
+	UserID    uuid.UUID  `gorm:"column:user_id;type:uuid;index;not null" json:"user_id"`
+	Kind      string     `gorm:"not null" json:"kind"`
+	Channel   string     `gorm:"not null" json:"channel"`
+	Recipient string     `json:"recipient"`
+	Subject   string     `json:"subject"`
+	Body      string     `json:"body"`
+	Status    string     `gorm:"not null" json:"status"`
+	Error     string     `json:"error"`
+	SendAfter *time.Time `gorm:"column:send_after" json:"send_after,omitempty"`
+	SentAt    *time.Time `gorm:"column:sent_at" json:"sent_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
@@ -0,0 +1,13 @@
+package models
+
+// OdrProvider is an Online Dispute Resolution provider a DISPUTE can be
+// forwarded to. Empty Domains/Categories mean the provider accepts any.
+type OdrProvider struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	ShortDesc    string   `json:"short_desc"`
+	URI          string   `json:"uri"`
+	PricingModel string   `json:"pricing_model"`
+	Domains      []string `json:"domains"`
+	Categories   []string `json:"categories"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// SLAPolicy holds the expected IGM timelines for a domain/category/sub-category.
+// Empty key fields act as wildcards.
+type SLAPolicy struct {
+	ID                     uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Domain                 string    `gorm:"column:domain" json:"domain"`
+	Category               string    `gorm:"column:category" json:"category"`
+	SubCategory            string    `gorm:"column:sub_category" json:"sub_category"`
+	ExpectedResponseTime   string    `gorm:"column:expected_response_time;not null" json:"expected_response_time"`
+	ExpectedResolutionTime string    `gorm:"column:expected_resolution_time;not null" json:"expected_resolution_time"`
+	CreatedAt              time.Time `json:"created_at"`
+	UpdatedAt              time.Time `json:"updated_at"`
+}
+
+func (SLAPolicy) TableName() string {
+	return "sla_policies"
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+const (
+	SupportActionSearch      = "SEARCH"
+	SupportActionViewDetails = "VIEW_DETAILS"
+	SupportActionAddNote     = "ADD_NOTE"
+	SupportActionReassign    = "REASSIGN"
+	SupportActionForceStatus = "FORCE_STATUS"
+	SupportActionViewAudit   = "VIEW_AUDIT_LOG"
+)
+
+// IssueNote is a support-only note. It is never shown to the complainant or
+// sent to the BPP.
+type IssueNote struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	IssueID   string    `gorm:"column:issue_id;not null;index" json:"issue_id"`
+	Author    string    `gorm:"not null" json:"author"`
+	Body      string    `gorm:"not null" json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (IssueNote) TableName() string {
+	return "issue_notes"
+}
+
+// SupportAuditEntry records one SupportService call by one agent.
+type SupportAuditEntry struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement" json:"id"`
+	IssueID   string         `gorm:"column:issue_id;index" json:"issue_id"`
+	Actor     string         `gorm:"not null" json:"actor"`
+	ActorRole string         `gorm:"column:actor_role;not null" json:"actor_role"`
+	Action    string         `gorm:"not null" json:"action"`
+	Reason    string         `json:"reason"`
+	Details   datatypes.JSON `gorm:"type:jsonb" json:"details"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+func (SupportAuditEntry) TableName() string {
+	return "support_audit_log"
+}
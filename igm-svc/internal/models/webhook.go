@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+const (
+	WebhookDeliveryPending   = "PENDING"
+	WebhookDeliverySucceeded = "SUCCEEDED"
+	WebhookDeliveryDead      = "DEAD"
+)
+
+// WebhookSubscription is an internal consumer receiving domain events over
+// HTTP. It is disabled after too many consecutive failed attempts.
+type WebhookSubscription struct {
+	ID                  uuid.UUID      `gorm:"primaryKey;type:uuid" json:"id"`
+	Name                string         `gorm:"not null" json:"name"`
+	URL                 string         `gorm:"column:url;not null" json:"url"`
+	Secret              string         `gorm:"not null" json:"-"`
+	EventTypes          datatypes.JSON `gorm:"type:jsonb;column:event_types" json:"event_types"`
+	Active              bool           `gorm:"not null;default:true" json:"active"`
+	ConsecutiveFailures int            `gorm:"column:consecutive_failures;not null;default:0" json:"consecutive_failures"`
+	DisabledAt          *time.Time     `gorm:"column:disabled_at" json:"disabled_at,omitempty"`
+	DisabledReason      string         `gorm:"column:disabled_reason" json:"disabled_reason"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookDelivery is one domain event queued for one subscription, together
+// with the outcome of its latest attempt.
+type WebhookDelivery struct {
+	ID             uint           `gorm:"primaryKey;autoIncrement" json:"id"`
+	SubscriptionID uuid.UUID      `gorm:"column:subscription_id;type:uuid;not null" json:"subscription_id"`
+	EventID        string         `gorm:"column:event_id;not null" json:"event_id"`
+	EventType      string         `gorm:"column:event_type;not null" json:"event_type"`
+	Payload        datatypes.JSON `gorm:"type:jsonb;not null" json:"payload"`
+	Status         string         `gorm:"not null" json:"status"`
+	Attempts       int            `gorm:"not null;default:0" json:"attempts"`
+	LastStatusCode int            `gorm:"column:last_status_code" json:"last_status_code"`
+	LastError      string         `gorm:"column:last_error" json:"last_error"`
+	NextAttemptAt  time.Time      `gorm:"column:next_attempt_at;not null" json:"next_attempt_at"`
+	DeliveredAt    *time.Time     `gorm:"column:delivered_at" json:"delivered_at,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var ErrAttachmentNotFound = fmt.Errorf("attachment %w", ErrNotFound)
+
+type AttachmentRepository interface {
+	Create(ctx context.Context, a *models.Attachment) error
+	Get(ctx context.Context, id uuid.UUID) (*models.Attachment, error)
+	// ListByIDs returns the attachments found, in no particular order.
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Attachment, error)
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+func (r *attachmentRepository) Create(ctx context.Context, a *models.Attachment) error {
+	if a.CreatedAt.IsZero() {
+		a.CreatedAt = time.Now()
+	}
+	if err := r.db.WithContext(ctx).Create(a).Error; err != nil {
+		return fmt.Errorf("failed to create attachment: %w", translateError(err, ErrAttachmentNotFound))
+	}
+	return nil
+}
+
+func (r *attachmentRepository) Get(ctx context.Context, id uuid.UUID) (*models.Attachment, error) {
+	var a models.Attachment
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&a).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	return &a, nil
+}
+
+func (r *attachmentRepository) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Attachment, error) {
+	var out []*models.Attachment
+	if len(ids) == 0 {
+		return out, nil
+	}
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&out).Error; err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Sentinel errors returned, possibly wrapped, by the repositories. Callers
+// test them with errors.Is.
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+
+	ErrIssueNotFound = fmt.Errorf("issue %w", ErrNotFound)
+)
+
+// translateError maps gorm errors onto the sentinels. NewPostgresDB enables
+// gorm's error translation so that unique violations arrive as
+// gorm.ErrDuplicatedKey.
+func translateError(err error, notFound error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return notFound
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return fmt.Errorf("%w: %v", ErrAlreadyExists, err)
+	}
+	return err
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestTranslateError(t *testing.T) {
+	assert.NoError(t, translateError(nil, ErrIssueNotFound))
+	assert.Equal(t, ErrIssueNotFound, translateError(gorm.ErrRecordNotFound, ErrIssueNotFound))
+	assert.True(t, errors.Is(translateError(gorm.ErrRecordNotFound, ErrIssueNotFound), ErrNotFound))
+	assert.True(t, errors.Is(translateError(gorm.ErrDuplicatedKey, ErrIssueNotFound), ErrAlreadyExists))
+
+	other := errors.New("connection refused")
+	assert.Equal(t, other, translateError(other, ErrIssueNotFound))
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IssueInfoRepository interface {
+	// SaveMessage stores a round of the exchange; a round already stored for
+	// the same issue, action and time is ignored.
+	SaveMessage(ctx context.Context, msg *models.IssueInfoMessage) error
+	ListByIssueID(ctx context.Context, issueID string) ([]*models.IssueInfoMessage, error)
+}
+
+type issueInfoRepository struct {
+	db *gorm.DB
+}
+
+func NewIssueInfoRepository(db *gorm.DB) IssueInfoRepository {
+	return &issueInfoRepository{db: db}
+}
+
+func (r *issueInfoRepository) SaveMessage(ctx context.Context, msg *models.IssueInfoMessage) error {
+	if msg == nil {
+		return fmt.Errorf("nil IssueInfoMessage")
+	}
+	if msg.IssueID == "" {
+		return fmt.Errorf("missing issue_id in IssueInfoMessage")
+	}
+	if msg.CreatedAt.IsZero() {
+		msg.CreatedAt = time.Now()
+	}
+
+	err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "issue_id"}, {Name: "action"}, {Name: "action_at"}},
+			DoNothing: true,
+		}).
+		Create(msg).Error
+	if err != nil {
+		return fmt.Errorf("failed to save issue info message: %w", err)
+	}
+	return nil
+}
+
+func (r *issueInfoRepository) ListByIssueID(ctx context.Context, issueID string) ([]*models.IssueInfoMessage, error) {
+	var msgs []*models.IssueInfoMessage
+	err := r.db.WithContext(ctx).
+		Where("issue_id = ?", issueID).
+		Order("action_at ASC, id ASC").
+		Find(&msgs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue info messages: %w", err)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	IssueSortCreatedAt = "created_at"
+	IssueSortUpdatedAt = "updated_at"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// IssueQuery lists issues. Zero filter fields match every issue; a nil
+// UserID matches every user and is only built for support callers. Pages
+// are keyset based: After is the last issue of the previous page and ties
+// on the sort column are broken by id, so pages never shift or repeat while
+// issues are created.
+type IssueQuery struct {
+	UserID        uuid.UUID
+	Status        string
+	Category      string
+	IssueType     string
+	OrderID       string
+	CreatedFrom   *time.Time
+	CreatedTo     *time.Time
+	HasResolution *bool
+
+	SortBy string // IssueSortCreatedAt or IssueSortUpdatedAt
+	Asc    bool
+	After  *IssueCursor
+	// Offset is only used without After, for clients still sending page
+	// numbers.
+	Offset int
+	Limit  int
+}
+
+// IssueCursor is the position after which the next page starts. It carries
+// the sort it was issued for so it cannot be replayed against another.
+type IssueCursor struct {
+	SortBy string    `json:"s"`
+	Asc    bool      `json:"a,omitempty"`
+	At     time.Time `json:"t"`
+	ID     uint      `json:"i"`
+}
+
+// CursorAfter returns the cursor positioned after issue.
+func (q IssueQuery) CursorAfter(issue *models.Issue) *IssueCursor {
+	at := issue.CreatedAt
+	if q.sortColumn() == IssueSortUpdatedAt {
+		at = issue.UpdatedAt
+	}
+	return &IssueCursor{SortBy: q.sortColumn(), Asc: q.Asc, At: at, ID: issue.ID}
+}
+
+func (c *IssueCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodeIssueCursor parses a cursor from Encode and checks it was issued for
+// the same sort as q.
+func (q IssueQuery) DecodeIssueCursor(value string) (*IssueCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c IssueCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == 0 {
+		return nil, ErrInvalidCursor
+	}
+	if c.SortBy != q.sortColumn() || c.Asc != q.Asc {
+		return nil, fmt.Errorf("%w: issued for a different sort", ErrInvalidCursor)
+	}
+	return &c, nil
+}
+
+func (q IssueQuery) sortColumn() string {
+	if q.SortBy == IssueSortUpdatedAt {
+		return IssueSortUpdatedAt
+	}
+	return IssueSortCreatedAt
+}
+
+// filter applies the filters but neither the cursor nor the sort, so it is
+// also used for total counts.
+func (q IssueQuery) filter(db *gorm.DB) *gorm.DB {
+	if q.UserID != uuid.Nil {
+		db = db.Where("user_id = ?", q.UserID)
+	}
+	if q.Status != "" {
+		db = db.Where("status = ?", q.Status)
+	}
+	if q.Category != "" {
+		db = db.Where("category = ?", q.Category)
+	}
+	if q.IssueType != "" {
+		db = db.Where("issue_type = ?", q.IssueType)
+	}
+	if q.OrderID != "" {
+		db = db.Where("order_id = ?", q.OrderID)
+	}
+	if q.CreatedFrom != nil {
+		db = db.Where("created_at >= ?", *q.CreatedFrom)
+	}
+	if q.CreatedTo != nil {
+		db = db.Where("created_at < ?", *q.CreatedTo)
+	}
+	if q.HasResolution != nil {
+		// resolution is written as JSON null until the BPP resolves
+		hasResolution := "resolution IS NOT NULL AND jsonb_typeof(resolution) = 'object'"
+		if *q.HasResolution {
+			db = db.Where(hasResolution)
+		} else {
+			db = db.Where("NOT (" + hasResolution + ")")
+		}
+	}
+	return db
+}
+
+// page applies the keyset position, sort and limit.
+func (q IssueQuery) page(db *gorm.DB) *gorm.DB {
+	col := q.sortColumn()
+	dir, cmp := "DESC", "<"
+	if q.Asc {
+		dir, cmp = "ASC", ">"
+	}
+	if q.After != nil {
+		db = db.Where(fmt.Sprintf("(%s, id) %s (?, ?)", col, cmp), q.After.At, q.After.ID)
+	} else if q.Offset > 0 {
+		db = db.Offset(q.Offset)
+	}
+	return db.Order(fmt.Sprintf("%s %s, id %s", col, dir, dir)).Limit(q.Limit)
+}
+
+// IssueSearchHit is an issue matching a full-text query.
+type IssueSearchHit struct {
+	models.Issue `gorm:"embedded"`
+	Rank         float32 `gorm:"column:rank"`
+	Snippet      string  `gorm:"column:snippet"`
+}
+
+// search matches text against issues.search_vector. websearch_to_tsquery
+// accepts any user input without syntax errors.
+func (q IssueQuery) search(db *gorm.DB, text string) *gorm.DB {
+	return q.filter(db).Where("search_vector @@ websearch_to_tsquery('english', ?)", text)
+}
+
+const searchSnippetOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2"
+
+// searchPage ranks the hits best first, with a highlighted snippet each.
+func (q IssueQuery) searchPage(db *gorm.DB, text string) *gorm.DB {
+	return q.search(db, text).
+		Select("issues.*, "+
+			"ts_rank_cd(search_vector, websearch_to_tsquery('english', ?)) AS rank, "+
+			"ts_headline('english', concat_ws(' ', description_short, description_long, "+
+			"jsonb_path_query_array(respondent_actions, '$.respondentActions[*].shortDesc')::text), "+
+			"websearch_to_tsquery('english', ?), '"+searchSnippetOptions+"') AS snippet", text, text).
+		Order("rank DESC, id DESC").
+		Offset(q.Offset).
+		Limit(q.Limit)
+}
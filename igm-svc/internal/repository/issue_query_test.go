@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"errors"
+	"igm-svc/internal/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// dryRunDB builds SQL without a database connection.
+func dryRunDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:               true,
+		DisableAutomaticPing: true,
+	})
+	require.NoError(t, err)
+	return db
+}
+
+func listSQL(t *testing.T, q IssueQuery) string {
+	db := dryRunDB(t)
+	var issues []*models.Issue
+	stmt := q.page(q.filter(db.Model(&models.Issue{}))).Find(&issues).Statement
+	return stmt.SQL.String()
+}
+
+func TestIssueQuery_KeysetPage(t *testing.T) {
+	q := IssueQuery{UserID: uuid.New(), Limit: 11}
+	sql := listSQL(t, q)
+	assert.Contains(t, sql, "ORDER BY created_at DESC, id DESC LIMIT $2")
+	assert.NotContains(t, sql, "(created_at, id)")
+
+	q.After = &IssueCursor{SortBy: IssueSortCreatedAt, At: time.Now(), ID: 42}
+	q.Offset = 20
+	sql = listSQL(t, q)
+	assert.Contains(t, sql, "(created_at, id) < ($2, $3)")
+	assert.NotContains(t, sql, "OFFSET", "the cursor replaces the offset")
+
+	q = IssueQuery{UserID: uuid.New(), SortBy: IssueSortUpdatedAt, Asc: true, Limit: 5}
+	q.After = &IssueCursor{SortBy: IssueSortUpdatedAt, Asc: true, At: time.Now(), ID: 7}
+	sql = listSQL(t, q)
+	assert.Contains(t, sql, "(updated_at, id) > ($2, $3)")
+	assert.Contains(t, sql, "ORDER BY updated_at ASC, id ASC")
+}
+
+func TestIssueQuery_Filters(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	hasResolution := false
+	sql := listSQL(t, IssueQuery{
+		UserID:        uuid.New(),
+		Status:        "OPEN",
+		Category:      "ITEM",
+		IssueType:     "ISSUE",
+		OrderID:       "order-1",
+		CreatedFrom:   &from,
+		HasResolution: &hasResolution,
+		Limit:         10,
+	})
+	for _, want := range []string{
+		"user_id = $1", "status = $2", "category = $3", "issue_type = $4", "order_id = $5", "created_at >= $6",
+		"NOT (resolution IS NOT NULL AND jsonb_typeof(resolution) = 'object')",
+	} {
+		assert.Contains(t, sql, want)
+	}
+	assert.NotContains(t, sql, "created_at <")
+}
+
+func TestIssueCursor_RoundTrip(t *testing.T) {
+	q := IssueQuery{SortBy: IssueSortUpdatedAt}
+	issue := &models.Issue{ID: 9, CreatedAt: time.Now().Add(-time.Hour), UpdatedAt: time.Date(2026, 3, 4, 5, 6, 7, 891234000, time.UTC)}
+
+	encoded := q.CursorAfter(issue).Encode()
+	c, err := q.DecodeIssueCursor(encoded)
+	require.NoError(t, err)
+	assert.EqualValues(t, 9, c.ID)
+	assert.True(t, issue.UpdatedAt.Equal(c.At))
+
+	_, err = IssueQuery{}.DecodeIssueCursor(encoded)
+	assert.True(t, errors.Is(err, ErrInvalidCursor), "a cursor is tied to its sort")
+	_, err = q.DecodeIssueCursor("not-a-cursor")
+	assert.True(t, errors.Is(err, ErrInvalidCursor))
+}
+
+func TestIssueQuery_Search(t *testing.T) {
+	db := dryRunDB(t)
+	var hits []*IssueSearchHit
+	q := IssueQuery{Category: "FULFILLMENT", Offset: 20, Limit: 10}
+	stmt := q.searchPage(db.Model(&models.Issue{}), "damaged packaging at Andheri hub").Scan(&hits).Statement
+	sql := stmt.SQL.String()
+
+	assert.Contains(t, sql, "ts_rank_cd(search_vector, websearch_to_tsquery('english', $1)) AS rank")
+	assert.Contains(t, sql, "StartSel=<mark>")
+	assert.Contains(t, sql, "search_vector @@ websearch_to_tsquery('english', $4)")
+	assert.Contains(t, sql, "ORDER BY rank DESC, id DESC LIMIT $5 OFFSET $6")
+	assert.NotContains(t, sql, "user_id", "support searches span every user")
+	assert.Equal(t, "damaged packaging at Andheri hub", stmt.Vars[0])
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"igm-svc/internal/models"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -14,11 +15,37 @@ type IssueRepository interface {
 	GetByID(ctx context.Context, id uint) (*models.Issue, error)
 	GetByIssueID(ctx context.Context, issueID string) (*models.Issue, error)
 	GetByOrderID(ctx context.Context, orderID string) ([]*models.Issue, error)
+	GetByOrderIDAndUserID(ctx context.Context, orderID string, userID uuid.UUID) ([]*models.Issue, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]*models.Issue,int,error)
+	// ListIssues returns one page of q; CountIssues counts every issue
+	// matching q's filters.
+	ListIssues(ctx context.Context, q IssueQuery) ([]*models.Issue, error)
+	CountIssues(ctx context.Context, q IssueQuery) (int, error)
+	// SearchIssues ranks the issues matching q's filters against a
+	// full-text query; q.Offset and q.Limit page the hits.
+	SearchIssues(ctx context.Context, text string, q IssueQuery) ([]*IssueSearchHit, int, error)
 	GetByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]*models.Issue, error)
 	Update(ctx context.Context, issue *models.Issue) error
 	GetIssueExistByIssueID(issueID string, userID uuid.UUID)(*models.Issue,error)
 	HasActiveIssueWithSameCategory(userID uuid.UUID, category string, orderID string) (bool, error)
+	FindResponseBreaches(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error)
+	FindResolutionBreaches(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error)
+	// MarkSLABreach stores a breach and the escalation it triggered.
+	MarkSLABreach(ctx context.Context, issue *models.Issue) error
+	FindDueForStatusPoll(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error)
+	UpdateStatusPollSchedule(ctx context.Context, issueID string, polledAt *time.Time, nextPollAt time.Time) error
+	FindResolvedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*models.Issue, error)
+	// AutoClose closes an issue unless it was closed since it was loaded,
+	// and reports whether it did.
+	AutoClose(ctx context.Context, issue *models.Issue) (bool, error)
+	// SaveProvidedInfo stores the description, images and complainant
+	// actions of an INFO_PROVIDED round.
+	SaveProvidedInfo(ctx context.Context, issue *models.Issue) error
+	// SaveOdrSelection records the ODR a complainant picked before the
+	// dispute is sent to it; MarkDisputed stores the escalation once the ODR
+	// accepted it.
+	SaveOdrSelection(ctx context.Context, issueID, odrID, odrURI string) error
+	MarkDisputed(ctx context.Context, issue *models.Issue) error
 }
 
 type issueRepository struct {
@@ -34,14 +61,14 @@ func (r *issueRepository) Create(ctx context.Context, issue *models.Issue) error
 	if issue == nil {
 		return fmt.Errorf("issue cannot be nil")
 	}
-	return r.db.WithContext(ctx).Create(issue).Error
+	return translateError(conn(ctx, r.db).Create(issue).Error, ErrIssueNotFound)
 }
 
 func (r *issueRepository) GetByID(ctx context.Context, id uint) (*models.Issue, error) {
 	var issue models.Issue
 	err := r.db.WithContext(ctx).First(&issue, id).Error
 	if err != nil {
-		return nil, err
+		return nil, translateError(err, ErrIssueNotFound)
 	}
 	return &issue, nil
 }
@@ -50,7 +77,7 @@ func (r *issueRepository) GetByIssueID(ctx context.Context, issueID string) (*mo
 	var issue models.Issue
 	err := r.db.WithContext(ctx).Where("issue_id= ?", issueID).First(&issue).Error
 	if err != nil {
-		return nil, err
+		return nil, translateError(err, ErrIssueNotFound)
 	}
 	return &issue, nil
 }
@@ -65,20 +92,59 @@ func (r *issueRepository) GetByOrderID(ctx context.Context, orderID string) ([]*
 
 }
 
+func (r *issueRepository) GetByOrderIDAndUserID(ctx context.Context, orderID string, userID uuid.UUID) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	err := r.db.WithContext(ctx).
+		Where("order_id= ? AND user_id= ?", orderID, userID).
+		Order("created_at DESC").
+		Find(&issues).Error
+	return issues, err
+}
+
 func (r *issueRepository) GetByUserID(ctx context.Context, userID uuid.UUID, limit int, offset int) ([]*models.Issue,int, error) {
 	var issues []*models.Issue
 	var total int64
-	err:=r.db.Model(&models.Issue{}).Where("user_id=?",userID).Count(&total).Error
+	err:=r.db.WithContext(ctx).Model(&models.Issue{}).Where("user_id=?",userID).Count(&total).Error
 	if err!=nil{
 		return nil,0,err
 	}
 	err = r.db.WithContext(ctx).
 		Where("user_id= ?", userID).
+		Order("created_at DESC, id DESC").
 		Limit(limit).Offset(offset).
 		Find(&issues).Error
 	return issues,int(total), err
 }
 
+func (r *issueRepository) ListIssues(ctx context.Context, q IssueQuery) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	db := q.page(q.filter(r.db.WithContext(ctx).Model(&models.Issue{})))
+	if err := db.Find(&issues).Error; err != nil {
+		return nil, fmt.Errorf("failed to list issues: %w", err)
+	}
+	return issues, nil
+}
+
+func (r *issueRepository) SearchIssues(ctx context.Context, text string, q IssueQuery) ([]*IssueSearchHit, int, error) {
+	var total int64
+	if err := q.search(r.db.WithContext(ctx).Model(&models.Issue{}), text).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count search hits: %w", err)
+	}
+	var hits []*IssueSearchHit
+	if err := q.searchPage(r.db.WithContext(ctx).Model(&models.Issue{}), text).Scan(&hits).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to search issues: %w", err)
+	}
+	return hits, int(total), nil
+}
+
+func (r *issueRepository) CountIssues(ctx context.Context, q IssueQuery) (int, error) {
+	var total int64
+	if err := q.filter(r.db.WithContext(ctx).Model(&models.Issue{})).Count(&total).Error; err != nil {
+		return 0, fmt.Errorf("failed to count issues: %w", err)
+	}
+	return int(total), nil
+}
+
 func (r *issueRepository) GetByTransactionID(ctx context.Context, transactionID uuid.UUID) ([]*models.Issue, error) {
 	var issues []*models.Issue
 	err := r.db.WithContext(ctx).
@@ -89,26 +155,178 @@ func (r *issueRepository) GetByTransactionID(ctx context.Context, transactionID
 }
 
 func (r *issueRepository) Update(ctx context.Context, issue *models.Issue) error {
-	return r.db.WithContext(ctx).Save(issue).Error
+	return conn(ctx, r.db).Save(issue).Error
 }
 func (r *issueRepository)GetIssueExistByIssueID(issueID string, userID uuid.UUID)(*models.Issue,error){
 		var issue models.Issue
 		err:=r.db.Where("issue_id=? AND user_id=?",issueID,userID).First(&issue).Error
 		 if err != nil {
-        return nil, fmt.Errorf("no issue found with this issue_id")
+        return nil, translateError(err, ErrIssueNotFound)
     	}
     	return &issue, nil
 	}
 
-func (r *issueRepository)HasActiveIssueWithSameCategory(userID uuid.UUID, category string, orderID string) (bool, error){
-	var issue models.Issue
-	err:=r.db.Where("user_id=? AND category=? AND order_id=? AND status='OPEN'",userID,category,orderID).First(&issue).Error
-	if err!=nil{
-		return false,err
+// HasActiveIssueWithSameCategory counts rather than loads, so that no
+// matching issue is a plain false and not gorm.ErrRecordNotFound.
+func (r *issueRepository) HasActiveIssueWithSameCategory(userID uuid.UUID, category string, orderID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Issue{}).
+		Where("user_id = ? AND category = ? AND order_id = ? AND status = 'OPEN'", userID, category, orderID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
 	}
-	return true,nil
+	return count > 0, nil
 }
 
+// FindResponseBreaches returns open issues whose respond_by has passed while
+// the BPP has not taken any respondent action yet.
+func (r *issueRepository) FindResponseBreaches(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	err := r.db.WithContext(ctx).
+		Where("status <> ? AND respond_by IS NOT NULL AND respond_by < ?", "CLOSED", now).
+		Where("response_breached_at IS NULL").
+		Where("COALESCE(respondent_status, '') = ''").
+		Order("respond_by ASC").
+		Limit(limit).
+		Find(&issues).Error
+	return issues, err
+}
 
+// FindResolutionBreaches returns open issues whose resolve_by has passed
+// without the BPP marking them RESOLVED.
+func (r *issueRepository) FindResolutionBreaches(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	err := r.db.WithContext(ctx).
+		Where("status <> ? AND resolve_by IS NOT NULL AND resolve_by < ?", "CLOSED", now).
+		Where("resolution_breached_at IS NULL").
+		Where("COALESCE(respondent_status, '') <> ?", "RESOLVED").
+		Order("resolve_by ASC").
+		Limit(limit).
+		Find(&issues).Error
+	return issues, err
+}
+
+// FindDueForStatusPoll returns non-closed issues whose next issue_status poll
+// is due, oldest schedule first.
+func (r *issueRepository) FindDueForStatusPoll(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	err := r.db.WithContext(ctx).
+		Where("status <> ?", "CLOSED").
+		Where("next_status_poll_at IS NULL OR next_status_poll_at <= ?", now).
+		Order("next_status_poll_at ASC NULLS FIRST").
+		Limit(limit).
+		Find(&issues).Error
+	return issues, err
+}
+
+// UpdateStatusPollSchedule only touches the polling columns so it never
+// overwrites fields written concurrently by callbacks. polledAt is left
+// unchanged when nil.
+func (r *issueRepository) UpdateStatusPollSchedule(ctx context.Context, issueID string, polledAt *time.Time, nextPollAt time.Time) error {
+	updates := map[string]interface{}{
+		"next_status_poll_at": nextPollAt,
+	}
+	if polledAt != nil {
+		updates["last_status_poll_at"] = *polledAt
+	}
+	return r.db.WithContext(ctx).
+		Model(&models.Issue{}).
+		Where("issue_id = ?", issueID).
+		UpdateColumns(updates).Error
+}
 
-	
\ No newline at end of file
+// FindResolvedBefore returns open issues whose latest respondent action is
+// RESOLVED and was taken before cutoff, leaving out issues the complainant
+// acted on (escalated, rejected the resolution) after it. Internal audit
+// entries do not count as a response.
+func (r *issueRepository) FindResolvedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*models.Issue, error) {
+	var issues []*models.Issue
+	err := r.db.WithContext(ctx).
+		Where("status <> ? AND respondent_status = ?", "CLOSED", "RESOLVED").
+		Where("resolved_at IS NOT NULL AND resolved_at < ?", cutoff).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM jsonb_array_elements(CASE WHEN jsonb_typeof(complainant_actions) = 'array' THEN complainant_actions ELSE '[]'::jsonb END) AS a
+			WHERE COALESCE((a->>'internal')::boolean, false) = false
+			AND (a->>'updated_at')::timestamptz > resolved_at)`).
+		Order("resolved_at ASC").
+		Limit(limit).
+		Find(&issues).Error
+	return issues, err
+}
+
+func (r *issueRepository) MarkSLABreach(ctx context.Context, issue *models.Issue) error {
+	return r.updateColumns(ctx, issue.IssueID, map[string]interface{}{
+		"response_breached_at":   issue.ResponseBreachedAt,
+		"resolution_breached_at": issue.ResolutionBreachedAt,
+		"issue_type":             issue.IssueType,
+		"complainant_actions":    issue.ComplainantActions,
+		"updated_at":             issue.UpdatedAt,
+	})
+}
+
+func (r *issueRepository) AutoClose(ctx context.Context, issue *models.Issue) (bool, error) {
+	res := conn(ctx, r.db).
+		Model(&models.Issue{}).
+		Where("issue_id = ? AND status <> ?", issue.IssueID, "CLOSED").
+		UpdateColumns(map[string]interface{}{
+			"status":              issue.Status,
+			"rating":              issue.Rating,
+			"auto_closed_at":      issue.AutoClosedAt,
+			"complainant_actions": issue.ComplainantActions,
+			"updated_at":          issue.UpdatedAt,
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *issueRepository) SaveProvidedInfo(ctx context.Context, issue *models.Issue) error {
+	return r.updateColumns(ctx, issue.IssueID, map[string]interface{}{
+		"description_long":          issue.DescriptionLong,
+		"description_url":           issue.DescriptionURL,
+		"description_attachment_id": issue.DescriptionAttachmentID,
+		"description_content_type":  issue.DescriptionContentType,
+		"images":                    issue.Images,
+		"attachment_ids":            issue.AttachmentIDs,
+		"complainant_actions":       issue.ComplainantActions,
+		"updated_at":                issue.UpdatedAt,
+	})
+}
+
+func (r *issueRepository) SaveOdrSelection(ctx context.Context, issueID, odrID, odrURI string) error {
+	return r.updateColumns(ctx, issueID, map[string]interface{}{
+		"odr_provider_id":  odrID,
+		"odr_provider_uri": odrURI,
+		"updated_at":       time.Now(),
+	})
+}
+
+func (r *issueRepository) MarkDisputed(ctx context.Context, issue *models.Issue) error {
+	return r.updateColumns(ctx, issue.IssueID, map[string]interface{}{
+		"issue_type":          issue.IssueType,
+		"odr_provider_id":     issue.OdrProviderID,
+		"odr_provider_uri":    issue.OdrProviderURI,
+		"dispute_raised_at":   issue.DisputeRaisedAt,
+		"complainant_actions": issue.ComplainantActions,
+		"updated_at":          issue.UpdatedAt,
+	})
+}
+
+// updateColumns writes only the given columns, like UpdateStatusPollSchedule,
+// so a flow that sends to the network between loading and saving an issue
+// does not overwrite what callbacks stored in the meantime.
+func (r *issueRepository) updateColumns(ctx context.Context, issueID string, columns map[string]interface{}) error {
+	res := conn(ctx, r.db).
+		Model(&models.Issue{}).
+		Where("issue_id = ?", issueID).
+		UpdateColumns(columns)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrIssueNotFound
+	}
+	return nil
+}
@@ -163,4 +163,84 @@ func TestIssueRepository_Create_DuplicateIssueID(t *testing.T) {
     err = repo.Create(ctx, issue2)
     assert.Error(t, err, "Should fail due to unique constraint on issue_id")
     assert.Contains(t, err.Error(), "duplicate key", "Error should mention duplicate key")
-}
\ No newline at end of file
+}
+func TestIssueRepository_FindSLABreaches(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewIssueRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	newIssue := func(id string, mutate func(*models.Issue)) {
+		issue := &models.Issue{
+			IssueID:       id,
+			OrderID:       "order-123",
+			UserID:        uuid.New(),
+			TransactionID: uuid.New().String(),
+			BPPID:         "bpp-test-id",
+			BPPURI:        "https://bpp-test.com",
+			Category:      "ITEM",
+			IssueType:     "ISSUE",
+			Status:        "OPEN",
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		mutate(issue)
+		require.NoError(t, repo.Create(ctx, issue))
+	}
+	newIssue("overdue", func(i *models.Issue) { i.RespondBy = &past; i.ResolveBy = &past })
+	newIssue("not-due", func(i *models.Issue) { i.RespondBy = &future; i.ResolveBy = &future })
+	newIssue("answered", func(i *models.Issue) { i.RespondBy = &past; i.ResolveBy = &past; i.RespondentStatus = "PROCESSING" })
+	newIssue("resolved", func(i *models.Issue) { i.RespondBy = &past; i.ResolveBy = &past; i.RespondentStatus = "RESOLVED" })
+	newIssue("closed", func(i *models.Issue) { i.RespondBy = &past; i.ResolveBy = &past; i.Status = "CLOSED" })
+	newIssue("marked", func(i *models.Issue) {
+		i.RespondBy = &past
+		i.ResolveBy = &past
+		i.ResponseBreachedAt = &past
+		i.ResolutionBreachedAt = &past
+	})
+
+	ids := func(issues []*models.Issue) []string {
+		var out []string
+		for _, issue := range issues {
+			out = append(out, issue.IssueID)
+		}
+		return out
+	}
+
+	issues, err := repo.FindResponseBreaches(ctx, now, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"overdue"}, ids(issues))
+
+	issues, err = repo.FindResolutionBreaches(ctx, now, 10)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"overdue", "answered"}, ids(issues))
+}
+
+func TestIssueRepository_HasActiveIssueWithSameCategory(t *testing.T) {
+	db := setupTestDB(t)
+	defer cleanupTestDB(t, db)
+
+	repo := NewIssueRepository(db)
+	userID := uuid.New()
+
+	exists, err := repo.HasActiveIssueWithSameCategory(userID, "ITEM", "order-123")
+	require.NoError(t, err, "no active issue is not an error")
+	assert.False(t, exists)
+
+	require.NoError(t, repo.Create(context.Background(), &models.Issue{
+		IssueID:   uuid.New().String(),
+		OrderID:   "order-123",
+		UserID:    userID,
+		Category:  "ITEM",
+		Status:    "OPEN",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}))
+	exists, err = repo.HasActiveIssueWithSameCategory(userID, "ITEM", "order-123")
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
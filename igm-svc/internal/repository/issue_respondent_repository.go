@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type IssueRespondentRepository interface {
+	ListByIssueID(ctx context.Context, issueID string) ([]*models.IssueRespondent, error)
+	// SaveChain upserts the given levels of an issue's respondent chain.
+	SaveChain(ctx context.Context, rows []*models.IssueRespondent) error
+}
+
+type issueRespondentRepository struct {
+	db *gorm.DB
+}
+
+func NewIssueRespondentRepository(db *gorm.DB) IssueRespondentRepository {
+	return &issueRespondentRepository{db: db}
+}
+
+func (r *issueRespondentRepository) ListByIssueID(ctx context.Context, issueID string) ([]*models.IssueRespondent, error) {
+	var rows []*models.IssueRespondent
+	err := r.db.WithContext(ctx).
+		Where("issue_id = ?", issueID).
+		Order("cascaded_level ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue respondents: %w", err)
+	}
+	return rows, nil
+}
+
+func (r *issueRespondentRepository) SaveChain(ctx context.Context, rows []*models.IssueRespondent) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	now := time.Now()
+	for _, row := range rows {
+		if row.IssueID == "" {
+			return fmt.Errorf("missing issue_id in IssueRespondent")
+		}
+		if row.CreatedAt.IsZero() {
+			row.CreatedAt = now
+		}
+		row.UpdatedAt = now
+	}
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "issue_id"}, {Name: "cascaded_level"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"org_name", "person_name", "phone", "email",
+				"gro_name", "gro_phone", "gro_email", "gro_type",
+				"last_action", "last_action_at", "updated_at",
+			}),
+		}).
+		Create(&rows).Error
+	if err != nil {
+		return fmt.Errorf("failed to save issue respondents: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository interface {
+	// GetPreferences returns nil when the user has not stored any.
+	GetPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreference, error)
+	SavePreferences(ctx context.Context, pref *models.NotificationPreference) error
+
+	// Create stores n and reports whether it was new; a notification for the
+	// same event and channel already stored is left untouched.
+	Create(ctx context.Context, n *models.Notification) (bool, error)
+	Update(ctx context.Context, n *models.Notification) error
+	// ClaimDue marks PENDING and DEFERRED notifications whose send_after has
+	// passed as SENDING until now+lease and returns them. Rows claimed by
+	// another worker are skipped; a claim whose lease ran out is taken again.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Notification, error)
+	ListByUser(ctx context.Context, userID uuid.UUID, issueID string, limit int) ([]*models.Notification, error)
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return &notificationRepository{db: db}
+}
+
+func (r *notificationRepository) GetPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreference, error) {
+	var pref models.NotificationPreference
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).First(&pref).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	return &pref, nil
+}
+
+func (r *notificationRepository) SavePreferences(ctx context.Context, pref *models.NotificationPreference) error {
+	now := time.Now()
+	if pref.CreatedAt.IsZero() {
+		pref.CreatedAt = now
+	}
+	pref.UpdatedAt = now
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"sms_enabled", "email_enabled", "push_enabled", "push_token",
+				"quiet_hours_start", "quiet_hours_end", "timezone", "updated_at",
+			}),
+		}).
+		Create(pref).Error
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) Create(ctx context.Context, n *models.Notification) (bool, error) {
+	if n == nil {
+		return false, fmt.Errorf("nil Notification")
+	}
+	now := time.Now()
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = now
+	}
+	n.UpdatedAt = now
+
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "event_id"}, {Name: "channel"}},
+			DoNothing: true,
+		}).
+		Create(n)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create notification: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *notificationRepository) Update(ctx context.Context, n *models.Notification) error {
+	n.UpdatedAt = time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&models.Notification{}).
+		Where("id = ?", n.ID).
+		Updates(map[string]interface{}{
+			"status":     n.Status,
+			"error":      n.Error,
+			"send_after": n.SendAfter,
+			"sent_at":    n.SentAt,
+			"updated_at": n.UpdatedAt,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Notification, error) {
+	var out []*models.Notification
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE notifications SET status = ?, send_after = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM notifications
+			WHERE status IN ? AND send_after <= ?
+			ORDER BY send_after ASC, id ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		models.NotificationStatusSending, now.Add(lease), now,
+		[]string{models.NotificationStatusPending, models.NotificationStatusDeferred, models.NotificationStatusSending}, now,
+		limit,
+	).Scan(&out).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due notifications: %w", err)
+	}
+	return out, nil
+}
+
+func (r *notificationRepository) ListByUser(ctx context.Context, userID uuid.UUID, issueID string, limit int) ([]*models.Notification, error) {
+	var out []*models.Notification
+	q := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if issueID != "" {
+		q = q.Where("issue_id = ?", issueID)
+	}
+	err := q.Order("created_at DESC, id DESC").Limit(limit).Find(&out).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	return out, nil
+}
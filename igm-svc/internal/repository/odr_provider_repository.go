@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/models"
+	"os"
+)
+
+type OdrProviderRepository interface {
+	List(ctx context.Context) ([]*models.OdrProvider, error)
+	GetByID(ctx context.Context, id string) (*models.OdrProvider, error)
+}
+
+// fileOdrProviderRepository reads the registry from a JSON file on every call
+// so local edits are picked up without a restart.
+type fileOdrProviderRepository struct {
+	path string
+}
+
+func NewFileOdrProviderRepository(path string) OdrProviderRepository {
+	return &fileOdrProviderRepository{path: path}
+}
+
+func (r *fileOdrProviderRepository) List(ctx context.Context) ([]*models.OdrProvider, error) {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read odr provider registry: %w", err)
+	}
+	var providers []*models.OdrProvider
+	if err := json.Unmarshal(data, &providers); err != nil {
+		return nil, fmt.Errorf("failed to parse odr provider registry: %w", err)
+	}
+	return providers, nil
+}
+
+func (r *fileOdrProviderRepository) GetByID(ctx context.Context, id string) (*models.OdrProvider, error) {
+	providers, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range providers {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("odr provider %s not found", id)
+}
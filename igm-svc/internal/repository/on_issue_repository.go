@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"igm-svc/internal/models"
 	"log"
@@ -15,6 +16,8 @@ type OnIssueRepository interface {
 	SaveOnIssueCallback(ctx context.Context, transactionID, messageID string, payload []byte) error
 	UpdateIssueFromOnIssue(ctx context.Context, issueID string, updates map[string]interface{}) error
 	SaveOnIssueStatusResponse(ctx context.Context, row *models.OnIssueStatusResponse) error
+	GetLatestOnIssueStatusResponse(ctx context.Context, issueID string) (*models.OnIssueStatusResponse, error)
+	ListOnIssueStatusResponses(ctx context.Context, issueID string) ([]*models.OnIssueStatusResponse, error)
 }
 
 type onIssueRepository struct {
@@ -51,21 +54,10 @@ func (r *onIssueRepository) UpdateIssueFromOnIssue(ctx context.Context, issueID
 		updates["updated_at"] = time.Now()
 	}
 
-	tx := r.db.WithContext(ctx).Begin()
-
-	if tx.Error != nil {
-		return fmt.Errorf("failed to begin transaction :%w", tx.Error)
-	}
-
-	err := tx.Model(&models.Issue{}).Where("issue_id = ?", issueID).Updates(updates).Error
+	err := conn(ctx, r.db).Model(&models.Issue{}).Where("issue_id = ?", issueID).Updates(updates).Error
 	if err != nil {
-		_ = tx.Rollback()
 		return fmt.Errorf("failed to update issue:%w", err)
 	}
-	err = tx.Commit().Error
-	if err != nil {
-		return fmt.Errorf("failed to commit issue update:%w", err)
-	}
 	return nil
 }
 
@@ -89,3 +81,34 @@ func (r *onIssueRepository) SaveOnIssueStatusResponse(ctx context.Context, row *
 
 	return nil
 }
+
+// GetLatestOnIssueStatusResponse returns the most recent callback stored for
+// the issue, or nil when none has been received yet.
+func (r *onIssueRepository) GetLatestOnIssueStatusResponse(ctx context.Context, issueID string) (*models.OnIssueStatusResponse, error) {
+	var row models.OnIssueStatusResponse
+	err := r.db.WithContext(ctx).
+		Where("issue_id = ?", issueID).
+		Order("created_at DESC").
+		First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load latest on_issue_status_response: %w", err)
+	}
+	return &row, nil
+}
+
+// ListOnIssueStatusResponses returns every callback stored for the issue,
+// oldest first.
+func (r *onIssueRepository) ListOnIssueStatusResponses(ctx context.Context, issueID string) ([]*models.OnIssueStatusResponse, error) {
+	var rows []*models.OnIssueStatusResponse
+	err := r.db.WithContext(ctx).
+		Where("issue_id = ?", issueID).
+		Order("created_at ASC, id ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list on_issue_status_responses: %w", err)
+	}
+	return rows, nil
+}
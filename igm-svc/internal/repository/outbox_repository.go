@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type OutboxRepository interface {
+	// Enqueue stores an event; an event_id already stored is ignored.
+	Enqueue(ctx context.Context, row *models.DomainEventOutbox) error
+	FetchPending(ctx context.Context, now time.Time, limit int) ([]*models.DomainEventOutbox, error)
+	MarkPublished(ctx context.Context, id uint, at time.Time) error
+	MarkFailed(ctx context.Context, id uint, cause string, nextAttemptAt time.Time) error
+}
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) OutboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (r *outboxRepository) Enqueue(ctx context.Context, row *models.DomainEventOutbox) error {
+	if row == nil {
+		return fmt.Errorf("nil DomainEventOutbox")
+	}
+	if row.EventID == "" {
+		return fmt.Errorf("missing event_id in DomainEventOutbox")
+	}
+	now := time.Now()
+	if row.CreatedAt.IsZero() {
+		row.CreatedAt = now
+	}
+	if row.NextAttemptAt.IsZero() {
+		row.NextAttemptAt = now
+	}
+
+	err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(row).Error
+	if err != nil {
+		return fmt.Errorf("failed to enqueue domain event: %w", err)
+	}
+	return nil
+}
+
+func (r *outboxRepository) FetchPending(ctx context.Context, now time.Time, limit int) ([]*models.DomainEventOutbox, error) {
+	var rows []*models.DomainEventOutbox
+	err := r.db.WithContext(ctx).
+		Where("published_at IS NULL AND next_attempt_at <= ?", now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch pending domain events: %w", err)
+	}
+	return rows, nil
+}
+
+func (r *outboxRepository) MarkPublished(ctx context.Context, id uint, at time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.DomainEventOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"published_at": at,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   "",
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to mark domain event published: %w", err)
+	}
+	return nil
+}
+
+func (r *outboxRepository) MarkFailed(ctx context.Context, id uint, cause string, nextAttemptAt time.Time) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.DomainEventOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"last_error":      cause,
+			"next_attempt_at": nextAttemptAt,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to mark domain event failed: %w", err)
+	}
+	return nil
+}
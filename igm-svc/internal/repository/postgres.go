@@ -17,6 +17,7 @@ func NewPostgresDB(databaseURL string)(*gorm.DB,error){
 
 	db,err :=gorm.Open(postgres.Open(databaseURL),&gorm.Config{
 		Logger: logger.Default.LogMode(logger.Info),
+		TranslateError: true,
 		NowFunc:func() time.Time{
 			return  time.Now().UTC()
 		},
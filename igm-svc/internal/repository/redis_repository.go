@@ -2,77 +2,47 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
 	"time"
 
+	"igm-svc/pkg/eventbus"
+
 	"github.com/go-redis/redis/v8"
 )
 
+type RedisRepository interface {
+	// SaveIssueResponse publishes an issue event to the event bus.
+	SaveIssueResponse(ctx context.Context, transactionID string, payload map[string]interface{}) error
 
-type RedisRepository interface{
-	SaveIssueResponse(ctx context.Context, transactionID string, payload map[string]interface{})error
-	GetIssueResponse(ctx context.Context,transactionID string)([]map[string]interface{},error)
-	Exists(ctx context.Context,transactionID string)(bool,error)
+	// ReadIssueEvents blocks up to block for events on stream after afterID.
+	ReadIssueEvents(ctx context.Context, stream, afterID string, block time.Duration, count int64) ([]eventbus.Event, error)
+	// LatestIssueEventID returns the id of the newest event on stream, "0-0" when empty.
+	LatestIssueEventID(ctx context.Context, stream string) (string, error)
 }
 
-type redisRepository struct{
-	client *redis.Client
-	ttl time.Duration
+type redisRepository struct {
+	client    *redis.Client
+	publisher *eventbus.Publisher
 }
 
-func NewRedisRepository(client *redis.Client)RedisRepository{
+func NewRedisRepository(client *redis.Client, streamCfg eventbus.PublisherConfig) RedisRepository {
 	return &redisRepository{
-		client: client,
-		ttl: 24 * time.Hour,
+		client:    client,
+		publisher: eventbus.NewPublisher(client, streamCfg),
 	}
 }
 
-func (r *redisRepository) SaveIssueResponse(ctx context.Context, transactionID string, payload map[string]interface{})error{
-    key :=fmt.Sprintf("on_issue:%s",transactionID)
-
-    jsonData,err :=json.Marshal(payload)
-    if err!=nil{
-        return fmt.Errorf("failed to marshal the payload:%w",err)
-    }
-
-    if err := r.client.RPush(ctx,key,jsonData).Err(); err != nil {
-        return fmt.Errorf("failed to push to redis :%w",err)
-    }
-
-    r.client.Expire(ctx,key,r.ttl)
-
-    return nil
-}
-
-func (r *redisRepository) GetIssueResponse(ctx context.Context,transactionID string)([]map[string]interface{},error){
-	key :=fmt.Sprintf("on_issue:%s",transactionID)
-	
-	result,err :=r.client.LRange(ctx,key,0,-1).Result()
-	if err!=nil{
-		if err==redis.Nil{
-			return []map[string]interface{}{},nil
-		}
-		return nil,fmt.Errorf("redis LRANGE failed:%w",err)
+func (r *redisRepository) SaveIssueResponse(ctx context.Context, transactionID string, payload map[string]interface{}) error {
+	if _, ok := payload["transaction_id"]; !ok && transactionID != "" {
+		payload["transaction_id"] = transactionID
 	}
+	_, err := r.publisher.Publish(ctx, payload)
+	return err
+}
 
-	var response []map[string]interface{}
-	for _,jsonStr :=range result{
-		var payload map[string]interface{}
-		err :=json.Unmarshal([]byte(jsonStr),&payload)
-		if err!=nil{
-			continue
-		}
-		response=append(response, payload)
-	}
-	return  response,nil
+func (r *redisRepository) ReadIssueEvents(ctx context.Context, stream, afterID string, block time.Duration, count int64) ([]eventbus.Event, error) {
+	return eventbus.Read(ctx, r.client, stream, afterID, block, count)
 }
 
-func (r *redisRepository)Exists(ctx context.Context,transactionID string)(bool,error){
-	key:=fmt.Sprintf("on_issue:%s",transactionID)
-	count,err :=r.client.Exists(ctx,key).Result()
-	if err!=nil{
-		return false,err
-	}
-	return  count>0,nil
-}
\ No newline at end of file
+func (r *redisRepository) LatestIssueEventID(ctx context.Context, stream string) (string, error) {
+	return eventbus.LatestID(ctx, r.client, stream)
+}
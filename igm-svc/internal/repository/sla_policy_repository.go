@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type SLAPolicyRepository interface {
+	ListPolicies(ctx context.Context) ([]*models.SLAPolicy, error)
+}
+
+type slaPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewSLAPolicyRepository(db *gorm.DB) SLAPolicyRepository {
+	return &slaPolicyRepository{db: db}
+}
+
+func (r *slaPolicyRepository) ListPolicies(ctx context.Context) ([]*models.SLAPolicy, error) {
+	var policies []*models.SLAPolicy
+	err := r.db.WithContext(ctx).Order("id ASC").Find(&policies).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sla policies: %w", err)
+	}
+	return policies, nil
+}
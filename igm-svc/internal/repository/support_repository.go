@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// IssueSearchFilter narrows SupportRepository.SearchIssues. Zero fields match
+// every issue.
+type IssueSearchFilter struct {
+	Status      string
+	BPPID       string
+	Category    string
+	AssignedTo  string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	// SLABreached keeps issues that missed their response or resolution
+	// deadline.
+	SLABreached bool
+}
+
+type SupportRepository interface {
+	SearchIssues(ctx context.Context, f IssueSearchFilter, limit int, offset int) ([]*models.Issue, int, error)
+	// ListCallbacks returns the raw ONDC callbacks stored for an issue,
+	// oldest first.
+	ListCallbacks(ctx context.Context, transactionID string, issueID string) ([]*models.OndcCallback, error)
+	ListNotes(ctx context.Context, issueID string) ([]*models.IssueNote, error)
+
+	// AddNote stores note together with its audit entry.
+	AddNote(ctx context.Context, note *models.IssueNote, audit *models.SupportAuditEntry) error
+	// UpdateIssue saves the assignment, status and complainant actions of
+	// issue together with its audit entry.
+	UpdateIssue(ctx context.Context, issue *models.Issue, audit *models.SupportAuditEntry) error
+	RecordAudit(ctx context.Context, entry *models.SupportAuditEntry) error
+	ListAudit(ctx context.Context, issueID string, actor string, limit int) ([]*models.SupportAuditEntry, error)
+}
+
+type supportRepository struct {
+	db *gorm.DB
+}
+
+func NewSupportRepository(db *gorm.DB) SupportRepository {
+	return &supportRepository{db: db}
+}
+
+func (r *supportRepository) SearchIssues(ctx context.Context, f IssueSearchFilter, limit int, offset int) ([]*models.Issue, int, error) {
+	q := r.db.WithContext(ctx).Model(&models.Issue{})
+	if f.Status != "" {
+		q = q.Where("status = ?", f.Status)
+	}
+	if f.BPPID != "" {
+		q = q.Where("bpp_id = ?", f.BPPID)
+	}
+	if f.Category != "" {
+		q = q.Where("category = ?", f.Category)
+	}
+	if f.AssignedTo != "" {
+		q = q.Where("assigned_to = ?", f.AssignedTo)
+	}
+	if f.CreatedFrom != nil {
+		q = q.Where("created_at >= ?", *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		q = q.Where("created_at < ?", *f.CreatedTo)
+	}
+	if f.SLABreached {
+		q = q.Where("response_breached_at IS NOT NULL OR resolution_breached_at IS NOT NULL")
+	}
+
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count issues: %w", err)
+	}
+	var issues []*models.Issue
+	err := q.Order("created_at DESC").
+		Limit(limit).Offset(offset).
+		Find(&issues).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search issues: %w", err)
+	}
+	return issues, int(total), nil
+}
+
+func (r *supportRepository) ListCallbacks(ctx context.Context, transactionID string, issueID string) ([]*models.OndcCallback, error) {
+	var callbacks []*models.OndcCallback
+	// a transaction can carry several issues of the same order
+	err := r.db.WithContext(ctx).
+		Where("transaction_id = ? AND payload->'issue'->>'id' = ?", transactionID, issueID).
+		Order("created_at ASC").
+		Find(&callbacks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ondc callbacks: %w", err)
+	}
+	return callbacks, nil
+}
+
+func (r *supportRepository) ListNotes(ctx context.Context, issueID string) ([]*models.IssueNote, error) {
+	var notes []*models.IssueNote
+	err := r.db.WithContext(ctx).
+		Where("issue_id = ?", issueID).
+		Order("created_at ASC").
+		Find(&notes).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue notes: %w", err)
+	}
+	return notes, nil
+}
+
+func (r *supportRepository) AddNote(ctx context.Context, note *models.IssueNote, audit *models.SupportAuditEntry) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		note.CreatedAt = time.Now()
+		if err := tx.Create(note).Error; err != nil {
+			return fmt.Errorf("failed to add issue note: %w", err)
+		}
+		return createAuditEntry(tx, audit)
+	})
+}
+
+// UpdateIssue writes only the columns support actions change, so callbacks
+// stored since the issue was loaded are kept.
+func (r *supportRepository) UpdateIssue(ctx context.Context, issue *models.Issue, audit *models.SupportAuditEntry) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&models.Issue{}).
+			Where("issue_id = ?", issue.IssueID).
+			UpdateColumns(map[string]interface{}{
+				"assigned_to":         issue.AssignedTo,
+				"assigned_at":         issue.AssignedAt,
+				"status":              issue.Status,
+				"complainant_actions": issue.ComplainantActions,
+				"updated_at":          issue.UpdatedAt,
+			})
+		if res.Error != nil {
+			return fmt.Errorf("failed to update issue: %w", res.Error)
+		}
+		if res.RowsAffected == 0 {
+			return ErrIssueNotFound
+		}
+		return createAuditEntry(tx, audit)
+	})
+}
+
+func (r *supportRepository) RecordAudit(ctx context.Context, entry *models.SupportAuditEntry) error {
+	return createAuditEntry(conn(ctx, r.db), entry)
+}
+
+func (r *supportRepository) ListAudit(ctx context.Context, issueID string, actor string, limit int) ([]*models.SupportAuditEntry, error) {
+	var entries []*models.SupportAuditEntry
+	q := r.db.WithContext(ctx)
+	if issueID != "" {
+		q = q.Where("issue_id = ?", issueID)
+	}
+	if actor != "" {
+		q = q.Where("actor = ?", actor)
+	}
+	if err := q.Order("created_at DESC").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to list support audit log: %w", err)
+	}
+	return entries, nil
+}
+
+func createAuditEntry(db *gorm.DB, entry *models.SupportAuditEntry) error {
+	entry.CreatedAt = time.Now()
+	if err := db.Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to record support audit entry: %w", err)
+	}
+	return nil
+}
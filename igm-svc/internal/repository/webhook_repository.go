@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"igm-svc/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrWebhookSubscriptionNotFound = fmt.Errorf("webhook subscription %w", ErrNotFound)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id uuid.UUID) (*models.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, activeOnly bool) ([]*models.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id uuid.UUID) (bool, error)
+	// RecordSuccess clears the subscription's failure streak.
+	RecordSuccess(ctx context.Context, id uuid.UUID) error
+	// RecordFailure extends the failure streak and disables the subscription
+	// once it reaches disableAfter. It reports whether this call disabled it.
+	RecordFailure(ctx context.Context, id uuid.UUID, disableAfter int, reason string) (bool, error)
+
+	// EnqueueDelivery stores d; an event already queued for the subscription
+	// is ignored.
+	EnqueueDelivery(ctx context.Context, d *models.WebhookDelivery) error
+	// FindDueDeliveries returns pending deliveries of active subscriptions.
+	FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, d *models.WebhookDelivery) error
+	ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, limit int) ([]*models.WebhookDelivery, error)
+}
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) WebhookRepository {
+	return &webhookRepository{db: db}
+}
+
+func (r *webhookRepository) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	now := time.Now()
+	sub.CreatedAt, sub.UpdatedAt = now, now
+	if err := r.db.WithContext(ctx).Create(sub).Error; err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", translateError(err, ErrWebhookSubscriptionNotFound))
+	}
+	return nil
+}
+
+func (r *webhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*models.WebhookSubscription, error) {
+	var sub models.WebhookSubscription
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&sub).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrWebhookSubscriptionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+func (r *webhookRepository) ListSubscriptions(ctx context.Context, activeOnly bool) ([]*models.WebhookSubscription, error) {
+	var subs []*models.WebhookSubscription
+	q := r.db.WithContext(ctx)
+	if activeOnly {
+		q = q.Where("active = ?", true)
+	}
+	if err := q.Order("created_at ASC").Find(&subs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list webhook subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+func (r *webhookRepository) UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	sub.UpdatedAt = time.Now()
+	result := r.db.WithContext(ctx).
+		Model(&models.WebhookSubscription{}).
+		Where("id = ?", sub.ID).
+		Updates(map[string]interface{}{
+			"name":                 sub.Name,
+			"url":                  sub.URL,
+			"secret":               sub.Secret,
+			"event_types":          sub.EventTypes,
+			"active":               sub.Active,
+			"consecutive_failures": sub.ConsecutiveFailures,
+			"disabled_at":          sub.DisabledAt,
+			"disabled_reason":      sub.DisabledReason,
+			"updated_at":           sub.UpdatedAt,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+	return nil
+}
+
+func (r *webhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) (bool, error) {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.WebhookSubscription{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete webhook subscription: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *webhookRepository) RecordSuccess(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).
+		Model(&models.WebhookSubscription{}).
+		Where("id = ? AND consecutive_failures > 0", id).
+		Updates(map[string]interface{}{
+			"consecutive_failures": 0,
+			"updated_at":           time.Now(),
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to record webhook success: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) RecordFailure(ctx context.Context, id uuid.UUID, disableAfter int, reason string) (bool, error) {
+	now := time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&models.WebhookSubscription{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"consecutive_failures": gorm.Expr("consecutive_failures + 1"),
+			"updated_at":           now,
+		}).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to record webhook failure: %w", err)
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&models.WebhookSubscription{}).
+		Where("id = ? AND active AND consecutive_failures >= ?", id, disableAfter).
+		Updates(map[string]interface{}{
+			"active":          false,
+			"disabled_at":     now,
+			"disabled_reason": reason,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to disable webhook subscription: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *webhookRepository) EnqueueDelivery(ctx context.Context, d *models.WebhookDelivery) error {
+	now := time.Now()
+	if d.CreatedAt.IsZero() {
+		d.CreatedAt = now
+	}
+	if d.NextAttemptAt.IsZero() {
+		d.NextAttemptAt = now
+	}
+	d.UpdatedAt = now
+
+	err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "subscription_id"}, {Name: "event_id"}},
+			DoNothing: true,
+		}).
+		Create(d).Error
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	var out []*models.WebhookDelivery
+	err := r.db.WithContext(ctx).
+		Joins("JOIN webhook_subscriptions s ON s.id = webhook_deliveries.subscription_id AND s.active").
+		Where("webhook_deliveries.status = ? AND webhook_deliveries.next_attempt_at <= ?", models.WebhookDeliveryPending, now).
+		Order("webhook_deliveries.next_attempt_at ASC, webhook_deliveries.id ASC").
+		Limit(limit).
+		Find(&out).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due webhook deliveries: %w", err)
+	}
+	return out, nil
+}
+
+func (r *webhookRepository) UpdateDelivery(ctx context.Context, d *models.WebhookDelivery) error {
+	d.UpdatedAt = time.Now()
+	err := r.db.WithContext(ctx).
+		Model(&models.WebhookDelivery{}).
+		Where("id = ?", d.ID).
+		Updates(map[string]interface{}{
+			"status":           d.Status,
+			"attempts":         d.Attempts,
+			"last_status_code": d.LastStatusCode,
+			"last_error":       d.LastError,
+			"next_attempt_at":  d.NextAttemptAt,
+			"delivered_at":     d.DeliveredAt,
+			"updated_at":       d.UpdatedAt,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *webhookRepository) ListDeliveries(ctx context.Context, subscriptionID uuid.UUID, status string, limit int) ([]*models.WebhookDelivery, error) {
+	var out []*models.WebhookDelivery
+	q := r.db.WithContext(ctx).Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	err := q.Order("created_at DESC, id DESC").Limit(limit).Find(&out).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+	return out, nil
+}
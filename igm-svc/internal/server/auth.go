@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"igm-svc/internal/auth"
+	"log"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// unauthenticatedPrefixes are served without a token. Reflection only
+// exposes the schema.
+var unauthenticatedPrefixes = []string{
+	"/grpc.reflection.",
+}
+
+func AuthInterceptor(verifier auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if skipAuth(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		ctx, err := authenticate(ctx, verifier, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamAuthInterceptor(verifier auth.Verifier) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if skipAuth(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		ctx, err := authenticate(ss.Context(), verifier, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func authenticate(ctx context.Context, verifier auth.Verifier, method string) (context.Context, error) {
+	p, err := verifier.Verify(ctx, bearerToken(ctx))
+	if err != nil {
+		log.Printf("auth failed for %s: %v", method, err)
+		return nil, status.Error(codes.Unauthenticated, "invalid or missing credentials")
+	}
+	return auth.WithPrincipal(ctx, p), nil
+}
+
+// bearerToken reads "authorization: Bearer <token>" from incoming metadata.
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, v := range md.Get("authorization") {
+		if len(v) > 7 && strings.EqualFold(v[:7], "bearer ") {
+			return strings.TrimSpace(v[7:])
+		}
+	}
+	return ""
+}
+
+func skipAuth(method string) bool {
+	for _, prefix := range unauthenticatedPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
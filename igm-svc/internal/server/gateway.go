@@ -0,0 +1,178 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"igm-svc/api/openapi"
+	"igm-svc/pkg/storage"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+	igmv2 "igm-svc/api/proto/igm/v2"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/cors"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// GatewayConfig configures the REST/JSON gateway.
+type GatewayConfig struct {
+	Port           string
+	GRPCPort       string       // the gateway calls the gRPC server so auth and validation run once
+	AllowedOrigins []string     // CORS origins; "*" allows any, empty disables CORS
+	Attachments    http.Handler // serves local-store attachment URLs under /attachments/, nil for S3
+}
+
+// GatewayServer serves IssueService v1 and v2 over HTTP/JSON using the
+// google.api.http routes in the protos, plus the OpenAPI document at
+// /openapi.json and, with the local attachment store, attachment downloads.
+type GatewayServer struct {
+	server *http.Server
+}
+
+func NewGatewayServer(ctx context.Context, cfg GatewayConfig) (*GatewayServer, error) {
+	mux := newGatewayMux()
+	endpoint := dialAddr(cfg.GRPCPort)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterIssueServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, fmt.Errorf("failed to register v1 gateway:%w", err)
+	}
+	if err := igmv2.RegisterIssueServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, fmt.Errorf("failed to register v2 gateway:%w", err)
+	}
+
+	return &GatewayServer{
+		server: &http.Server{
+			Addr:              cfg.Port,
+			Handler:           gatewayHandler(mux, cfg.AllowedOrigins, cfg.Attachments),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}, nil
+}
+
+func (s *GatewayServer) Start() error {
+	log.Printf("http gateway listening to %s", s.server.Addr)
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve http gateway:%w", err)
+	}
+	return nil
+}
+
+func (s *GatewayServer) Stop() {
+	log.Printf("shutting down http gateway")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		log.Printf("failed to shut down http gateway: %v", err)
+	}
+	log.Printf("http gateway stopped")
+}
+
+// newGatewayMux uses the proto field names in JSON, matching the ONDC
+// payloads and the OpenAPI document, and writes every error as an ErrorBody.
+func newGatewayMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+		runtime.WithErrorHandler(gatewayErrorHandler),
+	)
+}
+
+func gatewayHandler(mux *runtime.ServeMux, allowedOrigins []string, attachments http.Handler) http.Handler {
+	root := http.NewServeMux()
+	root.HandleFunc("GET /openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openapi.Spec)
+	})
+	if attachments != nil {
+		root.Handle(storage.LocalPathPrefix, attachments)
+	}
+	root.Handle("/", mux)
+	if len(allowedOrigins) == 0 {
+		return root
+	}
+	return cors.New(cors.Options{
+		AllowedOrigins: allowedOrigins,
+		AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowedHeaders: []string{"Authorization", "Content-Type"},
+		MaxAge:         600,
+	}).Handler(root)
+}
+
+// gatewayErrorHandler replaces the google.rpc.Status body with ErrorBody.
+// Routing errors arrive here too, wrapped by the default routing handler.
+func gatewayErrorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	httpStatus := 0
+	var statusErr *runtime.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		httpStatus = statusErr.HTTPStatus
+		err = statusErr.Err
+	}
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = runtime.HTTPStatusFromCode(st.Code())
+	}
+
+	body := errorBody(st, httpStatus)
+	buf, merr := marshaler.Marshal(body)
+	if merr != nil {
+		log.Printf("failed to marshal gateway error: %v", merr)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", marshaler.ContentType(body))
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	w.WriteHeader(httpStatus)
+	if _, err := w.Write(buf); err != nil {
+		log.Printf("failed to write gateway error: %v", err)
+	}
+}
+
+func errorBody(st *status.Status, httpStatus int) *pb.ErrorBody {
+	body := &pb.ErrorBody{
+		HttpStatus: int32(httpStatus),
+		Code:       code.Code(st.Code()).String(),
+		Message:    st.Message(),
+	}
+	for _, d := range st.Details() {
+		br, ok := d.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, v := range br.FieldViolations {
+			body.FieldViolations = append(body.FieldViolations, &pb.ErrorBody_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+	}
+	return body
+}
+
+// dialAddr turns a listen address such as ":50053" into one the gateway
+// can dial.
+func dialAddr(listenAddr string) string {
+	if strings.HasPrefix(listenAddr, ":") {
+		return "localhost" + listenAddr
+	}
+	return listenAddr
+}
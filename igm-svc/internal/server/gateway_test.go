@@ -0,0 +1,142 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type stubIssueServer struct {
+	pb.UnimplementedIssueServiceServer
+}
+
+func (stubIssueServer) GetIssue(ctx context.Context, req *pb.GetIssueRequest) (*pb.GetIssueResponse, error) {
+	if req.IssueId == "missing" {
+		return nil, status.Error(codes.NotFound, "issue missing not found")
+	}
+	return &pb.GetIssueResponse{Issue: &pb.Issue{IssueId: req.IssueId, OrderId: "order-1"}}, nil
+}
+
+func (stubIssueServer) CreateIssue(ctx context.Context, req *pb.CreateIssueRequest) (*pb.CreateIssueResponse, error) {
+	st, err := status.New(codes.InvalidArgument, "invalid request: order_id: value is required").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "order_id", Description: "value is required"},
+			{Field: "items", Description: "value must contain at least 1 item(s)"},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nil, st.Err()
+}
+
+func newTestGateway(t *testing.T, origins ...string) http.Handler {
+	mux := newGatewayMux()
+	require.NoError(t, pb.RegisterIssueServiceHandlerServer(context.Background(), mux, stubIssueServer{}))
+	return gatewayHandler(mux, origins, nil)
+}
+
+func serve(h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeErrorBody(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body
+}
+
+func TestGateway_RoutesWithProtoFieldNames(t *testing.T) {
+	rec := serve(newTestGateway(t), http.MethodGet, "/v1/issues/issue-1", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var body struct {
+		Issue map[string]any `json:"issue"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, "issue-1", body.Issue["issue_id"])
+	assert.Equal(t, "order-1", body.Issue["order_id"])
+	assert.Contains(t, body.Issue, "category", "unpopulated fields are emitted")
+}
+
+func TestGateway_ErrorBodies(t *testing.T) {
+	h := newTestGateway(t)
+
+	rec := serve(h, http.MethodPost, "/v1/issues", `{"user_id":"u-1"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	body := decodeErrorBody(t, rec)
+	assert.EqualValues(t, 400, body["http_status"])
+	assert.Equal(t, "INVALID_ARGUMENT", body["code"])
+	require.Len(t, body["field_violations"], 2)
+	assert.Equal(t, "order_id", body["field_violations"].([]any)[0].(map[string]any)["field"])
+
+	rec = serve(h, http.MethodGet, "/v1/issues/missing", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "NOT_FOUND", decodeErrorBody(t, rec)["code"])
+
+	rec = serve(h, http.MethodPost, "/v1/issues", `{"user_id":`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "INVALID_ARGUMENT", decodeErrorBody(t, rec)["code"])
+
+	rec = serve(h, http.MethodGet, "/v1/nothing-here", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "NOT_FOUND", decodeErrorBody(t, rec)["code"])
+
+	rec = serve(h, http.MethodGet, "/v1/issues:search?query=late", "")
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+	assert.Equal(t, "UNIMPLEMENTED", decodeErrorBody(t, rec)["code"])
+}
+
+func TestGateway_CORS(t *testing.T) {
+	h := newTestGateway(t, "https://app.example")
+
+	preflight := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, "/v1/issues", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "authorization,content-type")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := preflight("https://app.example")
+	assert.Equal(t, "https://app.example", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, rec.Header().Get("Access-Control-Allow-Methods"), http.MethodPost)
+
+	rec = preflight("https://evil.example")
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	rec := serve(newTestGateway(t), http.MethodGet, "/openapi.json", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var doc struct {
+		Paths map[string]map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &doc))
+	assert.Contains(t, doc.Paths["/v1/issues"], "post")
+	assert.Contains(t, doc.Paths["/v1/issues/{issue_id}"], "get")
+	assert.Contains(t, doc.Paths["/v2/issues"], "post")
+}
+
+func TestDialAddr(t *testing.T) {
+	assert.Equal(t, "localhost:50053", dialAddr(":50053"))
+	assert.Equal(t, "igm:50053", dialAddr("igm:50053"))
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+)
+
+// GRPCWebConfig configures the gRPC-Web listener.
+type GRPCWebConfig struct {
+	Port           string
+	AllowedOrigins []string // "*" allows any origin; empty allows same-origin requests only
+}
+
+// GRPCWebServer serves the gRPC services to browsers. It wraps the
+// *grpc.Server built by NewGRPCServer, so every call runs through the same
+// interceptor chain. Server streams such as WatchIssue are sent as chunked
+// HTTP/1.1 responses; client and bidi streams use the websocket transport.
+type GRPCWebServer struct {
+	server *http.Server
+}
+
+func NewGRPCWebServer(grpcServer *GRPCServer, cfg GRPCWebConfig) *GRPCWebServer {
+	allowed := originAllowed(cfg.AllowedOrigins)
+	wrapped := grpcweb.WrapServer(grpcServer.server,
+		grpcweb.WithOriginFunc(allowed),
+		grpcweb.WithAllowedRequestHeaders([]string{"authorization", "content-type", "x-grpc-web", "x-user-agent"}),
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketOriginFunc(websocketOriginAllowed(allowed)),
+	)
+
+	return &GRPCWebServer{
+		server: &http.Server{
+			Addr:              cfg.Port,
+			Handler:           grpcWebHandler(wrapped),
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+func (s *GRPCWebServer) Start() error {
+	log.Printf("grpc-web server listening to %s", s.server.Addr)
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve grpc-web:%w", err)
+	}
+	return nil
+}
+
+func (s *GRPCWebServer) Stop() {
+	log.Printf("shutting down grpc-web server")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		log.Printf("failed to shut down grpc-web server: %v", err)
+	}
+	log.Printf("grpc-web server stopped")
+}
+
+// grpcWebHandler only answers gRPC-Web, its CORS preflights and websocket
+// upgrades; plain HTTP requests get 404 instead of reaching the gRPC
+// server's HTTP/2 handler.
+func grpcWebHandler(wrapped *grpcweb.WrappedGrpcServer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrapped.IsGrpcWebRequest(r) || wrapped.IsAcceptableGrpcCorsRequest(r) || wrapped.IsGrpcWebSocketRequest(r) {
+			wrapped.ServeHTTP(w, r)
+			return
+		}
+		http.NotFound(w, r)
+	})
+}
+
+func originAllowed(allowedOrigins []string) func(origin string) bool {
+	allowed := map[string]bool{}
+	for _, o := range allowedOrigins {
+		allowed[o] = true
+	}
+	return func(origin string) bool {
+		return allowed["*"] || allowed[origin]
+	}
+}
+
+// websocketOriginAllowed also admits the host the request was sent to.
+// Browsers send Origin on every websocket upgrade, same-origin ones included,
+// while same-origin gRPC-Web calls need no CORS headers at all.
+func websocketOriginAllowed(allowed func(origin string) bool) func(req *http.Request) bool {
+	return func(req *http.Request) bool {
+		origin := req.Header.Get("Origin")
+		if allowed(origin) {
+			return true
+		}
+		u, err := url.Parse(origin)
+		return err == nil && u.Host != "" && strings.EqualFold(u.Host, req.Host)
+	}
+}
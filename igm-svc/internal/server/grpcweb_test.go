@@ -0,0 +1,167 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/handlers"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const testOrigin = "https://support.example"
+
+type stubWatchServer struct {
+	pb.UnimplementedIssueServiceServer
+}
+
+func (stubWatchServer) WatchIssue(req *pb.WatchIssueRequest, stream grpc.ServerStreamingServer[pb.IssueEvent]) error {
+	for _, cursor := range []string{"1-0", "2-0"} {
+		if err := stream.Send(&pb.IssueEvent{IssueId: req.IssueId, Cursor: cursor}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type rejectAll struct{}
+
+func (rejectAll) Verify(ctx context.Context, token string) (*auth.Principal, error) {
+	return nil, errors.New("no token")
+}
+
+// grpcWebCall posts one length-prefixed message and splits the response
+// into data frames and the trailer frame.
+func grpcWebCall(t *testing.T, url, method string, req proto.Message) (http.Header, [][]byte, string) {
+	payload, err := proto.Marshal(req)
+	require.NoError(t, err)
+	body := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(body[1:], uint32(len(payload)))
+	body = append(body, payload...)
+
+	httpReq, err := http.NewRequest(http.MethodPost, url+method, bytes.NewReader(body))
+	require.NoError(t, err)
+	httpReq.Header.Set("Content-Type", "application/grpc-web+proto")
+	httpReq.Header.Set("X-Grpc-Web", "1")
+	httpReq.Header.Set("Origin", testOrigin)
+	resp, err := http.DefaultClient.Do(httpReq)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var frames [][]byte
+	var trailer string
+	for len(raw) >= 5 {
+		n := binary.BigEndian.Uint32(raw[1:5])
+		frame := raw[5 : 5+n]
+		if raw[0]&0x80 != 0 {
+			trailer = string(frame)
+		} else {
+			frames = append(frames, frame)
+		}
+		raw = raw[5+n:]
+	}
+	return resp.Header, frames, trailer
+}
+
+func grpcStatus(header http.Header, trailer string) string {
+	if s := header.Get("Grpc-Status"); s != "" {
+		return s
+	}
+	for _, line := range strings.Split(trailer, "\r\n") {
+		if v, ok := strings.CutPrefix(strings.ToLower(line), "grpc-status:"); ok {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+func TestGRPCWeb_ServerStreaming(t *testing.T) {
+	grpcServer := &GRPCServer{server: grpc.NewServer()}
+	pb.RegisterIssueServiceServer(grpcServer.server, stubWatchServer{})
+	ts := httptest.NewServer(NewGRPCWebServer(grpcServer, GRPCWebConfig{AllowedOrigins: []string{testOrigin}}).server.Handler)
+	defer ts.Close()
+
+	header, frames, trailer := grpcWebCall(t, ts.URL, "/igm.v1.IssueService/WatchIssue", &pb.WatchIssueRequest{IssueId: "issue-1"})
+	assert.Equal(t, testOrigin, header.Get("Access-Control-Allow-Origin"))
+	require.Len(t, frames, 2)
+	var event pb.IssueEvent
+	require.NoError(t, proto.Unmarshal(frames[1], &event))
+	assert.Equal(t, "issue-1", event.IssueId)
+	assert.Equal(t, "2-0", event.Cursor)
+	assert.Equal(t, "0", grpcStatus(header, trailer))
+}
+
+func TestGRPCWeb_UsesInterceptorChain(t *testing.T) {
+	issueHandler := handlers.NewIssueHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	newServer := func(verifier auth.Verifier) *httptest.Server {
+		grpcServer, err := NewGRPCServer(":0", issueHandler, handlers.NewIssueV2Handler(nil), handlers.NewSupportHandler(nil), verifier)
+		require.NoError(t, err)
+		return httptest.NewServer(NewGRPCWebServer(grpcServer, GRPCWebConfig{AllowedOrigins: []string{"*"}}).server.Handler)
+	}
+
+	ts := newServer(rejectAll{})
+	header, _, trailer := grpcWebCall(t, ts.URL, "/igm.v1.IssueService/WatchUserIssues", &pb.WatchUserIssuesRequest{UserId: uuid.NewString()})
+	ts.Close()
+	assert.Equal(t, "16", grpcStatus(header, trailer), "stream auth interceptor rejects the call")
+
+	ts = newServer(auth.TrustAll{})
+	header, _, trailer = grpcWebCall(t, ts.URL, "/igm.v1.IssueService/GetIssue", &pb.GetIssueRequest{})
+	ts.Close()
+	assert.Equal(t, "3", grpcStatus(header, trailer), "validation interceptor rejects a missing issue_id")
+}
+
+func TestGRPCWeb_Origins(t *testing.T) {
+	grpcServer := &GRPCServer{server: grpc.NewServer()}
+	pb.RegisterIssueServiceServer(grpcServer.server, stubWatchServer{})
+	h := NewGRPCWebServer(grpcServer, GRPCWebConfig{AllowedOrigins: []string{testOrigin}}).server.Handler
+
+	preflight := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, "/igm.v1.IssueService/WatchIssue", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		req.Header.Set("Access-Control-Request-Headers", "authorization,content-type,x-grpc-web")
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+	assert.Equal(t, testOrigin, preflight(testOrigin).Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, preflight("https://evil.example").Header().Get("Access-Control-Allow-Origin"))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestWebsocketOriginAllowed(t *testing.T) {
+	upgrade := func(origin string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "http://igm.example/igm.v1.IssueService/WatchIssue", nil)
+		req.Header.Set("Origin", origin)
+		return req
+	}
+
+	sameOriginOnly := websocketOriginAllowed(originAllowed(nil))
+	assert.True(t, sameOriginOnly(upgrade("http://igm.example")), "same-origin upgrade is allowed with an empty list")
+	assert.False(t, sameOriginOnly(upgrade(testOrigin)))
+	assert.False(t, sameOriginOnly(upgrade("")))
+
+	listed := websocketOriginAllowed(originAllowed([]string{testOrigin}))
+	assert.True(t, listed(upgrade(testOrigin)))
+	assert.True(t, listed(upgrade("http://igm.example")))
+	assert.False(t, listed(upgrade("https://evil.example")))
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"log"
+	"runtime/debug"
 	"time"
 
 	"google.golang.org/grpc"
@@ -42,3 +43,36 @@ func RecoveryInterceptor() grpc.UnaryServerInterceptor {
 		return handler(ctx, req)
 	}
 }
+
+func StreamLoggingInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		log.Printf("grpc stream:%s", info.FullMethod)
+
+		err := handler(srv, ss)
+
+		duration := time.Since(start)
+		if err != nil {
+			log.Printf("← gRPC stream: %s [ERROR] duration=%v err=%v",
+				info.FullMethod, duration, err)
+		} else {
+			log.Printf("← gRPC stream: %s [OK] duration=%v",
+				info.FullMethod, duration)
+		}
+		return err
+	}
+}
+
+func StreamRecoveryInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				// the panic value may hold internal state; it stays in the log
+				log.Printf("panic recovered in %s:%v\n%s", info.FullMethod, r, debug.Stack())
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
@@ -2,12 +2,15 @@ package server
 
 import (
 	"fmt"
+	"igm-svc/internal/auth"
 	"igm-svc/internal/handlers"
 	"log"
 	"net"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
+	pb "igm-svc/api/proto/igm/v1"
+	igmv2 "igm-svc/api/proto/igm/v2"
 
+	"buf.build/go/protovalidate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -18,15 +21,30 @@ type GRPCServer struct {
 	handler *handlers.IssueHandler
 }
 
-func NewGRPCServer(port string, handler *handlers.IssueHandler) *GRPCServer {
+func NewGRPCServer(port string, handler *handlers.IssueHandler, v2Handler *handlers.IssueV2Handler, supportHandler *handlers.SupportHandler, verifier auth.Verifier) (*GRPCServer, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request validator:%w", err)
+	}
+
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			LoggingInterceptor(),
 			RecoveryInterceptor(),
+			AuthInterceptor(verifier),
+			ValidationInterceptor(validator),
+		),
+		grpc.ChainStreamInterceptor(
+			StreamLoggingInterceptor(),
+			StreamRecoveryInterceptor(),
+			StreamAuthInterceptor(verifier),
+			StreamValidationInterceptor(validator),
 		),
 	)
 
 	pb.RegisterIssueServiceServer(server, handler)
+	igmv2.RegisterIssueServiceServer(server, v2Handler)
+	pb.RegisterSupportServiceServer(server, supportHandler)
 
 	reflection.Register(server)
 
@@ -34,7 +52,7 @@ func NewGRPCServer(port string, handler *handlers.IssueHandler) *GRPCServer {
 		server:  server,
 		port:    port,
 		handler: handler,
-	}
+	}, nil
 }
 
 func (s *GRPCServer) Start() error {
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"buf.build/go/protovalidate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationInterceptor enforces the buf.validate rules declared in the
+// proto files before the handler runs. Messages without rules pass
+// through; the services keep the cross-field and business checks.
+func ValidationInterceptor(validator protovalidate.Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := validateMessage(validator, req); err != nil {
+			log.Printf("invalid request for %s: %v", info.FullMethod, err)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidationInterceptor validates every message the client sends on
+// a stream as the handler receives it.
+func StreamValidationInterceptor(validator protovalidate.Validator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, validator: validator, method: info.FullMethod})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+	validator protovalidate.Validator
+	method    string
+}
+
+func (s *validatingStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := validateMessage(s.validator, m); err != nil {
+		log.Printf("invalid request for %s: %v", s.method, err)
+		return err
+	}
+	return nil
+}
+
+// validateMessage returns InvalidArgument with a BadRequest listing every
+// violation, so clients can fix all fields in one round trip.
+func validateMessage(validator protovalidate.Validator, m any) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	err := validator.Validate(msg)
+	if err == nil {
+		return nil
+	}
+	var verr *protovalidate.ValidationError
+	if !errors.As(err, &verr) {
+		return status.Errorf(codes.Internal, "failed to validate request :%v", err)
+	}
+	br := &errdetails.BadRequest{}
+	for _, v := range verr.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violationField(v),
+			Description: v.Proto.GetMessage(),
+		})
+	}
+	st, detailErr := status.New(codes.InvalidArgument, validationSummary(br)).WithDetails(br)
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, validationSummary(br))
+	}
+	return st.Err()
+}
+
+func violationField(v *protovalidate.Violation) string {
+	if field := protovalidate.FieldPathString(v.Proto.GetField()); field != "" {
+		return field
+	}
+	return v.Proto.GetRuleId()
+}
+
+func validationSummary(br *errdetails.BadRequest) string {
+	first := br.FieldViolations[0]
+	msg := fmt.Sprintf("invalid request: %s: %s", first.Field, first.Description)
+	if n := len(br.FieldViolations) - 1; n > 0 {
+		msg += fmt.Sprintf(" (and %d more)", n)
+	}
+	return msg
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "igm-svc/api/proto/igm/v1"
+	igmv2 "igm-svc/api/proto/igm/v2"
+
+	"buf.build/go/protovalidate"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestValidator(t *testing.T) protovalidate.Validator {
+	validator, err := protovalidate.New()
+	require.NoError(t, err)
+	return validator
+}
+
+func validCreateIssueRequest() *pb.CreateIssueRequest {
+	return &pb.CreateIssueRequest{
+		UserId:      uuid.NewString(),
+		OrderId:     "order-1",
+		Category:    "ITEM",
+		SubCategory: "ITM02",
+		IssueType:   "ISSUE",
+		Description: "damaged packaging",
+		ImageUrls:   []string{"https://cdn.example/1.png"},
+		Items:       []*pb.IssueItem{{Id: "item-1", Quantity: 1}},
+	}
+}
+
+func invokeUnary(t *testing.T, req any) (bool, error) {
+	called := false
+	_, err := ValidationInterceptor(newTestValidator(t))(context.Background(), req,
+		&grpc.UnaryServerInfo{FullMethod: "/igm.v1.IssueService/CreateIssue"},
+		func(ctx context.Context, req any) (any, error) {
+			called = true
+			return nil, nil
+		})
+	return called, err
+}
+
+func fieldViolations(t *testing.T, err error) map[string]string {
+	st := status.Convert(err)
+	require.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+	br, ok := st.Details()[0].(*errdetails.BadRequest)
+	require.True(t, ok)
+	fields := map[string]string{}
+	for _, v := range br.FieldViolations {
+		fields[v.Field] = v.Description
+	}
+	return fields
+}
+
+func TestValidationInterceptor_PassesValidRequest(t *testing.T) {
+	called, err := invokeUnary(t, validCreateIssueRequest())
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestValidationInterceptor_ReportsAllViolations(t *testing.T) {
+	req := validCreateIssueRequest()
+	req.UserId = "not-a-uuid"
+	req.OrderId = ""
+	req.ImageUrls = []string{"not a url"}
+	req.Items[0].Quantity = 0
+	req.Description = string(make([]byte, 256))
+
+	called, err := invokeUnary(t, req)
+	assert.False(t, called)
+	fields := fieldViolations(t, err)
+	assert.Len(t, fields, 5)
+	for _, field := range []string{"user_id", "order_id", "description", "image_urls[0]", "items[0].quantity"} {
+		assert.Contains(t, fields, field)
+	}
+	assert.Contains(t, status.Convert(err).Message(), "(and 4 more)")
+}
+
+func TestValidationInterceptor_V2Enums(t *testing.T) {
+	req := &igmv2.CloseIssueRequest{
+		UserId:  uuid.NewString(),
+		IssueId: "issue-1",
+		OrderId: "order-1",
+		Status:  igmv2.IssueStatus_ISSUE_STATUS_CLOSED,
+		Rating:  igmv2.Rating(42),
+	}
+	_, err := invokeUnary(t, req)
+	assert.Contains(t, fieldViolations(t, err), "rating")
+
+	req.Rating = igmv2.Rating_RATING_UNSPECIFIED
+	_, err = invokeUnary(t, req)
+	assert.Contains(t, fieldViolations(t, err), "rating")
+
+	req.Rating = igmv2.Rating_RATING_THUMBS_UP
+	called, err := invokeUnary(t, req)
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestValidationInterceptor_OptionalUserID(t *testing.T) {
+	called, err := invokeUnary(t, &pb.ListIssueRequest{})
+	require.NoError(t, err)
+	assert.True(t, called)
+
+	// callers with a token may leave user_id to auth.ResolveUserID
+	create := validCreateIssueRequest()
+	create.UserId = ""
+	for _, req := range []proto.Message{
+		create,
+		&pb.CloseIssueRequest{IssueId: "issue-1", OrderId: "order-1"},
+		&pb.SelectOdrRequest{IssueId: "issue-1", OdrId: "odr-1"},
+		&pb.WatchUserIssuesRequest{},
+		&igmv2.AcceptResolutionRequest{IssueId: "issue-1"},
+	} {
+		_, err := invokeUnary(t, req)
+		if err != nil {
+			assert.NotContains(t, fieldViolations(t, err), "user_id", "%T", req)
+		}
+	}
+
+	_, err = invokeUnary(t, &pb.ListIssueRequest{UserId: "42", SortBy: "rating"})
+	fields := fieldViolations(t, err)
+	assert.Contains(t, fields, "user_id")
+	assert.Contains(t, fields, "sort_by")
+}
+
+type recvStream struct {
+	grpc.ServerStream
+	msg proto.Message
+}
+
+func (s *recvStream) Context() context.Context { return context.Background() }
+
+func (s *recvStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func TestStreamValidationInterceptor(t *testing.T) {
+	interceptor := StreamValidationInterceptor(newTestValidator(t))
+	info := &grpc.StreamServerInfo{FullMethod: "/igm.v1.IssueService/WatchIssue"}
+	handler := func(srv any, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&pb.WatchIssueRequest{})
+	}
+
+	err := interceptor(nil, &recvStream{msg: &pb.WatchIssueRequest{UserId: uuid.NewString()}}, info, handler)
+	assert.Contains(t, fieldViolations(t, err), "issue_id")
+
+	err = interceptor(nil, &recvStream{msg: &pb.WatchIssueRequest{UserId: uuid.NewString(), IssueId: "issue-1"}}, info, handler)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,298 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/storage"
+	"image"
+	_ "image/jpeg" // image.DecodeConfig formats for attachments
+	_ "image/png"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// attachmentTypes are the accepted content types and the extension their
+// storage keys get.
+var attachmentTypes = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"application/pdf": ".pdf",
+}
+
+// maxIssueImages caps image_urls and attachment_ids together, matching the
+// image_urls limit in issue.proto.
+const maxIssueImages = 10
+
+type AttachmentConfig struct {
+	MaxBytes          int64
+	MaxImageDimension int           // max width and height in pixels
+	URLTTL            time.Duration // lifetime of signed URLs
+}
+
+// AttachmentService stores uploaded files and turns attachment ids into the
+// signed, expiring URLs sent to BPPs in issue payloads. URLs are signed when
+// a payload or response is built, never stored.
+type AttachmentService struct {
+	attachmentRepo repository.AttachmentRepository
+	store          storage.Store
+	config         AttachmentConfig
+}
+
+func NewAttachmentService(attachmentRepo repository.AttachmentRepository, store storage.Store, config AttachmentConfig) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepo: attachmentRepo,
+		store:          store,
+		config:         config,
+	}
+}
+
+// UploadAttachment reads the file from body, checks that its bytes match the
+// declared size and content type and, for images, that the dimensions are
+// within limits, then stores it. The file is buffered in memory, which
+// MaxBytes bounds.
+func (s *AttachmentService) UploadAttachment(ctx context.Context, meta *pb.AttachmentMetadata, body io.Reader) (*pb.Attachment, error) {
+	if meta == nil {
+		return nil, missingField("metadata")
+	}
+	userID, err := auth.ResolveUserID(ctx, meta.UserId)
+	if err != nil {
+		return nil, err
+	}
+	contentType, ext, err := attachmentType(meta.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	if meta.SizeBytes > s.config.MaxBytes {
+		return nil, invalidField("metadata.size_bytes", "attachment is larger than %d bytes", s.config.MaxBytes)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, meta.SizeBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive attachment: %w", err)
+	}
+	if int64(len(data)) != meta.SizeBytes {
+		return nil, invalidField("chunk", "received more or fewer than the declared %d bytes", meta.SizeBytes)
+	}
+	if sniffed, _, _ := strings.Cut(http.DetectContentType(data), ";"); sniffed != contentType {
+		return nil, invalidField("metadata.content_type", "file content is %s, not %s", sniffed, contentType)
+	}
+
+	sum := sha256.Sum256(data)
+	attachment := &models.Attachment{
+		ID:          uuid.New(),
+		UserID:      userID,
+		FileName:    meta.FileName,
+		ContentType: contentType,
+		SizeBytes:   meta.SizeBytes,
+		SHA256:      hex.EncodeToString(sum[:]),
+	}
+	if strings.HasPrefix(contentType, "image/") {
+		cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, invalidField("chunk", "file is not a readable %s image", contentType)
+		}
+		if cfg.Width > s.config.MaxImageDimension || cfg.Height > s.config.MaxImageDimension {
+			return nil, invalidField("chunk", "image is %dx%d, at most %dx%d pixels are allowed",
+				cfg.Width, cfg.Height, s.config.MaxImageDimension, s.config.MaxImageDimension)
+		}
+		attachment.Width, attachment.Height = cfg.Width, cfg.Height
+	}
+	attachment.StorageKey = userID.String() + "/" + attachment.ID.String() + ext
+
+	if err := s.store.Put(ctx, attachment.StorageKey, bytes.NewReader(data), attachment.SizeBytes, contentType); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		if derr := s.store.Delete(ctx, attachment.StorageKey); derr != nil {
+			log.Printf("[Service] failed to remove orphaned attachment %s: %v", attachment.StorageKey, derr)
+		}
+		return nil, err
+	}
+	log.Printf("[Service] attachment %s stored for user %s (%s, %d bytes)", attachment.ID, userID, contentType, attachment.SizeBytes)
+	return s.toProto(ctx, attachment)
+}
+
+func (s *AttachmentService) GetAttachment(ctx context.Context, req *pb.GetAttachmentRequest) (*pb.Attachment, error) {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	attachment, err := s.getOwned(ctx, userID, req.AttachmentId)
+	if err != nil {
+		return nil, err
+	}
+	return s.toProto(ctx, attachment)
+}
+
+// CheckIssueAttachments checks that the image attachments in attachmentIDs
+// and desc.attachment_id belong to userID and returns their ids, filling
+// desc.content_type from the attachment. Issues store the ids; ImageURLs and
+// DescriptionURL sign them each time a URL is needed, since signed URLs
+// expire.
+func (s *AttachmentService) CheckIssueAttachments(ctx context.Context, userID uuid.UUID, attachmentIDs, imageURLs []string, desc *pb.AdditionalDescription) ([]uuid.UUID, *uuid.UUID, error) {
+	if len(attachmentIDs) == 0 && (desc == nil || desc.AttachmentId == "") {
+		return nil, nil, nil
+	}
+	if len(imageURLs)+len(attachmentIDs) > maxIssueImages {
+		return nil, nil, invalidField("attachment_ids", "at most %d images are allowed including image_urls", maxIssueImages)
+	}
+
+	ids := make([]uuid.UUID, 0, len(attachmentIDs))
+	for _, raw := range attachmentIDs {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, nil, invalidField("attachment_ids", "invalid attachment id %q", raw)
+		}
+		ids = append(ids, id)
+	}
+	found, err := s.attachmentRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Attachment, len(found))
+	for _, a := range found {
+		if a.UserID == userID {
+			byID[a.ID] = a
+		}
+	}
+	for _, id := range ids {
+		a, ok := byID[id]
+		if !ok {
+			return nil, nil, invalidField("attachment_ids", "attachment %s not found", id)
+		}
+		if !strings.HasPrefix(a.ContentType, "image/") {
+			return nil, nil, invalidField("attachment_ids", "attachment %s is not an image", id)
+		}
+	}
+
+	var descID *uuid.UUID
+	if desc != nil && desc.AttachmentId != "" {
+		if desc.Url != "" {
+			return nil, nil, invalidField("additional_desc.attachment_id", "set either additional_desc.url or additional_desc.attachment_id")
+		}
+		a, err := s.getOwned(ctx, userID, desc.AttachmentId)
+		if err != nil {
+			return nil, nil, invalidField("additional_desc.attachment_id", "attachment %s not found", desc.AttachmentId)
+		}
+		descID = &a.ID
+		desc.ContentType = a.ContentType
+	}
+	return ids, descID, nil
+}
+
+// ImageURLs returns the stored image URLs followed by freshly signed URLs for
+// the attachments in attachmentIDs, both JSON arrays as kept on issues and
+// info messages.
+func (s *AttachmentService) ImageURLs(ctx context.Context, images, attachmentIDs datatypes.JSON) ([]string, error) {
+	var urls []string
+	if len(images) > 0 {
+		if err := json.Unmarshal(images, &urls); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal images: %w", err)
+		}
+	}
+	var ids []uuid.UUID
+	if len(attachmentIDs) > 0 {
+		if err := json.Unmarshal(attachmentIDs, &ids); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal attachment ids: %w", err)
+		}
+	}
+	if len(ids) == 0 {
+		return urls, nil
+	}
+	if s == nil {
+		return nil, fmt.Errorf("attachments are not configured")
+	}
+
+	found, err := s.attachmentRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*models.Attachment, len(found))
+	for _, a := range found {
+		byID[a.ID] = a
+	}
+	for _, id := range ids {
+		a, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("attachment %s: %w", id, repository.ErrAttachmentNotFound)
+		}
+		signed, err := s.store.SignedURL(ctx, a.StorageKey, s.config.URLTTL)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, signed)
+	}
+	return urls, nil
+}
+
+// DescriptionURL returns url, or a freshly signed URL when the additional
+// description is the attachment attachmentID.
+func (s *AttachmentService) DescriptionURL(ctx context.Context, url string, attachmentID *uuid.UUID) (string, error) {
+	if attachmentID == nil {
+		return url, nil
+	}
+	if s == nil {
+		return "", fmt.Errorf("attachments are not configured")
+	}
+	a, err := s.attachmentRepo.Get(ctx, *attachmentID)
+	if err != nil {
+		return "", err
+	}
+	return s.store.SignedURL(ctx, a.StorageKey, s.config.URLTTL)
+}
+
+// getOwned reports other users' attachments as not found.
+func (s *AttachmentService) getOwned(ctx context.Context, userID uuid.UUID, rawID string) (*models.Attachment, error) {
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, invalidField("attachment_id", "invalid attachment id")
+	}
+	attachment, err := s.attachmentRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.UserID != userID {
+		return nil, repository.ErrAttachmentNotFound
+	}
+	return attachment, nil
+}
+
+func (s *AttachmentService) toProto(ctx context.Context, attachment *models.Attachment) (*pb.Attachment, error) {
+	expiresAt := time.Now().Add(s.config.URLTTL)
+	signed, err := s.store.SignedURL(ctx, attachment.StorageKey, s.config.URLTTL)
+	if err != nil {
+		return nil, err
+	}
+	return mapper.ToProtoAttachment(attachment, signed, expiresAt), nil
+}
+
+// attachmentType normalises a declared content type and returns the storage
+// key extension for it.
+func attachmentType(declared string) (string, string, error) {
+	contentType, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		return "", "", invalidField("metadata.content_type", "invalid content type %q", declared)
+	}
+	ext, ok := attachmentTypes[contentType]
+	if !ok {
+		return "", "", invalidField("metadata.content_type", "content type %s is not allowed, use image/jpeg, image/png or application/pdf", contentType)
+	}
+	return contentType, ext, nil
+}
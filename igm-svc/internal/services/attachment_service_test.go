@@ -0,0 +1,198 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/storage"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+type fakeAttachmentRepo struct {
+	repository.AttachmentRepository
+	byID map[uuid.UUID]*models.Attachment
+}
+
+func (f *fakeAttachmentRepo) Create(ctx context.Context, a *models.Attachment) error {
+	f.byID[a.ID] = a
+	return nil
+}
+
+func (f *fakeAttachmentRepo) Get(ctx context.Context, id uuid.UUID) (*models.Attachment, error) {
+	a, ok := f.byID[id]
+	if !ok {
+		return nil, repository.ErrAttachmentNotFound
+	}
+	return a, nil
+}
+
+func (f *fakeAttachmentRepo) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Attachment, error) {
+	var out []*models.Attachment
+	for _, id := range ids {
+		if a, ok := f.byID[id]; ok {
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+func newTestAttachmentService(t *testing.T) *AttachmentService {
+	store, err := storage.NewLocalStore(t.TempDir(), "https://igm.example", "test-key")
+	require.NoError(t, err)
+	return NewAttachmentService(&fakeAttachmentRepo{byID: map[uuid.UUID]*models.Attachment{}}, store, AttachmentConfig{
+		MaxBytes:          1 << 20,
+		MaxImageDimension: 100,
+		URLTTL:            time.Hour,
+	})
+}
+
+func pngBytes(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func upload(s *AttachmentService, ctx context.Context, contentType string, data []byte) (*pb.Attachment, error) {
+	return s.UploadAttachment(ctx, &pb.AttachmentMetadata{
+		FileName:    "file",
+		ContentType: contentType,
+		SizeBytes:   int64(len(data)),
+	}, bytes.NewReader(data))
+}
+
+func TestAttachmentService_Upload(t *testing.T) {
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	s := newTestAttachmentService(t)
+
+	att, err := upload(s, ctx, "image/PNG", pngBytes(t, 40, 30))
+	require.NoError(t, err)
+	assert.Equal(t, userID.String(), att.UserId)
+	assert.Equal(t, "image/png", att.ContentType)
+	assert.EqualValues(t, 40, att.Width)
+	assert.EqualValues(t, 30, att.Height)
+	assert.True(t, strings.HasPrefix(att.Url, "https://igm.example/attachments/"+userID.String()+"/"+att.AttachmentId+".png?"))
+
+	got, err := s.GetAttachment(ctx, &pb.GetAttachmentRequest{AttachmentId: att.AttachmentId})
+	require.NoError(t, err)
+	assert.Equal(t, att.AttachmentId, got.AttachmentId)
+
+	otherCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: uuid.New()})
+	_, err = s.GetAttachment(otherCtx, &pb.GetAttachmentRequest{AttachmentId: att.AttachmentId})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestAttachmentService_UploadValidation(t *testing.T) {
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: uuid.New()})
+	s := newTestAttachmentService(t)
+	pdf := []byte("%PDF-1.4\n%âãÏÓ\n1 0 obj\n<<>>\nendobj\n")
+
+	tests := []struct {
+		name        string
+		contentType string
+		data        []byte
+		field       string
+	}{
+		{"type not allowed", "image/gif", []byte("GIF89a"), "metadata.content_type"},
+		{"content does not match type", "image/png", pdf, "metadata.content_type"},
+		{"image too large", "image/png", pngBytes(t, 101, 10), "chunk"},
+		{"corrupt image", "image/png", append([]byte("\x89PNG\r\n\x1a\n"), "broken"...), "chunk"},
+		{"too many bytes", "application/pdf", bytes.Repeat([]byte("%PDF-"), 1<<18), "metadata.size_bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := upload(s, ctx, tt.contentType, tt.data)
+			var verr *ValidationError
+			require.ErrorAs(t, err, &verr)
+			assert.Equal(t, tt.field, verr.Violations[0].Field)
+		})
+	}
+
+	_, err := s.UploadAttachment(ctx, &pb.AttachmentMetadata{ContentType: "application/pdf", SizeBytes: int64(len(pdf)) + 1}, bytes.NewReader(pdf))
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr, "stream shorter than size_bytes")
+	assert.Equal(t, "chunk", verr.Violations[0].Field)
+
+	att, err := upload(s, ctx, "application/pdf", pdf)
+	require.NoError(t, err)
+	assert.Zero(t, att.Width)
+}
+
+func TestAttachmentService_CheckIssueAttachments(t *testing.T) {
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	s := newTestAttachmentService(t)
+	img, err := upload(s, ctx, "image/png", pngBytes(t, 10, 10))
+	require.NoError(t, err)
+	doc, err := upload(s, ctx, "application/pdf", []byte("%PDF-1.4 receipt"))
+	require.NoError(t, err)
+
+	desc := &pb.AdditionalDescription{AttachmentId: doc.AttachmentId}
+	ids, descID, err := s.CheckIssueAttachments(ctx, userID, []string{img.AttachmentId}, []string{"https://cdn.example/a.jpg"}, desc)
+	require.NoError(t, err)
+	assert.Equal(t, []uuid.UUID{uuid.MustParse(img.AttachmentId)}, ids)
+	require.NotNil(t, descID)
+	assert.Equal(t, doc.AttachmentId, descID.String())
+	assert.Empty(t, desc.Url, "urls are signed when sent, not stored")
+	assert.Equal(t, "application/pdf", desc.ContentType)
+
+	_, _, err = s.CheckIssueAttachments(ctx, userID, []string{doc.AttachmentId}, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument, "documents are not images")
+
+	_, _, err = s.CheckIssueAttachments(ctx, uuid.New(), []string{img.AttachmentId}, nil, nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument, "other users' attachments are not found")
+
+	_, _, err = s.CheckIssueAttachments(ctx, userID, []string{img.AttachmentId}, make([]string, maxIssueImages), nil)
+	assert.ErrorIs(t, err, ErrInvalidArgument, "too many images")
+
+	_, _, err = s.CheckIssueAttachments(ctx, userID, nil, nil, &pb.AdditionalDescription{Url: "https://cdn.example/d.pdf", AttachmentId: doc.AttachmentId})
+	assert.ErrorIs(t, err, ErrInvalidArgument, "url and attachment_id together")
+
+	ids, descID, err = s.CheckIssueAttachments(ctx, userID, nil, []string{"https://cdn.example/a.jpg"}, nil)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	assert.Nil(t, descID)
+}
+
+func TestAttachmentService_SignsStoredIDs(t *testing.T) {
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	s := newTestAttachmentService(t)
+	img, err := upload(s, ctx, "image/png", pngBytes(t, 10, 10))
+	require.NoError(t, err)
+	doc, err := upload(s, ctx, "application/pdf", []byte("%PDF-1.4 receipt"))
+	require.NoError(t, err)
+
+	urls, err := s.ImageURLs(ctx, datatypes.JSON(`["https://cdn.example/a.jpg"]`), datatypes.JSON(`["`+img.AttachmentId+`"]`))
+	require.NoError(t, err)
+	require.Len(t, urls, 2)
+	assert.Equal(t, "https://cdn.example/a.jpg", urls[0])
+	assert.Contains(t, urls[1], img.AttachmentId+".png?expires=")
+
+	docID := uuid.MustParse(doc.AttachmentId)
+	url, err := s.DescriptionURL(ctx, "", &docID)
+	require.NoError(t, err)
+	assert.Contains(t, url, doc.AttachmentId+".pdf?expires=")
+
+	url, err = s.DescriptionURL(ctx, "https://cdn.example/d.pdf", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cdn.example/d.pdf", url)
+
+	var none *AttachmentService
+	urls, err = none.ImageURLs(ctx, datatypes.JSON(`["https://cdn.example/a.jpg"]`), nil)
+	require.NoError(t, err, "issues without attachments need no store")
+	assert.Equal(t, []string{"https://cdn.example/a.jpg"}, urls)
+}
@@ -0,0 +1,145 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
+	"log"
+	"time"
+)
+
+type AutoCloseWorkerConfig struct {
+	Interval  time.Duration
+	BatchSize int
+	// Window is how long the complainant has to respond to a RESOLVED action.
+	Window time.Duration
+	// Rating is sent on behalf of the complainant when closing.
+	Rating string
+}
+
+// AutoCloseWorker closes issues the complainant did not respond to within the
+// acceptance window after the respondent marked them RESOLVED.
+type AutoCloseWorker struct {
+	issueRepo  repository.IssueRepository
+	redisRepo  repository.RedisRepository
+	publisher  events.EventPublisher
+	OndcClient *OndcClient
+	config     *Config
+	workerCfg  AutoCloseWorkerConfig
+}
+
+func NewAutoCloseWorker(issueRepo repository.IssueRepository,
+	redisRepo repository.RedisRepository,
+	publisher events.EventPublisher,
+	ondcClient *OndcClient,
+	config *Config,
+	workerCfg AutoCloseWorkerConfig,
+) *AutoCloseWorker {
+	if workerCfg.Interval <= 0 {
+		workerCfg.Interval = 5 * time.Minute
+	}
+	if workerCfg.BatchSize <= 0 {
+		workerCfg.BatchSize = 100
+	}
+	if workerCfg.Window <= 0 {
+		workerCfg.Window = 24 * time.Hour
+	}
+	if workerCfg.Rating == "" {
+		workerCfg.Rating = "THUMBS-UP"
+	}
+	return &AutoCloseWorker{
+		issueRepo:  issueRepo,
+		redisRepo:  redisRepo,
+		publisher:  publisher,
+		OndcClient: ondcClient,
+		config:     config,
+		workerCfg:  workerCfg,
+	}
+}
+
+// Start runs the worker until ctx is cancelled.
+func (w *AutoCloseWorker) Start(ctx context.Context) {
+	log.Printf("[AutoCloseWorker] started interval=%v window=%v", w.workerCfg.Interval, w.workerCfg.Window)
+	ticker := time.NewTicker(w.workerCfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Printf("[AutoCloseWorker] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *AutoCloseWorker) RunOnce(ctx context.Context) {
+	now := time.Now()
+	issues, err := w.issueRepo.FindResolvedBefore(ctx, now.Add(-w.workerCfg.Window), w.workerCfg.BatchSize)
+	if err != nil {
+		log.Printf("[AutoCloseWorker] failed to find resolved issues: %v", err)
+		return
+	}
+	for _, issue := range issues {
+		if err := w.autoClose(ctx, issue, now); err != nil {
+			log.Printf("[AutoCloseWorker] failed to auto-close %s: %v", issue.IssueID, err)
+		}
+	}
+}
+
+// errClosedConcurrently rolls back the close event of an issue that was
+// closed by someone else while the worker was closing it.
+var errClosedConcurrently = errors.New("issue closed concurrently")
+
+func (w *AutoCloseWorker) autoClose(ctx context.Context, issue *models.Issue, now time.Time) error {
+	shortDesc := fmt.Sprintf("auto-closed: no response to resolution within %v", w.workerCfg.Window)
+
+	issue.Status = "CLOSED"
+	issue.Rating = w.workerCfg.Rating
+	issue.AutoClosedAt = &now
+	issue.UpdatedAt = now
+	err := appendComplainantAction(issue, newComplainantAction("CLOSE", shortDesc, w.config.SubcriberID, now))
+	if err != nil {
+		return err
+	}
+
+	// the BPP is told first: if that fails the issue stays RESOLVED here and
+	// the next run retries it
+	if err := w.OndcClient.SendIssue(ctx, issue, "CLOSE"); err != nil {
+		return fmt.Errorf("failed to send close: %w", err)
+	}
+	err = saveWithEvents(ctx, w.publisher, func(ctx context.Context) error {
+		closed, err := w.issueRepo.AutoClose(ctx, issue)
+		if err == nil && !closed {
+			return errClosedConcurrently
+		}
+		return err
+	}, issueClosedEvent(issue, CloseSourceAutoClose))
+	if errors.Is(err, errClosedConcurrently) {
+		log.Printf("[AutoCloseWorker] issue %s was closed while auto-closing it", issue.IssueID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to close issue: %w", err)
+	}
+	log.Printf("[AutoCloseWorker] issue %s %s", issue.IssueID, shortDesc)
+
+	if w.redisRepo != nil {
+		event := map[string]interface{}{
+			"action":         "auto_closed",
+			"issue_id":       issue.IssueID,
+			"transaction_id": issue.TransactionID,
+			"user_id":        issue.UserID.String(),
+			"rating":         issue.Rating,
+			"timestamp":      now.Format(time.RFC3339),
+		}
+		if err := w.redisRepo.SaveIssueResponse(ctx, issue.TransactionID, event); err != nil {
+			log.Printf("warn: failed to push redis event: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// testBPP records the ONDC payloads posted to it and fails them while
+// failing is set. onAccept, when set, runs for every accepted payload, e.g.
+// to store a callback that races the sender.
+type testBPP struct {
+	*httptest.Server
+	mu       sync.Mutex
+	failing  bool
+	payloads []map[string]interface{}
+	onAccept func()
+}
+
+func newTestBPP(t *testing.T) *testBPP {
+	bpp := &testBPP{}
+	bpp.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bpp.mu.Lock()
+		defer bpp.mu.Unlock()
+		if bpp.failing {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		var payload map[string]interface{}
+		_ = json.Unmarshal(body, &payload)
+		payload["path"] = r.URL.Path
+		bpp.payloads = append(bpp.payloads, payload)
+		if bpp.onAccept != nil {
+			bpp.onAccept()
+		}
+	}))
+	t.Cleanup(bpp.Close)
+	return bpp
+}
+
+func (b *testBPP) setOnAccept(fn func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.onAccept = fn
+}
+
+func (b *testBPP) setFailing(failing bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failing = failing
+}
+
+// issues returns message.issue of every accepted payload.
+func (b *testBPP) issues() []map[string]interface{} {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var out []map[string]interface{}
+	for _, p := range b.payloads {
+		out = append(out, p["message"].(map[string]interface{})["issue"].(map[string]interface{}))
+	}
+	return out
+}
+
+type fakeResolvedIssues struct {
+	repository.IssueRepository
+	issues  []*models.Issue
+	updated []*models.Issue
+}
+
+func (f *fakeResolvedIssues) FindResolvedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*models.Issue, error) {
+	var out []*models.Issue
+	for _, issue := range f.issues {
+		if issue.Status != "CLOSED" && issue.ResolvedAt != nil && issue.ResolvedAt.Before(cutoff) {
+			copied := *issue
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeResolvedIssues) AutoClose(ctx context.Context, issue *models.Issue) (bool, error) {
+	for _, stored := range f.issues {
+		if stored.IssueID == issue.IssueID && stored.Status != "CLOSED" {
+			f.updated = append(f.updated, issue)
+			stored.Status = issue.Status
+			stored.Rating = issue.Rating
+			stored.AutoClosedAt = issue.AutoClosedAt
+			stored.ComplainantActions = issue.ComplainantActions
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestAutoCloseWorker_ClosesOnlyAfterBPPAccepts(t *testing.T) {
+	bpp := newTestBPP(t)
+	resolvedAt := time.Now().Add(-48 * time.Hour)
+	repo := &fakeResolvedIssues{issues: []*models.Issue{{
+		IssueID:            "issue-1",
+		UserID:             uuid.New(),
+		BPPURI:             bpp.URL,
+		Status:             "OPEN",
+		RespondentStatus:   "RESOLVED",
+		ResolvedAt:         &resolvedAt,
+		ComplainantActions: datatypes.JSON(`[{"complainant_action":"OPEN","updated_at":"2026-01-01T09:00:00Z"}]`),
+	}}}
+	worker := NewAutoCloseWorker(repo, nil, nil, NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), &Config{SubcriberID: "buyer.example"}, AutoCloseWorkerConfig{Window: 24 * time.Hour})
+
+	bpp.setFailing(true)
+	worker.RunOnce(context.Background())
+	assert.Empty(t, repo.updated, "a failed send leaves the issue open for the next run")
+	assert.Equal(t, "OPEN", repo.issues[0].Status)
+
+	bpp.setFailing(false)
+	worker.RunOnce(context.Background())
+	require.Len(t, repo.updated, 1)
+	assert.Equal(t, "CLOSED", repo.issues[0].Status)
+	assert.NotNil(t, repo.issues[0].AutoClosedAt)
+	sent := bpp.issues()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "CLOSED", sent[0]["status"])
+	assert.Equal(t, "THUMBS-UP", sent[0]["rating"])
+
+	worker.RunOnce(context.Background())
+	assert.Len(t, bpp.issues(), 1, "closed issues are not picked up again")
+}
+
+func TestAutoCloseWorker_KeepsConcurrentClose(t *testing.T) {
+	bpp := newTestBPP(t)
+	resolvedAt := time.Now().Add(-48 * time.Hour)
+	repo := &fakeResolvedIssues{issues: []*models.Issue{{
+		IssueID:          "issue-1",
+		BPPURI:           bpp.URL,
+		Status:           "OPEN",
+		RespondentStatus: "RESOLVED",
+		ResolvedAt:       &resolvedAt,
+	}}}
+	publisher := events.NewMemoryPublisher()
+	worker := NewAutoCloseWorker(repo, nil, publisher, NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), &Config{SubcriberID: "buyer.example"}, AutoCloseWorkerConfig{Window: 24 * time.Hour})
+
+	// the complainant closes the issue while the worker is sending its close
+	bpp.setOnAccept(func() {
+		repo.issues[0].Status = "CLOSED"
+		repo.issues[0].Rating = "THUMBS-DOWN"
+	})
+	worker.RunOnce(context.Background())
+
+	assert.Empty(t, repo.updated)
+	assert.Equal(t, "THUMBS-DOWN", repo.issues[0].Rating, "the complainant's close is kept")
+	assert.Nil(t, repo.issues[0].AutoClosedAt)
+	assert.Empty(t, publisher.Events(), "no close event for a close that did not happen")
+}
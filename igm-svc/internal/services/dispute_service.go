@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"log"
+	"strings"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DisputeService escalates issues to an Online Dispute Resolution provider.
+type DisputeService struct {
+	issueRepo  repository.IssueRepository
+	odrRepo    repository.OdrProviderRepository
+	redisRepo  repository.RedisRepository
+	OndcClient *OndcClient
+	config     *Config
+}
+
+func NewDisputeService(issueRepo repository.IssueRepository,
+	odrRepo repository.OdrProviderRepository,
+	redisRepo repository.RedisRepository,
+	ondcClient *OndcClient,
+	config *Config,
+) *DisputeService {
+	return &DisputeService{
+		issueRepo:  issueRepo,
+		odrRepo:    odrRepo,
+		redisRepo:  redisRepo,
+		OndcClient: ondcClient,
+		config:     config,
+	}
+}
+
+// odrCandidate is a provider the complainant may pick, with whether the
+// respondent proposed it in resolution_provider.selected_odrs.
+type odrCandidate struct {
+	provider *models.OdrProvider
+	proposed bool
+}
+
+func (s *DisputeService) ListOdrProviders(ctx context.Context, req *pb.ListOdrProvidersRequest) (*pb.ListOdrProvidersResponse, error) {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+
+	candidates, err := s.candidates(ctx, issue)
+	if err != nil {
+		return nil, err
+	}
+	providers := make([]*pb.OdrProvider, 0, len(candidates))
+	for _, c := range candidates {
+		providers = append(providers, toProtoOdrProvider(c))
+	}
+	return &pb.ListOdrProvidersResponse{Providers: providers}, nil
+}
+
+// SelectOdr turns the issue into a DISPUTE and forwards it to the chosen ODR.
+func (s *DisputeService) SelectOdr(ctx context.Context, req *pb.SelectOdrRequest) (*pb.SelectOdrResponse, error) {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+	if issue.Status == "CLOSED" {
+		return nil, failedPrecondition("issue %s is already closed", issue.IssueID)
+	}
+	if issue.IssueType == "DISPUTE" {
+		return nil, failedPrecondition("issue %s is already a dispute with %s", issue.IssueID, issue.OdrProviderID)
+	}
+
+	candidates, err := s.candidates(ctx, issue)
+	if err != nil {
+		return nil, err
+	}
+	var selected *odrCandidate
+	for i := range candidates {
+		if candidates[i].provider.ID == req.OdrId {
+			selected = &candidates[i]
+			break
+		}
+	}
+	if selected == nil {
+		return nil, invalidField("odr_id", "odr %s is not available for issue %s", req.OdrId, issue.IssueID)
+	}
+
+	// the selection is on record before any issue data leaves for the ODR
+	if err := s.issueRepo.SaveOdrSelection(ctx, issue.IssueID, selected.provider.ID, selected.provider.URI); err != nil {
+		return nil, fmt.Errorf("failed to save odr selection: %w", err)
+	}
+
+	now := time.Now()
+	issue.IssueType = "DISPUTE"
+	issue.OdrProviderID = selected.provider.ID
+	issue.OdrProviderURI = selected.provider.URI
+	issue.DisputeRaisedAt = &now
+	issue.UpdatedAt = now
+	if err := appendComplainantAction(issue, map[string]interface{}{
+		"complainant_action": "ESCALATE",
+		"short_desc":         req.Reason,
+		"updated_at":         now.Format(time.RFC3339),
+	}); err != nil {
+		return nil, err
+	}
+
+	// the issue becomes a DISPUTE only once the ODR has it, so a failed send
+	// can be retried
+	if err := s.OndcClient.SendDispute(ctx, issue, selected.provider); err != nil {
+		return nil, fmt.Errorf("failed to send dispute to ODR: %w", err)
+	}
+
+	if err := s.issueRepo.MarkDisputed(ctx, issue); err != nil {
+		return nil, fmt.Errorf("failed to update issue:%w", err)
+	}
+
+	if s.redisRepo != nil {
+		event := map[string]interface{}{
+			"action":         "dispute_raised",
+			"issue_id":       issue.IssueID,
+			"transaction_id": issue.TransactionID,
+			"user_id":        issue.UserID.String(),
+			"odr_id":         selected.provider.ID,
+			"odr_uri":        selected.provider.URI,
+			"ondc_sent":      true,
+			"timestamp":      now.Format(time.RFC3339),
+		}
+		if err := s.redisRepo.SaveIssueResponse(ctx, issue.TransactionID, event); err != nil {
+			log.Printf("warn: failed to push redis event: %v", err)
+		}
+	}
+
+	return &pb.SelectOdrResponse{
+		IssueId:     issue.IssueID,
+		IssueType:   issue.IssueType,
+		Odr:         toProtoOdrProvider(*selected),
+		UpdatedAt:   issue.UpdatedAt.Format(time.RFC3339),
+		OndcSent:    true,
+		OndcMessage: "dispute sent to ODR",
+	}, nil
+}
+
+// candidates returns the registry ODRs the respondent proposed in its
+// resolution_provider. Proposals not in the registry are dropped: their URL is
+// under the BPP's control and the dispute carries the complainant's details.
+// When no proposal matches, every registry provider serving the issue's domain
+// and category is offered.
+func (s *DisputeService) candidates(ctx context.Context, issue *models.Issue) ([]odrCandidate, error) {
+	registry, err := s.odrRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load odr providers: %w", err)
+	}
+
+	var out []odrCandidate
+	for _, odr := range proposedOdrs(issue) {
+		provider := registryOdr(registry, odr)
+		if provider == nil {
+			log.Printf("[DisputeService] ignoring odr %q proposed for %s: not in the registry", odr.GetUrl(), issue.IssueID)
+			continue
+		}
+		out = append(out, odrCandidate{provider: provider, proposed: true})
+	}
+	if len(out) > 0 {
+		return out, nil
+	}
+
+	for _, p := range registry {
+		if len(p.Domains) > 0 && !Contains(p.Domains, s.config.Domain) {
+			continue
+		}
+		if len(p.Categories) > 0 && !Contains(p.Categories, issue.Category) {
+			continue
+		}
+		out = append(out, odrCandidate{provider: p})
+	}
+	return out, nil
+}
+
+func proposedOdrs(issue *models.Issue) []*pb.SelectedOdr {
+	if len(issue.ResolutionProvider) == 0 {
+		return nil
+	}
+	var rp pb.ResolutionProvider
+	if err := protojson.Unmarshal(issue.ResolutionProvider, &rp); err != nil {
+		log.Printf("[DisputeService] failed to parse resolution_provider for %s: %v", issue.IssueID, err)
+		return nil
+	}
+	return rp.GetRespondentInfo().GetResolutionSupport().GetSelectedOdrs()
+}
+
+func registryOdr(registry []*models.OdrProvider, odr *pb.SelectedOdr) *models.OdrProvider {
+	for _, p := range registry {
+		if sameURI(p.URI, odr.GetUrl()) || (p.Name != "" && strings.EqualFold(p.Name, odr.GetName())) {
+			return p
+		}
+	}
+	return nil
+}
+
+func sameURI(a, b string) bool {
+	return a != "" && strings.TrimRight(a, "/") == strings.TrimRight(b, "/")
+}
+
+func toProtoOdrProvider(c odrCandidate) *pb.OdrProvider {
+	return &pb.OdrProvider{
+		Id:                   c.provider.ID,
+		Name:                 c.provider.Name,
+		ShortDesc:            c.provider.ShortDesc,
+		Uri:                  c.provider.URI,
+		PricingModel:         c.provider.PricingModel,
+		ProposedByRespondent: c.proposed,
+	}
+}
@@ -0,0 +1,207 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"testing"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+type fakeSingleIssue struct {
+	repository.IssueRepository
+	issue   *models.Issue
+	updated int
+}
+
+func (f *fakeSingleIssue) GetIssueExistByIssueID(issueID string, userID uuid.UUID) (*models.Issue, error) {
+	if f.issue.IssueID != issueID || f.issue.UserID != userID {
+		return nil, repository.ErrIssueNotFound
+	}
+	copied := *f.issue
+	return &copied, nil
+}
+
+func (f *fakeSingleIssue) Update(ctx context.Context, issue *models.Issue) error {
+	f.updated++
+	f.issue = issue
+	return nil
+}
+
+func (f *fakeSingleIssue) SaveOdrSelection(ctx context.Context, issueID, odrID, odrURI string) error {
+	f.issue.OdrProviderID = odrID
+	f.issue.OdrProviderURI = odrURI
+	return nil
+}
+
+func (f *fakeSingleIssue) MarkDisputed(ctx context.Context, issue *models.Issue) error {
+	f.updated++
+	f.issue.IssueType = issue.IssueType
+	f.issue.OdrProviderID = issue.OdrProviderID
+	f.issue.OdrProviderURI = issue.OdrProviderURI
+	f.issue.DisputeRaisedAt = issue.DisputeRaisedAt
+	f.issue.ComplainantActions = issue.ComplainantActions
+	return nil
+}
+
+func (f *fakeSingleIssue) SaveProvidedInfo(ctx context.Context, issue *models.Issue) error {
+	f.updated++
+	f.issue.DescriptionLong = issue.DescriptionLong
+	f.issue.DescriptionURL = issue.DescriptionURL
+	f.issue.DescriptionAttachmentID = issue.DescriptionAttachmentID
+	f.issue.DescriptionContentType = issue.DescriptionContentType
+	f.issue.Images = issue.Images
+	f.issue.AttachmentIDs = issue.AttachmentIDs
+	f.issue.ComplainantActions = issue.ComplainantActions
+	return nil
+}
+
+type fakeOdrRegistry struct {
+	repository.OdrProviderRepository
+	providers []*models.OdrProvider
+}
+
+func (f *fakeOdrRegistry) List(ctx context.Context) ([]*models.OdrProvider, error) {
+	return f.providers, nil
+}
+
+func newTestDisputeService(issue *models.Issue, providers ...*models.OdrProvider) (*DisputeService, *fakeSingleIssue) {
+	repo := &fakeSingleIssue{issue: issue}
+	svc := NewDisputeService(repo, &fakeOdrRegistry{providers: providers}, nil,
+		NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil),
+		&Config{SubcriberID: "buyer.example", Domain: "ONDC:RET10"})
+	return svc, repo
+}
+
+func disputeCtx(userID uuid.UUID) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+}
+
+func TestDisputeService_Candidates(t *testing.T) {
+	grocery := &models.OdrProvider{ID: "odr-grocery", URI: "https://grocery.odr.example", Domains: []string{"ONDC:RET10"}}
+	fashion := &models.OdrProvider{ID: "odr-fashion", URI: "https://fashion.odr.example", Domains: []string{"ONDC:RET12"}}
+	fulfillment := &models.OdrProvider{ID: "odr-fulfillment", URI: "https://fulfillment.odr.example", Categories: []string{"FULFILLMENT"}}
+	anything := &models.OdrProvider{ID: "odr-any", Name: "Any ODR", URI: "https://any.odr.example/"}
+
+	t.Run("registry providers serving the domain and category", func(t *testing.T) {
+		svc, _ := newTestDisputeService(nil, grocery, fashion, fulfillment, anything)
+		got, err := svc.candidates(context.Background(), &models.Issue{Category: "ITEM"})
+		require.NoError(t, err)
+		var ids []string
+		for _, c := range got {
+			ids = append(ids, c.provider.ID)
+			assert.False(t, c.proposed)
+		}
+		assert.Equal(t, []string{"odr-grocery", "odr-any"}, ids)
+	})
+
+	t.Run("respondent proposals replace the registry", func(t *testing.T) {
+		svc, _ := newTestDisputeService(nil, grocery, anything)
+		issue := &models.Issue{Category: "ITEM", ResolutionProvider: datatypes.JSON(`{"respondentInfo":{"resolutionSupport":{"selectedOdrs":[
+			{"name":"Any ODR","url":"https://any.odr.example"},
+			{"name":"Unlisted","url":"https://unlisted.odr.example","pricingModel":{"price":{"currency":"INR","value":"100"},"pricingInfo":"flat"}}]}}}`)}
+		got, err := svc.candidates(context.Background(), issue)
+		require.NoError(t, err)
+		require.Len(t, got, 1, "proposals outside the registry are dropped")
+		assert.Same(t, anything, got[0].provider, "proposals are matched to the registry by uri")
+		assert.True(t, got[0].proposed)
+	})
+
+	t.Run("a proposal's url never replaces the registry uri", func(t *testing.T) {
+		svc, _ := newTestDisputeService(nil, grocery, anything)
+		issue := &models.Issue{Category: "ITEM", ResolutionProvider: datatypes.JSON(`{"respondentInfo":{"resolutionSupport":{"selectedOdrs":[
+			{"name":"any odr","url":"https://attacker.example/collect"}]}}}`)}
+		got, err := svc.candidates(context.Background(), issue)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "https://any.odr.example/", got[0].provider.URI)
+	})
+
+	t.Run("unlisted proposals fall back to the registry", func(t *testing.T) {
+		svc, _ := newTestDisputeService(nil, grocery, fashion)
+		issue := &models.Issue{Category: "ITEM", ResolutionProvider: datatypes.JSON(`{"respondentInfo":{"resolutionSupport":{"selectedOdrs":[
+			{"name":"Unlisted","url":"https://unlisted.odr.example"}]}}}`)}
+		got, err := svc.candidates(context.Background(), issue)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Same(t, grocery, got[0].provider)
+		assert.False(t, got[0].proposed)
+	})
+}
+
+func TestDisputeService_SelectOdr(t *testing.T) {
+	odr := newTestBPP(t)
+	userID := uuid.New()
+	issue := &models.Issue{
+		IssueID:            "issue-1",
+		UserID:             userID,
+		TransactionID:      "txn-1",
+		BPPID:              "seller.example",
+		BPPURI:             "https://seller.example",
+		Category:           "ITEM",
+		Status:             "OPEN",
+		IssueType:          "ISSUE",
+		DescriptionShort:   "damaged item",
+		ComplainantActions: datatypes.JSON(`[{"complainant_action":"OPEN","updated_at":"2026-01-01T09:00:00Z"},{"complainant_action":"SLA_BREACH","internal":true}]`),
+		RespondentActions:  datatypes.JSON(`{"respondentActions":[{"respondentAction":"PROCESSING","shortDesc":"looking into it"}]}`),
+		Resolution:         datatypes.JSON(`{"shortDesc":"refund rejected","actionTriggered":"NO-ACTION"}`),
+	}
+	svc, repo := newTestDisputeService(issue, &models.OdrProvider{ID: "odr-1", Name: "Test ODR", URI: odr.URL})
+	req := &pb.SelectOdrRequest{IssueId: "issue-1", OdrId: "odr-1", Reason: "seller refused refund"}
+
+	odr.setFailing(true)
+	_, err := svc.SelectOdr(disputeCtx(userID), req)
+	require.Error(t, err)
+	assert.Equal(t, "odr-1", repo.issue.OdrProviderID, "the selection is saved before sending")
+	assert.Zero(t, repo.updated, "a failed send does not raise the dispute")
+	assert.Equal(t, "ISSUE", repo.issue.IssueType)
+
+	odr.setFailing(false)
+	resp, err := svc.SelectOdr(disputeCtx(userID), req)
+	require.NoError(t, err, "the dispute can be retried after a failed send")
+	assert.True(t, resp.OndcSent)
+	assert.Equal(t, "DISPUTE", resp.IssueType)
+	assert.Equal(t, 1, repo.updated)
+	assert.Equal(t, "DISPUTE", repo.issue.IssueType)
+	assert.Equal(t, "odr-1", repo.issue.OdrProviderID)
+	assert.NotNil(t, repo.issue.DisputeRaisedAt)
+
+	require.Len(t, odr.payloads, 1)
+	payload := odr.payloads[0]
+	assert.Equal(t, "/issue", payload["path"])
+	wireCtx := payload["context"].(map[string]interface{})
+	assert.Equal(t, "odr-1", wireCtx["bpp_id"])
+	assert.Equal(t, odr.URL, wireCtx["bpp_uri"])
+	sent := odr.issues()[0]
+	assert.Equal(t, "DISPUTE", sent["issue_type"])
+	assert.Equal(t, "ITEM", sent["category"])
+	actions := sent["issue_actions"].(map[string]interface{})
+	complainant := actions["complainant_actions"].([]interface{})
+	require.Len(t, complainant, 2, "internal actions are not sent")
+	assert.Equal(t, "ESCALATE", complainant[1].(map[string]interface{})["complainant_action"])
+	assert.Equal(t, "seller refused refund", complainant[1].(map[string]interface{})["short_desc"])
+	respondent := actions["respondent_actions"].([]interface{})
+	require.Len(t, respondent, 1)
+	assert.Equal(t, "PROCESSING", respondent[0].(map[string]interface{})["respondent_action"])
+	assert.Equal(t, "NO-ACTION", sent["resolution"].(map[string]interface{})["action_triggered"])
+
+	_, err = svc.SelectOdr(disputeCtx(userID), req)
+	assert.ErrorIs(t, err, ErrFailedPrecondition, "a delivered dispute is not raised twice")
+}
+
+func TestDisputeService_SelectOdrRejectsUnknownOdr(t *testing.T) {
+	userID := uuid.New()
+	issue := &models.Issue{IssueID: "issue-1", UserID: userID, Category: "ITEM", Status: "OPEN", IssueType: "ISSUE"}
+	svc, repo := newTestDisputeService(issue, &models.OdrProvider{ID: "odr-1", URI: "https://odr.example", Domains: []string{"ONDC:RET12"}})
+
+	_, err := svc.SelectOdr(disputeCtx(userID), &pb.SelectOdrRequest{IssueId: "issue-1", OdrId: "odr-1"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	assert.Zero(t, repo.updated)
+}
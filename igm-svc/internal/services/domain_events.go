@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
+	"log"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+	pb "igm-svc/api/proto/igm/v1"
+
+	"gorm.io/datatypes"
+)
+
+const (
+	EscalationSourceComplainant        = "COMPLAINANT"
+	EscalationSourceResolutionRejected = "RESOLUTION_REJECTED"
+	EscalationSourceSLABreach          = "SLA_BREACH"
+
+	CloseSourceComplainant        = "COMPLAINANT"
+	CloseSourceResolutionAccepted = "RESOLUTION_ACCEPTED"
+	CloseSourceAutoClose          = "AUTO_CLOSE"
+)
+
+// OutboxPublisher is the EventPublisher the services use. It only stores the
+// event; OutboxRelay hands it to the configured backend, retrying until the
+// backend accepts it.
+type OutboxPublisher struct {
+	outbox repository.OutboxRepository
+	tx     repository.Transactor
+}
+
+func NewOutboxPublisher(outbox repository.OutboxRepository, tx repository.Transactor) *OutboxPublisher {
+	return &OutboxPublisher{outbox: outbox, tx: tx}
+}
+
+// InTx runs fn in a transaction that events published with its context join.
+func (p *OutboxPublisher) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if p.tx == nil {
+		return fn(ctx)
+	}
+	return p.tx.InTx(ctx, fn)
+}
+
+func (p *OutboxPublisher) Publish(ctx context.Context, event *eventsv1.DomainEvent) error {
+	if err := events.Prepare(event); err != nil {
+		return err
+	}
+	data, err := events.Encode(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	return p.outbox.Enqueue(ctx, &models.DomainEventOutbox{
+		EventID:   event.EventId,
+		EventType: event.EventType,
+		IssueID:   event.IssueId,
+		Payload:   datatypes.JSON(data),
+	})
+}
+
+func (p *OutboxPublisher) Close() error {
+	return nil
+}
+
+type OutboxRelayConfig struct {
+	Interval  time.Duration
+	BatchSize int
+	// MaxBackoff caps the delay between attempts for an event the backend
+	// keeps rejecting.
+	MaxBackoff time.Duration
+}
+
+// OutboxRelay publishes stored domain events oldest first. An event the
+// backend rejects is retried after a backoff while newer events go out, so
+// consumers must not rely on the order. An event is marked published only
+// after the backend accepts it, so a crash in between redelivers it with the
+// same event_id.
+type OutboxRelay struct {
+	outbox    repository.OutboxRepository
+	publisher events.EventPublisher
+	relayCfg  OutboxRelayConfig
+}
+
+func NewOutboxRelay(outbox repository.OutboxRepository,
+	publisher events.EventPublisher,
+	relayCfg OutboxRelayConfig,
+) *OutboxRelay {
+	if relayCfg.Interval <= 0 {
+		relayCfg.Interval = time.Second
+	}
+	if relayCfg.BatchSize <= 0 {
+		relayCfg.BatchSize = 100
+	}
+	if relayCfg.MaxBackoff <= 0 {
+		relayCfg.MaxBackoff = 5 * time.Minute
+	}
+	return &OutboxRelay{
+		outbox:    outbox,
+		publisher: publisher,
+		relayCfg:  relayCfg,
+	}
+}
+
+// Start runs the relay until ctx is cancelled.
+func (r *OutboxRelay) Start(ctx context.Context) {
+	log.Printf("[OutboxRelay] started interval=%v", r.relayCfg.Interval)
+	ticker := time.NewTicker(r.relayCfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		r.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Printf("[OutboxRelay] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *OutboxRelay) RunOnce(ctx context.Context) {
+	now := time.Now()
+	rows, err := r.outbox.FetchPending(ctx, now, r.relayCfg.BatchSize)
+	if err != nil {
+		log.Printf("[OutboxRelay] failed to fetch pending events: %v", err)
+		return
+	}
+	for _, row := range rows {
+		if err := r.relay(ctx, row); err != nil {
+			log.Printf("[OutboxRelay] failed to publish %s: %v", row.EventID, err)
+			next := now.Add(retryBackoff(r.relayCfg.Interval, r.relayCfg.MaxBackoff, row.Attempts+1))
+			if err := r.outbox.MarkFailed(ctx, row.ID, err.Error(), next); err != nil {
+				log.Printf("[OutboxRelay] %v", err)
+			}
+			continue
+		}
+		if err := r.outbox.MarkPublished(ctx, row.ID, time.Now()); err != nil {
+			log.Printf("[OutboxRelay] %v", err)
+		}
+	}
+}
+
+func (r *OutboxRelay) relay(ctx context.Context, row *models.DomainEventOutbox) error {
+	event, err := events.Decode(row.Payload)
+	if err != nil {
+		return err
+	}
+	return r.publisher.Publish(ctx, event)
+}
+
+// retryBackoff is the delay before the next attempt after attempts failures:
+// base doubled per failure, capped at max. The outbox relay and the webhook
+// dispatcher share it.
+func retryBackoff(base, max time.Duration, attempts int) time.Duration {
+	d := base
+	for i := 1; i < attempts && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+func publishDomainEvents(ctx context.Context, publisher events.EventPublisher, evs ...*eventsv1.DomainEvent) error {
+	if publisher == nil {
+		return nil
+	}
+	for _, event := range evs {
+		if err := publisher.Publish(ctx, event); err != nil {
+			return fmt.Errorf("failed to publish domain event %s: %w", event.GetEventType(), err)
+		}
+	}
+	return nil
+}
+
+// saveWithEvents stores an issue change through save together with its
+// domain events. With an OutboxPublisher both happen in one transaction, so
+// the change is never kept without its events or the other way round.
+func saveWithEvents(ctx context.Context, publisher events.EventPublisher, save func(ctx context.Context) error, evs ...*eventsv1.DomainEvent) error {
+	store := func(ctx context.Context) error {
+		if err := save(ctx); err != nil {
+			return err
+		}
+		return publishDomainEvents(ctx, publisher, evs...)
+	}
+	if tp, ok := publisher.(interface {
+		InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	}); ok {
+		return tp.InTx(ctx, store)
+	}
+	return store(ctx)
+}
+
+func newIssueEvent(issue *models.Issue) *eventsv1.DomainEvent {
+	event := events.New(issue.IssueID, issue.TransactionID)
+	event.OrderId = issue.OrderID
+	event.UserId = issue.UserID.String()
+	return event
+}
+
+func issueCreatedEvent(issue *models.Issue) *eventsv1.DomainEvent {
+	event := newIssueEvent(issue)
+	event.Payload = &eventsv1.DomainEvent_IssueCreated{IssueCreated: &eventsv1.IssueCreated{
+		Category:    issue.Category,
+		SubCategory: issue.SubCategory,
+		IssueType:   issue.IssueType,
+		BppId:       issue.BPPID,
+		RespondBy:   formatOptionalTime(issue.RespondBy),
+		ResolveBy:   formatOptionalTime(issue.ResolveBy),
+	}}
+	return event
+}
+
+func issueUpdatedEvent(issue *models.Issue) *eventsv1.DomainEvent {
+	event := newIssueEvent(issue)
+	event.Payload = &eventsv1.DomainEvent_IssueUpdated{IssueUpdated: &eventsv1.IssueUpdated{
+		Status:    issue.Status,
+		IssueType: issue.IssueType,
+	}}
+	return event
+}
+
+func issueEscalatedEvent(issue *models.Issue, fromIssueType, reason, source string) *eventsv1.DomainEvent {
+	event := newIssueEvent(issue)
+	event.Payload = &eventsv1.DomainEvent_IssueEscalated{IssueEscalated: &eventsv1.IssueEscalated{
+		FromIssueType: fromIssueType,
+		IssueType:     issue.IssueType,
+		Reason:        reason,
+		Source:        source,
+	}}
+	return event
+}
+
+func issueClosedEvent(issue *models.Issue, source string) *eventsv1.DomainEvent {
+	event := newIssueEvent(issue)
+	event.Payload = &eventsv1.DomainEvent_IssueClosed{IssueClosed: &eventsv1.IssueClosed{
+		Rating: issue.Rating,
+		Source: source,
+	}}
+	return event
+}
+
+func issueStatusRequestedEvent(issue *models.Issue) *eventsv1.DomainEvent {
+	event := newIssueEvent(issue)
+	event.Payload = &eventsv1.DomainEvent_IssueStatusRequested{IssueStatusRequested: &eventsv1.IssueStatusRequested{
+		BppId: issue.BPPID,
+	}}
+	return event
+}
+
+func issueSLABreachedEvent(issue *models.Issue, kind string, deadline *time.Time, escalated bool) *eventsv1.DomainEvent {
+	event := newIssueEvent(issue)
+	event.Payload = &eventsv1.DomainEvent_IssueSlaBreached{IssueSlaBreached: &eventsv1.IssueSLABreached{
+		Breach:    kind,
+		Deadline:  formatOptionalTime(deadline),
+		Escalated: escalated,
+	}}
+	return event
+}
+
+// respondentActionEvents builds the events for the latest respondent action
+// of an on_issue / on_issue_status callback. BPPs resend the whole action
+// list on every callback, so the event ids are derived from the action itself
+// and a resent action dedupes downstream.
+func respondentActionEvents(issueID, transactionID string, ia *pb.IssueActions, res *pb.Resolution) []*eventsv1.DomainEvent {
+	actions := ia.GetRespondentActions()
+	if len(actions) == 0 {
+		return nil
+	}
+	last := actions[len(actions)-1]
+	if last.GetRespondentAction() == "" {
+		return nil
+	}
+	key := fmt.Sprintf("%s|%s|%s|%d", issueID, last.GetRespondentAction(), last.GetUpdatedAt(), last.GetCascadedLevel())
+
+	received := events.NewWithKey(issueID, transactionID, events.TypeRespondentActionReceived+"|"+key)
+	received.Payload = &eventsv1.DomainEvent_RespondentActionReceived{RespondentActionReceived: &eventsv1.RespondentActionReceived{
+		RespondentAction: last.GetRespondentAction(),
+		ShortDesc:        last.GetShortDesc(),
+		CascadedLevel:    last.GetCascadedLevel(),
+	}}
+	evs := []*eventsv1.DomainEvent{received}
+
+	if last.GetRespondentAction() == "RESOLVED" {
+		resolved := events.NewWithKey(issueID, transactionID, events.TypeIssueResolved+"|"+key)
+		resolved.Payload = &eventsv1.DomainEvent_IssueResolved{IssueResolved: &eventsv1.IssueResolved{
+			ShortDesc:       res.GetShortDesc(),
+			ActionTriggered: res.GetActionTriggered(),
+			RefundAmount:    res.GetRefundAmount(),
+		}}
+		evs = append(evs, resolved)
+	}
+	return evs
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
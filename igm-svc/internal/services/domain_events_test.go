@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"igm-svc/internal/models"
+	"igm-svc/pkg/events"
+	"testing"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeOutbox struct {
+	rows []*models.DomainEventOutbox
+	err  error
+}
+
+func (f *fakeOutbox) Enqueue(ctx context.Context, row *models.DomainEventOutbox) error {
+	if f.err != nil {
+		return f.err
+	}
+	for _, r := range f.rows {
+		if r.EventID == row.EventID {
+			return nil
+		}
+	}
+	row.ID = uint(len(f.rows) + 1)
+	f.rows = append(f.rows, row)
+	return nil
+}
+
+func (f *fakeOutbox) FetchPending(ctx context.Context, now time.Time, limit int) ([]*models.DomainEventOutbox, error) {
+	var out []*models.DomainEventOutbox
+	for _, r := range f.rows {
+		if r.PublishedAt == nil && !r.NextAttemptAt.After(now) && len(out) < limit {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeOutbox) MarkPublished(ctx context.Context, id uint, at time.Time) error {
+	f.rows[id-1].PublishedAt = &at
+	f.rows[id-1].Attempts++
+	return nil
+}
+
+func (f *fakeOutbox) MarkFailed(ctx context.Context, id uint, cause string, nextAttemptAt time.Time) error {
+	f.rows[id-1].Attempts++
+	f.rows[id-1].LastError = cause
+	f.rows[id-1].NextAttemptAt = nextAttemptAt
+	return nil
+}
+
+type flakyPublisher struct {
+	*events.MemoryPublisher
+	failures int
+}
+
+func (p *flakyPublisher) Publish(ctx context.Context, event *eventsv1.DomainEvent) error {
+	if p.failures > 0 {
+		p.failures--
+		return errors.New("broker unavailable")
+	}
+	return p.MemoryPublisher.Publish(ctx, event)
+}
+
+func TestOutboxRelayRetriesUntilPublished(t *testing.T) {
+	ctx := context.Background()
+	outbox := &fakeOutbox{}
+	backend := &flakyPublisher{MemoryPublisher: events.NewMemoryPublisher(), failures: 1}
+	relay := NewOutboxRelay(outbox, backend, OutboxRelayConfig{Interval: time.Millisecond})
+
+	issue := &models.Issue{IssueID: "issue-1", TransactionID: "txn-1", Rating: "THUMBS-UP"}
+	event := issueClosedEvent(issue, CloseSourceComplainant)
+	require.NoError(t, publishDomainEvents(ctx, NewOutboxPublisher(outbox, nil), event))
+	require.Len(t, outbox.rows, 1)
+
+	relay.RunOnce(ctx)
+	assert.Nil(t, outbox.rows[0].PublishedAt)
+	assert.Equal(t, "broker unavailable", outbox.rows[0].LastError)
+	assert.Empty(t, backend.Events())
+
+	time.Sleep(5 * time.Millisecond)
+	relay.RunOnce(ctx)
+	require.NotNil(t, outbox.rows[0].PublishedAt)
+	require.Len(t, backend.Events(), 1)
+
+	got := backend.Events()[0]
+	assert.Equal(t, event.EventId, got.EventId)
+	assert.Equal(t, events.TypeIssueClosed, got.EventType)
+	assert.Equal(t, CloseSourceComplainant, got.GetIssueClosed().GetSource())
+}
+
+func TestRetryBackoff(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		0: time.Second,
+		1: time.Second,
+		2: 2 * time.Second,
+		3: 4 * time.Second,
+		6: 10 * time.Second,
+	} {
+		assert.Equal(t, want, retryBackoff(time.Second, 10*time.Second, attempts), attempts)
+	}
+}
+
+func TestRespondentActionEvents(t *testing.T) {
+	ia := &pb.IssueActions{RespondentActions: []*pb.RespondentAction{
+		{RespondentAction: "PROCESSING", UpdatedAt: "2025-01-01T10:00:00Z", CascadedLevel: 1},
+		{RespondentAction: "RESOLVED", ShortDesc: "refunded", UpdatedAt: "2025-01-01T12:00:00Z", CascadedLevel: 1},
+	}}
+	res := &pb.Resolution{ShortDesc: "refund", ActionTriggered: "REFUND", RefundAmount: "100"}
+
+	evs := respondentActionEvents("issue-1", "txn-1", ia, res)
+	require.Len(t, evs, 2)
+	assert.Equal(t, "RESOLVED", evs[0].GetRespondentActionReceived().GetRespondentAction())
+	assert.Equal(t, "100", evs[1].GetIssueResolved().GetRefundAmount())
+
+	// a resent callback carries the same ids
+	again := respondentActionEvents("issue-1", "txn-1", ia, res)
+	assert.Equal(t, evs[0].EventId, again[0].EventId)
+	assert.Equal(t, evs[1].EventId, again[1].EventId)
+	assert.NotEqual(t, evs[0].EventId, evs[1].EventId)
+
+	assert.Nil(t, respondentActionEvents("issue-1", "txn-1", nil, nil))
+}
+
+// fakeTransactor rolls back the outbox rows written by a failed unit of work
+// and counts how it ended.
+type fakeTransactor struct {
+	outbox             *fakeOutbox
+	commits, rollbacks int
+}
+
+func (f *fakeTransactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	n := len(f.outbox.rows)
+	if err := fn(ctx); err != nil {
+		f.outbox.rows = f.outbox.rows[:n]
+		f.rollbacks++
+		return err
+	}
+	f.commits++
+	return nil
+}
+
+func TestSaveWithEventsIsAtomic(t *testing.T) {
+	ctx := context.Background()
+	outbox := &fakeOutbox{}
+	tx := &fakeTransactor{outbox: outbox}
+	publisher := NewOutboxPublisher(outbox, tx)
+	issue := &models.Issue{IssueID: "issue-1", TransactionID: "txn-1"}
+
+	saved := false
+	err := saveWithEvents(ctx, publisher, func(ctx context.Context) error {
+		saved = true
+		return nil
+	}, issueUpdatedEvent(issue), issueClosedEvent(issue, CloseSourceComplainant))
+	require.NoError(t, err)
+	assert.True(t, saved)
+	assert.Len(t, outbox.rows, 2)
+	assert.Equal(t, 1, tx.commits)
+
+	err = saveWithEvents(ctx, publisher, func(ctx context.Context) error {
+		return errors.New("db down")
+	}, issueUpdatedEvent(issue))
+	assert.EqualError(t, err, "db down")
+	assert.Len(t, outbox.rows, 2, "no events without the issue change")
+	assert.Equal(t, 1, tx.rollbacks)
+
+	outbox.err = errors.New("outbox full")
+	err = saveWithEvents(ctx, publisher, func(ctx context.Context) error {
+		return nil
+	}, issueUpdatedEvent(issue))
+	assert.ErrorContains(t, err, "outbox full", "a failed enqueue is returned")
+	assert.Equal(t, 2, tx.rollbacks, "and rolls the issue change back")
+}
@@ -0,0 +1,57 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"igm-svc/internal/repository"
+	"strings"
+)
+
+// Sentinel errors returned, possibly wrapped, by the services. The handlers
+// map them onto gRPC status codes; anything else is an internal error.
+var (
+	ErrNotFound           = repository.ErrNotFound
+	ErrAlreadyExists      = repository.ErrAlreadyExists
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrPermissionDenied   = errors.New("permission denied")
+)
+
+// FieldViolation names one invalid request field.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError is an ErrInvalidArgument listing the offending fields.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		msgs = append(msgs, v.Description)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// invalidField reports field as invalid; the description is the whole
+// message shown to the client.
+func invalidField(field, format string, args ...interface{}) error {
+	return &ValidationError{Violations: []FieldViolation{{Field: field, Description: fmt.Sprintf(format, args...)}}}
+}
+
+func missingField(field string) error {
+	return invalidField(field, "missing required field: %s", field)
+}
+
+// failedPrecondition reports a request that is valid but not allowed in the
+// issue's current state.
+func failedPrecondition(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrFailedPrecondition, fmt.Sprintf(format, args...))
+}
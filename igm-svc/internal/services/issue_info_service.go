@@ -0,0 +1,333 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"log"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// IssueInfoService handles the NEED-MORE-INFO / INFO_PROVIDED exchange
+// between the respondent and the complainant.
+type IssueInfoService struct {
+	issueRepo   repository.IssueRepository
+	infoRepo    repository.IssueInfoRepository
+	redisRepo   repository.RedisRepository
+	attachments *AttachmentService
+	OndcClient  *OndcClient
+	tx          repository.Transactor
+	config      *Config
+}
+
+func NewIssueInfoService(issueRepo repository.IssueRepository,
+	infoRepo repository.IssueInfoRepository,
+	redisRepo repository.RedisRepository,
+	attachments *AttachmentService,
+	ondcClient *OndcClient,
+	tx repository.Transactor,
+	config *Config,
+) *IssueInfoService {
+	return &IssueInfoService{
+		issueRepo:   issueRepo,
+		infoRepo:    infoRepo,
+		redisRepo:   redisRepo,
+		attachments: attachments,
+		OndcClient:  ondcClient,
+		tx:          tx,
+		config:      config,
+	}
+}
+
+func (s *IssueInfoService) GetIssueInfoThread(ctx context.Context, req *pb.GetIssueInfoThreadRequest) (*pb.GetIssueInfoThreadResponse, error) {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+
+	thread, err := s.infoRepo.ListByIssueID(ctx, issue.IssueID)
+	if err != nil {
+		return nil, err
+	}
+	messages := make([]*pb.InfoMessage, 0, len(thread))
+	for _, msg := range thread {
+		m, err := s.toProtoInfoMessage(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+	return &pb.GetIssueInfoThreadResponse{
+		IssueId:      issue.IssueID,
+		AwaitingInfo: issue.Status != "CLOSED" && awaitingInfo(thread),
+		Messages:     messages,
+	}, nil
+}
+
+// ProvideIssueInfo answers the respondent's latest NEED-MORE-INFO and sends
+// the updated issue to the BPP. Nothing is stored unless the BPP accepts it,
+// so a failed send leaves the request pending and the complainant can retry.
+func (s *IssueInfoService) ProvideIssueInfo(ctx context.Context, req *pb.ProvideIssueInfoRequest) (*pb.ProvideIssueInfoResponse, error) {
+	err := ValidateProvideIssueInfoRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+	if issue.Status == "CLOSED" {
+		return nil, failedPrecondition("issue %s is already closed", issue.IssueID)
+	}
+	thread, err := s.infoRepo.ListByIssueID(ctx, issue.IssueID)
+	if err != nil {
+		return nil, err
+	}
+	if !awaitingInfo(thread) {
+		return nil, failedPrecondition("issue %s has no pending information request", issue.IssueID)
+	}
+	attachmentIDs, descAttachmentID, err := s.attachments.CheckIssueAttachments(ctx, userID, req.AttachmentIds, req.ImageUrls, req.AdditionalDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	imagesJSON, err := json.Marshal(req.ImageUrls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal images:%w", err)
+	}
+	attachmentIDsJSON, err := appendAttachmentIDs(nil, attachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	msg := &models.IssueInfoMessage{
+		IssueID:                    issue.IssueID,
+		Action:                     models.InfoActionInfoProvided,
+		Actor:                      models.InfoActorComplainant,
+		ShortDesc:                  req.ShortDesc,
+		LongDesc:                   req.LongDesc,
+		Images:                     datatypes.JSON(imagesJSON),
+		AttachmentIDs:              attachmentIDsJSON,
+		AdditionalDescAttachmentID: descAttachmentID,
+		UpdatedBy:                  issue.UserName,
+		ActionAt:                   now,
+		CreatedAt:                  now,
+	}
+	if req.AdditionalDesc != nil {
+		msg.AdditionalDescURL = req.AdditionalDesc.Url
+		msg.AdditionalDescContentType = req.AdditionalDesc.ContentType
+	}
+
+	if err := applyProvidedInfo(issue, req, attachmentIDs, descAttachmentID); err != nil {
+		return nil, err
+	}
+	issue.UpdatedAt = now
+	if err := appendComplainantAction(issue, map[string]interface{}{
+		"complainant_action": models.InfoActionInfoProvided,
+		"short_desc":         req.ShortDesc,
+		"updated_at":         now.Format(time.RFC3339),
+		"updated_by": map[string]interface{}{
+			"org": map[string]interface{}{
+				"name": s.config.SubcriberID,
+			},
+			"contact": map[string]interface{}{
+				"phone": issue.UserPhone,
+				"email": issue.UserEmail,
+			},
+			"person": map[string]interface{}{
+				"name": issue.UserName,
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := s.OndcClient.SendIssue(ctx, issue, models.InfoActionInfoProvided); err != nil {
+		return nil, fmt.Errorf("failed to send info to BPP: %w", err)
+	}
+
+	err = s.inTx(ctx, func(ctx context.Context) error {
+		if err := s.infoRepo.SaveMessage(ctx, msg); err != nil {
+			return err
+		}
+		if err := s.issueRepo.SaveProvidedInfo(ctx, issue); err != nil {
+			return fmt.Errorf("failed to update issue:%w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.redisRepo != nil {
+		event := map[string]interface{}{
+			"action":         "info_provided",
+			"issue_id":       issue.IssueID,
+			"transaction_id": issue.TransactionID,
+			"user_id":        issue.UserID.String(),
+			"ondc_sent":      true,
+			"timestamp":      now.Format(time.RFC3339),
+		}
+		if err := s.redisRepo.SaveIssueResponse(ctx, issue.TransactionID, event); err != nil {
+			log.Printf("warn: failed to push redis event: %v", err)
+		}
+	}
+
+	// the info is sent and stored by now, so a signing failure only costs
+	// the attachment URLs in the response
+	message, err := s.toProtoInfoMessage(ctx, msg)
+	if err != nil {
+		log.Printf("[IssueInfoService] failed to sign attachments for %s: %v", issue.IssueID, err)
+		message = mapper.ToProtoInfoMessage(msg)
+	}
+	return &pb.ProvideIssueInfoResponse{
+		IssueId:     issue.IssueID,
+		Message:     message,
+		UpdatedAt:   issue.UpdatedAt.Format(time.RFC3339),
+		OndcSent:    true,
+		OndcMessage: "information sent to BPP",
+	}, nil
+}
+
+func (s *IssueInfoService) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if s.tx == nil {
+		return fn(ctx)
+	}
+	return s.tx.InTx(ctx, fn)
+}
+
+// applyProvidedInfo folds the complainant's answer into the issue description,
+// which is what the BPP reads on INFO_PROVIDED. Earlier rounds stay in the
+// thread.
+func applyProvidedInfo(issue *models.Issue, req *pb.ProvideIssueInfoRequest, attachmentIDs []uuid.UUID, descAttachmentID *uuid.UUID) error {
+	if req.LongDesc != "" {
+		issue.DescriptionLong = req.LongDesc
+	}
+	if descAttachmentID != nil {
+		issue.DescriptionURL = ""
+		issue.DescriptionAttachmentID = descAttachmentID
+		issue.DescriptionContentType = req.AdditionalDesc.ContentType
+	} else if req.AdditionalDesc != nil && req.AdditionalDesc.Url != "" {
+		issue.DescriptionURL = req.AdditionalDesc.Url
+		issue.DescriptionAttachmentID = nil
+		issue.DescriptionContentType = req.AdditionalDesc.ContentType
+	}
+	if len(attachmentIDs) > 0 {
+		idsJSON, err := appendAttachmentIDs(issue.AttachmentIDs, attachmentIDs)
+		if err != nil {
+			return err
+		}
+		issue.AttachmentIDs = idsJSON
+	}
+	if len(req.ImageUrls) == 0 {
+		return nil
+	}
+	var images []string
+	if len(issue.Images) > 0 {
+		if err := json.Unmarshal(issue.Images, &images); err != nil {
+			return fmt.Errorf("failed to unmarshal images: %w", err)
+		}
+	}
+	images = append(images, req.ImageUrls...)
+	imagesJSON, err := json.Marshal(images)
+	if err != nil {
+		return fmt.Errorf("failed to marshal images:%w", err)
+	}
+	issue.Images = datatypes.JSON(imagesJSON)
+	return nil
+}
+
+// appendAttachmentIDs appends ids to a stored JSON array of attachment ids.
+func appendAttachmentIDs(raw datatypes.JSON, ids []uuid.UUID) (datatypes.JSON, error) {
+	if len(ids) == 0 {
+		return raw, nil
+	}
+	var all []uuid.UUID
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &all); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal attachment ids: %w", err)
+		}
+	}
+	all = append(all, ids...)
+	b, err := json.Marshal(all)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal attachment ids: %w", err)
+	}
+	return datatypes.JSON(b), nil
+}
+
+// toProtoInfoMessage maps msg with its attachments signed for this response.
+func (s *IssueInfoService) toProtoInfoMessage(ctx context.Context, msg *models.IssueInfoMessage) (*pb.InfoMessage, error) {
+	m := mapper.ToProtoInfoMessage(msg)
+	if len(msg.AttachmentIDs) == 0 && msg.AdditionalDescAttachmentID == nil {
+		return m, nil
+	}
+	images, err := s.attachments.ImageURLs(ctx, msg.Images, msg.AttachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	m.ImageUrls = images
+	if msg.AdditionalDescAttachmentID != nil {
+		url, err := s.attachments.DescriptionURL(ctx, msg.AdditionalDescURL, msg.AdditionalDescAttachmentID)
+		if err != nil {
+			return nil, err
+		}
+		m.AdditionalDesc = &pb.AdditionalDescription{Url: url, ContentType: msg.AdditionalDescContentType}
+	}
+	return m, nil
+}
+
+// awaitingInfo reports whether the latest round is an unanswered
+// NEED-MORE-INFO.
+func awaitingInfo(thread []*models.IssueInfoMessage) bool {
+	return len(thread) > 0 && thread[len(thread)-1].Action == models.InfoActionNeedMoreInfo
+}
+
+// recordInfoRequests adds every NEED-MORE-INFO in a callback's respondent
+// actions to the issue's thread. Callbacks replay the full action list, so
+// rounds are keyed on the action's updated_at.
+func recordInfoRequests(ctx context.Context, infoRepo repository.IssueInfoRepository, issueID string, ia *pb.IssueActions) {
+	if infoRepo == nil {
+		return
+	}
+	for _, action := range ia.GetRespondentActions() {
+		if action.GetRespondentAction() != models.InfoActionNeedMoreInfo {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, action.GetUpdatedAt())
+		if err != nil {
+			log.Printf("warn: NEED-MORE-INFO for %s has no usable updated_at %q, not added to thread", issueID, action.GetUpdatedAt())
+			continue
+		}
+		msg := &models.IssueInfoMessage{
+			IssueID:   issueID,
+			Action:    models.InfoActionNeedMoreInfo,
+			Actor:     models.InfoActorRespondent,
+			ShortDesc: action.GetShortDesc(),
+			UpdatedBy: action.GetUpdatedBy().GetOrg().GetName(),
+			ActionAt:  at,
+		}
+		if err := infoRepo.SaveMessage(ctx, msg); err != nil {
+			log.Printf("warn: failed to record info request for %s: %v", issueID, err)
+		}
+	}
+}
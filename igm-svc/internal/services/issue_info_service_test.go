@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"testing"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+type fakeInfoRepo struct {
+	msgs []*models.IssueInfoMessage
+}
+
+func (f *fakeInfoRepo) SaveMessage(ctx context.Context, msg *models.IssueInfoMessage) error {
+	f.msgs = append(f.msgs, msg)
+	return nil
+}
+
+func (f *fakeInfoRepo) ListByIssueID(ctx context.Context, issueID string) ([]*models.IssueInfoMessage, error) {
+	var out []*models.IssueInfoMessage
+	for _, m := range f.msgs {
+		if m.IssueID == issueID {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// fakeInfoTx drops the thread messages saved by a failed unit of work.
+type fakeInfoTx struct {
+	info *fakeInfoRepo
+}
+
+func (f *fakeInfoTx) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	n := len(f.info.msgs)
+	if err := fn(ctx); err != nil {
+		f.info.msgs = f.info.msgs[:n]
+		return err
+	}
+	return nil
+}
+
+type failingIssueUpdate struct {
+	*fakeSingleIssue
+}
+
+func (f failingIssueUpdate) SaveProvidedInfo(ctx context.Context, issue *models.Issue) error {
+	return errors.New("db down")
+}
+
+func awaitingInfoIssue(userID uuid.UUID, bppURI string) (*models.Issue, *fakeInfoRepo) {
+	issue := &models.Issue{
+		IssueID:            "issue-1",
+		UserID:             userID,
+		BPPURI:             bppURI,
+		Status:             "OPEN",
+		IssueType:          "ISSUE",
+		DescriptionLong:    "item arrived damaged",
+		ComplainantActions: datatypes.JSON(`[{"complainant_action":"OPEN","updated_at":"2026-01-01T09:00:00Z"}]`),
+	}
+	info := &fakeInfoRepo{msgs: []*models.IssueInfoMessage{{
+		IssueID:   "issue-1",
+		Action:    models.InfoActionNeedMoreInfo,
+		Actor:     models.InfoActorRespondent,
+		ShortDesc: "photo of the package please",
+		ActionAt:  time.Now().Add(-time.Hour),
+	}}}
+	return issue, info
+}
+
+func TestProvideIssueInfo_StoresOnlyAfterBPPAccepts(t *testing.T) {
+	bpp := newTestBPP(t)
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	issue, info := awaitingInfoIssue(userID, bpp.URL)
+	repo := &fakeSingleIssue{issue: issue}
+	svc := NewIssueInfoService(repo, info, nil, nil, NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), &fakeInfoTx{info: info}, &Config{SubcriberID: "buyer.example"})
+	req := &pb.ProvideIssueInfoRequest{
+		IssueId:   "issue-1",
+		ShortDesc: "photo attached",
+		LongDesc:  "box was crushed",
+		ImageUrls: []string{"https://cdn.example/box.jpg"},
+	}
+
+	bpp.setFailing(true)
+	_, err := svc.ProvideIssueInfo(ctx, req)
+	require.Error(t, err)
+	assert.Len(t, info.msgs, 1, "a failed send is not added to the thread")
+	assert.Zero(t, repo.updated)
+
+	bpp.setFailing(false)
+	// the BPP answers while the info is on its way
+	bpp.setOnAccept(func() { repo.issue.RespondentStatus = "PROCESSING" })
+	resp, err := svc.ProvideIssueInfo(ctx, req)
+	require.NoError(t, err, "the request is still pending after a failed send")
+	assert.True(t, resp.OndcSent)
+	assert.Equal(t, "photo attached", resp.Message.ShortDesc)
+	require.Len(t, info.msgs, 2)
+	assert.Equal(t, models.InfoActionInfoProvided, info.msgs[1].Action)
+	assert.Equal(t, 1, repo.updated)
+	assert.Equal(t, "box was crushed", repo.issue.DescriptionLong)
+	assert.Equal(t, "PROCESSING", repo.issue.RespondentStatus, "the concurrent callback is kept")
+	assert.JSONEq(t, `["https://cdn.example/box.jpg"]`, string(repo.issue.Images))
+
+	sent := bpp.issues()
+	require.Len(t, sent, 1)
+	desc := sent[0]["description"].(map[string]interface{})
+	assert.Equal(t, "box was crushed", desc["long_desc"])
+	actions := sent[0]["issue_actions"].(map[string]interface{})["complainant_actions"].([]interface{})
+	assert.Equal(t, models.InfoActionInfoProvided, actions[len(actions)-1].(map[string]interface{})["complainant_action"])
+
+	_, err = svc.ProvideIssueInfo(ctx, req)
+	assert.ErrorIs(t, err, ErrFailedPrecondition, "the request is answered")
+}
+
+func TestProvideIssueInfo_SavesMessageAndIssueTogether(t *testing.T) {
+	bpp := newTestBPP(t)
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	issue, info := awaitingInfoIssue(userID, bpp.URL)
+	repo := failingIssueUpdate{&fakeSingleIssue{issue: issue}}
+	svc := NewIssueInfoService(repo, info, nil, nil, NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), &fakeInfoTx{info: info}, &Config{SubcriberID: "buyer.example"})
+
+	_, err := svc.ProvideIssueInfo(ctx, &pb.ProvideIssueInfoRequest{IssueId: "issue-1", ShortDesc: "photo attached"})
+	assert.ErrorContains(t, err, "db down")
+	assert.Len(t, info.msgs, 1, "the thread message is rolled back with the issue update")
+}
@@ -4,49 +4,66 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"igm-svc/internal/auth"
 	"igm-svc/internal/mapper"
 	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
 	"log"
+	"strings"
 	"time"
 
-	//replace with repo
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
-
-	"github.com/google/uuid"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+	pb "igm-svc/api/proto/igm/v1"
 
 	"gorm.io/datatypes"
 )
 
 type IssueService struct {
-	issueRepo  repository.IssueRepository
-	redisRepo  repository.RedisRepository
-	OndcClient *OndcClient
-	config     *Config
+	issueRepo   repository.IssueRepository
+	respondents repository.IssueRespondentRepository
+	redisRepo   repository.RedisRepository
+	publisher   events.EventPublisher
+	OndcClient  *OndcClient
+	slaPolicies *SLAPolicyService
+	attachments *AttachmentService
+	config      *Config
 }
 
 type Config struct {
 	SubcriberID string
 	BAPURI      string
+	Domain      string
 }
 
 func NewIssueService(issueRepo repository.IssueRepository,
+	respondents repository.IssueRespondentRepository,
 	redisRepo repository.RedisRepository,
+	publisher events.EventPublisher,
 	ondcClient *OndcClient,
+	slaPolicies *SLAPolicyService,
+	attachments *AttachmentService,
 	config *Config,
 ) *IssueService {
 	return &IssueService{
-		issueRepo:  issueRepo,
-		redisRepo:  redisRepo,
-		OndcClient: ondcClient,
-		config:     config,
+		issueRepo:   issueRepo,
+		respondents: respondents,
+		redisRepo:   redisRepo,
+		publisher:   publisher,
+		OndcClient:  ondcClient,
+		slaPolicies: slaPolicies,
+		attachments: attachments,
+		config:      config,
 	}
 }
 
 func (s *IssueService) CreateIssue(ctx context.Context, req *pb.CreateIssueRequest) (*pb.CreateIssueResponse, error) {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	req.UserId = userID.String()
 
-	err := s.ValidateNoActiveIssueExistsWithSameCategory(req)
+	err = s.ValidateNoActiveIssueExistsWithSameCategory(req)
 	if err != nil {
 		return nil, fmt.Errorf("validation failed :%w", err)
 	}
@@ -56,9 +73,9 @@ func (s *IssueService) CreateIssue(ctx context.Context, req *pb.CreateIssueReque
 		return nil, fmt.Errorf("validation failed :%w", err)
 	}
 
-	userID, err := uuid.Parse(req.UserId)
+	attachmentIDs, descAttachmentID, err := s.attachments.CheckIssueAttachments(ctx, userID, req.AttachmentIds, req.ImageUrls, req.AdditionalDesc)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user_id :%w", err)
+		return nil, err
 	}
 
 	// 3. TODO: Verify order exists and belongs to user
@@ -67,12 +84,27 @@ func (s *IssueService) CreateIssue(ctx context.Context, req *pb.CreateIssueReque
 	bppID := "preprod.logistics-seller.mp2.in"
 	bppURI := "https://preprod.logistics-seller.mp2.in/ondc"
 
-	issue, err := s.buildIssueFromRequest(req, userID, bppID, bppURI)
+	sla, err := s.slaPolicies.Timeline(s.config.Domain, req.Category, req.SubCategory, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve sla policy:%w", err)
+	}
+
+	issue, err := s.buildIssueFromRequest(req, userID, bppID, bppURI, sla)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build issue:%w", err)
 	}
+	if len(attachmentIDs) > 0 {
+		idsJSON, err := json.Marshal(attachmentIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal attachment ids:%w", err)
+		}
+		issue.AttachmentIDs = datatypes.JSON(idsJSON)
+	}
+	issue.DescriptionAttachmentID = descAttachmentID
 
-	err = s.issueRepo.Create(ctx, issue)
+	err = saveWithEvents(ctx, s.publisher, func(ctx context.Context) error {
+		return s.issueRepo.Create(ctx, issue)
+	}, issueCreatedEvent(issue))
 	if err != nil {
 		return nil, fmt.Errorf("failed to save issue :%w", err)
 	}
@@ -91,6 +123,7 @@ func (s *IssueService) CreateIssue(ctx context.Context, req *pb.CreateIssueReque
 		_ = s.redisRepo.SaveIssueResponse(ctx, issue.TransactionID, map[string]interface{}{
 			"action":    "issue",
 			"issue_id":  issue.IssueID,
+			"user_id":   issue.UserID.String(),
 			"timestamp": time.Now().Format(time.RFC3339),
 		})
 	}
@@ -110,20 +143,29 @@ func (s *IssueService) CreateIssue(ctx context.Context, req *pb.CreateIssueReque
 func (s *IssueService) UpdateIssue(ctx context.Context, req *pb.UpdateIssueRequest) (*pb.UpdateIssueResponse, error) {
 	err := ValidateUpdateIssueRequest(req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 	//TODO veirfy order data
 
 	issue, err := s.issueRepo.GetByIssueID(ctx, req.IssueId)
 	if err != nil {
-		return nil, fmt.Errorf("issue not found:%w", err)
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+	if err := auth.AuthorizeOwner(ctx, issue.UserID); err != nil {
+		return nil, err
 	}
 
+	fromIssueType := issue.IssueType
 	issue.Status = req.Status
-	issue.IssueType = req.IssueType
+	if req.IssueType != "" {
+		issue.IssueType = req.IssueType
+	}
 	issue.UpdatedAt = time.Now()
 
 	if req.ComplainantActionShortDesc != "" {
+		// an escalation answers any pending resolution, which then no longer
+		// starts the auto-close window
+		issue.ResolvedAt = nil
 		var actions []map[string]interface{}
 		if len(issue.ComplainantActions) > 0 {
 			_ = json.Unmarshal(issue.ComplainantActions, &actions)
@@ -137,7 +179,13 @@ func (s *IssueService) UpdateIssue(ctx context.Context, req *pb.UpdateIssueReque
 		issue.ComplainantActions = datatypes.JSON(actionsJSON)
 	}
 
-	err = s.issueRepo.Update(ctx, issue)
+	evs := []*eventsv1.DomainEvent{issueUpdatedEvent(issue)}
+	if req.ComplainantActionShortDesc != "" {
+		evs = append(evs, issueEscalatedEvent(issue, fromIssueType, req.ComplainantActionShortDesc, EscalationSourceComplainant))
+	}
+	err = saveWithEvents(ctx, s.publisher, func(ctx context.Context) error {
+		return s.issueRepo.Update(ctx, issue)
+	}, evs...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update issue:%w", err)
 	}
@@ -161,13 +209,16 @@ func (s *IssueService) UpdateIssue(ctx context.Context, req *pb.UpdateIssueReque
 func (s *IssueService) CloseIssue(ctx context.Context, req *pb.CloseIssueRequest) (*pb.CloseIssueResponse, error) {
 	err := ValidateCloseIssueRequest(req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 	//validate order todo
 
 	issue, err := s.issueRepo.GetByIssueID(ctx, req.IssueId)
 	if err != nil {
-		return nil, fmt.Errorf("issue not found :%v", err)
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+	if err := auth.AuthorizeOwner(ctx, issue.UserID); err != nil {
+		return nil, err
 	}
 	issue.Status = req.Status
 	issue.Rating = req.Rating
@@ -186,7 +237,9 @@ func (s *IssueService) CloseIssue(ctx context.Context, req *pb.CloseIssueRequest
 		actionsJSON, _ := json.Marshal(actions)
 		issue.ComplainantActions = datatypes.JSON(actionsJSON)
 	}
-	err = s.issueRepo.Update(ctx, issue)
+	err = saveWithEvents(ctx, s.publisher, func(ctx context.Context) error {
+		return s.issueRepo.Update(ctx, issue)
+	}, issueClosedEvent(issue, CloseSourceComplainant))
 	if err != nil {
 		return nil, fmt.Errorf("failed to update the issue :%w", err)
 	}
@@ -209,70 +262,274 @@ func (s *IssueService) CloseIssue(ctx context.Context, req *pb.CloseIssueRequest
 
 }
 
+// AcceptResolution closes the issue with the complainant's rating once the
+// respondent has proposed a resolution.
+func (s *IssueService) AcceptResolution(ctx context.Context, req *pb.AcceptResolutionRequest) (*pb.AcceptResolutionResponse, error) {
+	err := ValidateAcceptResolutionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+	if err := validateResolutionPending(issue); err != nil {
+		return nil, err
+	}
+
+	shortDesc := req.ShortDesc
+	if shortDesc == "" {
+		shortDesc = "resolution accepted"
+	}
+	now := time.Now()
+	issue.Status = "CLOSED"
+	issue.Rating = req.Rating
+	issue.UpdatedAt = now
+	if err := appendComplainantAction(issue, map[string]interface{}{
+		"complainant_action": "CLOSE",
+		"short_desc":         shortDesc,
+		"updated_at":         now.Format(time.RFC3339),
+	}); err != nil {
+		return nil, err
+	}
+
+	err = saveWithEvents(ctx, s.publisher, func(ctx context.Context) error {
+		return s.issueRepo.Update(ctx, issue)
+	}, issueClosedEvent(issue, CloseSourceResolutionAccepted))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update the issue :%w", err)
+	}
+
+	ondcSent := false
+	ondcMessage := ""
+	err = s.OndcClient.SendIssue(ctx, issue, "CLOSE")
+	if err != nil {
+		ondcMessage = fmt.Sprintf("Failed to send to BPP: %v", err)
+	} else {
+		ondcSent = true
+		ondcMessage = "resolution accepted, issue close sent to BPP"
+	}
+
+	return &pb.AcceptResolutionResponse{
+		IssueId:     issue.IssueID,
+		Status:      issue.Status,
+		ClosedAt:    issue.UpdatedAt.Format(time.RFC3339),
+		OndcSent:    ondcSent,
+		OndcMessage: ondcMessage,
+	}, nil
+}
+
+// RejectResolution escalates the issue back to the respondent with the
+// complainant's reason, optionally upgrading an ISSUE to a GRIEVANCE.
+func (s *IssueService) RejectResolution(ctx context.Context, req *pb.RejectResolutionRequest) (*pb.RejectResolutionResponse, error) {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+	if err := validateResolutionPending(issue); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	fromIssueType := issue.IssueType
+	if req.EscalateToGrievance && issue.IssueType == "ISSUE" {
+		issue.IssueType = "GRIEVANCE"
+	}
+	// the rejected resolution no longer starts the auto-close window
+	issue.ResolvedAt = nil
+	issue.UpdatedAt = now
+	if err := appendComplainantAction(issue, map[string]interface{}{
+		"complainant_action": "ESCALATE",
+		"short_desc":         req.Reason,
+		"updated_at":         now.Format(time.RFC3339),
+	}); err != nil {
+		return nil, err
+	}
+
+	err = saveWithEvents(ctx, s.publisher, func(ctx context.Context) error {
+		return s.issueRepo.Update(ctx, issue)
+	}, issueEscalatedEvent(issue, fromIssueType, req.Reason, EscalationSourceResolutionRejected))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update issue:%w", err)
+	}
+
+	ondcSent := false
+	ondcMessage := ""
+	err = s.OndcClient.SendIssue(ctx, issue, "ESCALATE")
+	if err != nil {
+		ondcMessage = fmt.Sprintf("Failed to send to BPP: %v", err)
+	} else {
+		ondcSent = true
+		ondcMessage = "resolution rejected, escalation sent to BPP"
+	}
+
+	return &pb.RejectResolutionResponse{
+		IssueId:     issue.IssueID,
+		Status:      issue.Status,
+		IssueType:   issue.IssueType,
+		UpdatedAt:   issue.UpdatedAt.Format(time.RFC3339),
+		OndcSent:    ondcSent,
+		OndcMessage: ondcMessage,
+	}, nil
+}
+
 func (s *IssueService) GetIssue(ctx context.Context, req *pb.GetIssueRequest) (*pb.GetIssueResponse, error) {
 	if req.IssueId == "" {
-		return nil, fmt.Errorf("missing required field:issue_id")
+		return nil, missingField("issue_id")
 	}
-	if req.UserId == "" {
-		return nil, fmt.Errorf("missing required field:user_id")
+	userId, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
 	}
-	userId := uuid.MustParse(req.UserId)
 	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userId)
 	if err != nil {
 		return nil, err
 	}
 
 	ProtoIssue := mapper.ToProtoIssue(issue)
+	chain, err := s.respondents.ListByIssueID(ctx, issue.IssueID)
+	if err != nil {
+		log.Printf("[Service] failed to load respondent chain for %s: %v", issue.IssueID, err)
+	} else {
+		mapper.AttachRespondentChain(ProtoIssue, chain, issue.CascadedLevel)
+	}
 
-	if len(issue.Images) > 0 {
-		var imgs []string
-		_ = json.Unmarshal(issue.Images, &imgs)
-		ProtoIssue.ImageUrls = imgs
+	ProtoIssue.ImageUrls, err = s.attachments.ImageURLs(ctx, issue.Images, issue.AttachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	if issue.DescriptionAttachmentID != nil {
+		url, err := s.attachments.DescriptionURL(ctx, issue.DescriptionURL, issue.DescriptionAttachmentID)
+		if err != nil {
+			return nil, err
+		}
+		ProtoIssue.AdditionalDesc = &pb.AdditionalDescription{Url: url, ContentType: issue.DescriptionContentType}
 	}
 	return &pb.GetIssueResponse{Issue: ProtoIssue}, nil
 
 }
+
+// GetIssuesByUser lists a user's issues a page at a time. Pages are chained
+// with next_cursor; page numbers are still honoured without a cursor for
+// older clients.
 func (s *IssueService) GetIssuesByUser(ctx context.Context, req *pb.ListIssueRequest) (*pb.ListIssueResponse, error) {
-	if req.UserId == "" {
-		return nil, fmt.Errorf("missing required fields: user_id")
-	}
-	if req.PageSize == 0 {
+	if req.PageSize <= 0 {
 		req.PageSize = 10
 	}
+	if req.PageSize > 100 {
+		req.PageSize = 100
+	}
 	if req.Page <= 0 {
 		req.Page = 1
 	}
 
-	userID, err := uuid.Parse(req.UserId)
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
 	if err != nil {
-		return nil, fmt.Errorf("invalid user_id")
+		return nil, err
 	}
-
-	offset := (int(req.Page) - 1) * int(req.PageSize)
-	issues, total, err := s.issueRepo.GetByUserID(ctx, userID, int(req.PageSize), offset)
+	q, err := issueQueryFromRequest(userID, req)
 	if err != nil {
 		return nil, err
 	}
 
-	protoIssues := mapper.ToProtoIssues(issues)
+	total, err := s.issueRepo.CountIssues(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	// one extra row tells whether another page follows
+	q.Limit = int(req.PageSize) + 1
+	issues, err := s.issueRepo.ListIssues(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	var nextCursor string
+	if len(issues) > int(req.PageSize) {
+		issues = issues[:req.PageSize]
+		nextCursor = q.CursorAfter(issues[len(issues)-1]).Encode()
+	}
 
 	return &pb.ListIssueResponse{
-		Issues:     protoIssues,
+		Issues:     mapper.ToProtoIssues(issues),
 		TotalCount: int32(total),
 		Page:       req.Page,
 		PageSize:   req.PageSize,
+		NextCursor: nextCursor,
 	}, nil
 
 }
 
-func (s *IssueService) GetIssueByOrder(ctx context.Context, req *pb.ListIssueByOrderRequest) (*pb.ListIssueResponse, error) {
-	if req.UserId == "" {
-		return nil, fmt.Errorf("required field is missing:user_id")
+// SearchIssues ranks issues against a full-text query over their
+// descriptions and the respondents' short descriptions. Users search their
+// own issues; support agents search everyone's unless they name a user.
+func (s *IssueService) SearchIssues(ctx context.Context, req *pb.IssueSearchRequest) (*pb.IssueSearchResponse, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, missingField("query")
 	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	userID, err := auth.ResolveUserFilter(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	q, err := issueQueryFromRequest(userID, &pb.ListIssueRequest{
+		Status:        req.Status,
+		Category:      req.Category,
+		IssueType:     req.IssueType,
+		OrderId:       req.OrderId,
+		CreatedFrom:   req.CreatedFrom,
+		CreatedTo:     req.CreatedTo,
+		HasResolution: req.HasResolution,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	q.Limit = int(req.PageSize)
+
+	hits, total, err := s.issueRepo.SearchIssues(ctx, req.Query, q)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.IssueSearchResponse{
+		Hits:       make([]*pb.IssueSearchHit, 0, len(hits)),
+		TotalCount: int32(total),
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}
+	for _, hit := range hits {
+		resp.Hits = append(resp.Hits, &pb.IssueSearchHit{
+			Issue:   mapper.ToProtoIssue(&hit.Issue),
+			Rank:    hit.Rank,
+			Snippet: hit.Snippet,
+		})
+	}
+	return resp, nil
+}
+
+func (s *IssueService) GetIssueByOrder(ctx context.Context, req *pb.ListIssueByOrderRequest) (*pb.ListIssueResponse, error) {
 	if req.OrderId == "" {
-		return nil, fmt.Errorf("missing required fiels: order_id")
+		return nil, missingField("order_id")
+	}
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
 	}
-	issues, err := s.issueRepo.GetByOrderID(ctx, req.OrderId)
+	issues, err := s.issueRepo.GetByOrderIDAndUserID(ctx, req.OrderId, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"testing"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/datatypes"
+)
+
+type fakeIssueLister struct {
+	repository.IssueRepository
+	issues  []*models.Issue // newest first
+	queries []repository.IssueQuery
+}
+
+func (f *fakeIssueLister) ListIssues(ctx context.Context, q repository.IssueQuery) ([]*models.Issue, error) {
+	f.queries = append(f.queries, q)
+	var out []*models.Issue
+	for _, issue := range f.issues {
+		if q.After != nil && !issue.CreatedAt.Before(q.After.At) {
+			continue
+		}
+		out = append(out, issue)
+		if len(out) == q.Limit {
+			break
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeIssueLister) CountIssues(ctx context.Context, q repository.IssueQuery) (int, error) {
+	return len(f.issues), nil
+}
+
+func TestGetIssuesByUser_CursorPaging(t *testing.T) {
+	userID := uuid.New()
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := &fakeIssueLister{}
+	for i := 5; i >= 1; i-- {
+		repo.issues = append(repo.issues, &models.Issue{ID: uint(i), IssueID: uuid.NewString(), UserID: userID, CreatedAt: base.Add(time.Duration(i) * time.Hour)})
+	}
+	svc := &IssueService{issueRepo: repo}
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+
+	var seen []string
+	req := &pb.ListIssueRequest{PageSize: 2, Status: "OPEN"}
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 5)
+		resp, err := svc.GetIssuesByUser(ctx, req)
+		require.NoError(t, err)
+		assert.EqualValues(t, 5, resp.TotalCount)
+		for _, issue := range resp.Issues {
+			seen = append(seen, issue.IssueId)
+		}
+		if resp.NextCursor == "" {
+			break
+		}
+		req.Cursor = resp.NextCursor
+	}
+	require.Len(t, seen, 5)
+	for i, issue := range repo.issues {
+		assert.Equal(t, issue.IssueID, seen[i])
+	}
+	assert.Equal(t, "OPEN", repo.queries[0].Status)
+	assert.Equal(t, userID, repo.queries[0].UserID)
+}
+
+func TestGetIssuesByUser_InvalidArguments(t *testing.T) {
+	userID := uuid.New()
+	svc := &IssueService{issueRepo: &fakeIssueLister{}}
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+
+	for name, req := range map[string]*pb.ListIssueRequest{
+		"created_from": {CreatedFrom: "last week"},
+		"cursor":       {Cursor: "garbage"},
+		"cursor sort":  {Cursor: (&repository.IssueCursor{SortBy: repository.IssueSortCreatedAt, ID: 1}).Encode(), SortBy: repository.IssueSortUpdatedAt},
+	} {
+		_, err := svc.GetIssuesByUser(ctx, req)
+		assert.ErrorIs(t, err, ErrInvalidArgument, name)
+	}
+}
+
+func TestGetIssue_MissingIDsAreInvalidArgument(t *testing.T) {
+	svc := &IssueService{issueRepo: &fakeIssueLister{}}
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: uuid.New()})
+
+	_, err := svc.GetIssue(ctx, &pb.GetIssueRequest{})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	_, err = svc.GetIssueByOrder(ctx, &pb.ListIssueByOrderRequest{})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func (f *fakeIssueLister) SearchIssues(ctx context.Context, text string, q repository.IssueQuery) ([]*repository.IssueSearchHit, int, error) {
+	f.queries = append(f.queries, q)
+	return []*repository.IssueSearchHit{{Issue: *f.issues[0], Rank: 0.5, Snippet: "<mark>damaged</mark> packaging"}}, 1, nil
+}
+
+func TestSearchIssues_ScopedByRole(t *testing.T) {
+	userID := uuid.New()
+	repo := &fakeIssueLister{issues: []*models.Issue{{ID: 1, IssueID: "issue-1", UserID: userID}}}
+	svc := &IssueService{issueRepo: repo}
+	userCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	supportCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "agent-7", Role: auth.RoleSupport})
+
+	resp, err := svc.SearchIssues(userCtx, &pb.IssueSearchRequest{Query: "damaged packaging", Page: 3, PageSize: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.Hits, 1)
+	assert.Equal(t, "<mark>damaged</mark> packaging", resp.Hits[0].Snippet)
+	assert.Equal(t, userID, repo.queries[0].UserID)
+	assert.Equal(t, 20, repo.queries[0].Offset)
+
+	_, err = svc.SearchIssues(userCtx, &pb.IssueSearchRequest{Query: "damaged", UserId: uuid.NewString()})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = svc.SearchIssues(supportCtx, &pb.IssueSearchRequest{Query: "damaged", Status: "OPEN"})
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Nil, repo.queries[1].UserID)
+	assert.Equal(t, "OPEN", repo.queries[1].Status)
+
+	_, err = svc.SearchIssues(supportCtx, &pb.IssueSearchRequest{Query: "  "})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestIssueValidation_SharedWireValues(t *testing.T) {
+	svc := &IssueService{}
+	create := func(category, issueType string) *pb.CreateIssueRequest {
+		return &pb.CreateIssueRequest{
+			UserId: uuid.NewString(), OrderId: "order-1", Category: category, SubCategory: "FLM02",
+			IssueType: issueType, Description: "damaged", Items: []*pb.IssueItem{{Id: "item-1", Quantity: 1}},
+		}
+	}
+	assert.NoError(t, svc.validateCreateRequest(create("POLICY BREACH", "GRIEVANCE")))
+
+	var verr *ValidationError
+	err := svc.validateCreateRequest(create("ITEM", "DISPUTE"))
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "issue_type", verr.Violations[0].Field)
+	assert.Contains(t, err.Error(), "SelectOdr", "create and update reject DISPUTE alike")
+	err = ValidateUpdateIssueRequest(&pb.UpdateIssueRequest{UserId: "u", IssueId: "i", OrderId: "o", Status: "OPEN", IssueType: "DISPUTE"})
+	assert.Contains(t, err.Error(), "SelectOdr")
+
+	err = ValidateUpdateIssueRequest(&pb.UpdateIssueRequest{UserId: "u", IssueId: "i", OrderId: "o", Status: "ESCALATED"})
+	require.ErrorAs(t, err, &verr)
+	assert.Equal(t, "status", verr.Violations[0].Field)
+	assert.NoError(t, ValidateUpdateIssueRequest(&pb.UpdateIssueRequest{UserId: "u", IssueId: "i", OrderId: "o", Status: "OPEN"}))
+
+	_, err = issueQueryFromRequest(uuid.New(), &pb.ListIssueRequest{Category: "order"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func resolvedIssue(userID uuid.UUID, bppURI string, resolvedAt time.Time) *models.Issue {
+	return &models.Issue{
+		IssueID:            "issue-1",
+		UserID:             userID,
+		BPPURI:             bppURI,
+		Status:             "OPEN",
+		IssueType:          "ISSUE",
+		RespondentStatus:   "RESOLVED",
+		ResolvedAt:         &resolvedAt,
+		Resolution:         datatypes.JSON(`{"shortDesc":"refund issued","actionTriggered":"REFUND"}`),
+		ComplainantActions: datatypes.JSON(`[{"complainant_action":"OPEN","updated_at":"2026-01-01T09:00:00Z"}]`),
+	}
+}
+
+func newTestResolutionService(issue *models.Issue) (*IssueService, *fakeSingleIssue) {
+	repo := &fakeSingleIssue{issue: issue}
+	svc := NewIssueService(repo, nil, nil, nil, NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), nil, nil, &Config{SubcriberID: "buyer.example"})
+	return svc, repo
+}
+
+func TestAcceptResolution(t *testing.T) {
+	bpp := newTestBPP(t)
+	userID := uuid.New()
+	svc, repo := newTestResolutionService(resolvedIssue(userID, bpp.URL, time.Now().Add(-time.Hour)))
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+
+	resp, err := svc.AcceptResolution(ctx, &pb.AcceptResolutionRequest{IssueId: "issue-1", Rating: "THUMBS-UP"})
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", resp.Status)
+	assert.True(t, resp.OndcSent)
+	assert.Equal(t, "CLOSED", repo.issue.Status)
+	assert.Equal(t, "THUMBS-UP", repo.issue.Rating)
+	sent := bpp.issues()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "CLOSED", sent[0]["status"])
+
+	_, err = svc.AcceptResolution(ctx, &pb.AcceptResolutionRequest{IssueId: "issue-1", Rating: "THUMBS-UP"})
+	assert.ErrorIs(t, err, ErrFailedPrecondition, "a closed issue cannot be accepted again")
+}
+
+func TestAcceptResolution_NoResolution(t *testing.T) {
+	userID := uuid.New()
+	issue := resolvedIssue(userID, "https://seller.example", time.Now())
+	issue.Resolution = nil
+	svc, repo := newTestResolutionService(issue)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+
+	_, err := svc.AcceptResolution(ctx, &pb.AcceptResolutionRequest{IssueId: "issue-1", Rating: "THUMBS-UP"})
+	assert.ErrorIs(t, err, ErrFailedPrecondition)
+	assert.Zero(t, repo.updated)
+}
+
+func TestRejectResolution(t *testing.T) {
+	bpp := newTestBPP(t)
+	userID := uuid.New()
+	resolvedAt := time.Now().Add(-time.Hour)
+	svc, repo := newTestResolutionService(resolvedIssue(userID, bpp.URL, resolvedAt))
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+
+	resp, err := svc.RejectResolution(ctx, &pb.RejectResolutionRequest{IssueId: "issue-1", Reason: "refund not received", EscalateToGrievance: true})
+	require.NoError(t, err)
+	assert.Equal(t, "GRIEVANCE", resp.IssueType)
+	assert.True(t, resp.OndcSent)
+	assert.Equal(t, "OPEN", repo.issue.Status)
+	assert.Nil(t, repo.issue.ResolvedAt)
+	sent := bpp.issues()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "GRIEVANCE", sent[0]["issue_type"])
+
+	_, err = svc.RejectResolution(ctx, &pb.RejectResolutionRequest{IssueId: "issue-1", Reason: "again"})
+	assert.ErrorIs(t, err, ErrFailedPrecondition, "the rejected resolution cannot be rejected again")
+	_, err = svc.AcceptResolution(ctx, &pb.AcceptResolutionRequest{IssueId: "issue-1", Rating: "THUMBS-UP"})
+	assert.ErrorIs(t, err, ErrFailedPrecondition, "the rejected resolution cannot be accepted")
+
+	// the BPP repeating its old RESOLVED action does not revive it
+	repo.issue.ResolvedAt = &resolvedAt
+	_, err = svc.AcceptResolution(ctx, &pb.AcceptResolutionRequest{IssueId: "issue-1", Rating: "THUMBS-UP"})
+	assert.ErrorIs(t, err, ErrFailedPrecondition)
+
+	// a newer resolution can be acted on
+	newer := time.Now().Add(time.Minute)
+	repo.issue.ResolvedAt = &newer
+	_, err = svc.AcceptResolution(ctx, &pb.AcceptResolutionRequest{IssueId: "issue-1", Rating: "THUMBS-UP"})
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", repo.issue.Status)
+}
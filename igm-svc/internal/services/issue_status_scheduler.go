@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"log"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type IssueStatusSchedulerConfig struct {
+	// Interval is how often the scheduler looks for due issues.
+	Interval  time.Duration
+	BatchSize int
+
+	// Concurrency caps in-flight polls across all BPPs.
+	Concurrency int
+	// PerBPPRatePerMinute caps /issue_status calls to a single BPP.
+	PerBPPRatePerMinute int
+
+	MinPollInterval time.Duration
+	MaxPollInterval time.Duration
+	// FreshWindow skips polling when an on_issue_status arrived this recently.
+	FreshWindow time.Duration
+}
+
+// IssueStatusScheduler polls BPPs with /issue_status for non-closed issues on
+// an adaptive cadence.
+type IssueStatusScheduler struct {
+	issueRepo     repository.IssueRepository
+	onIssueRepo   repository.OnIssueRepository
+	statusService *IssueStatusService
+	cfg           IssueStatusSchedulerConfig
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func NewIssueStatusScheduler(issueRepo repository.IssueRepository,
+	onIssueRepo repository.OnIssueRepository,
+	statusService *IssueStatusService,
+	cfg IssueStatusSchedulerConfig,
+) *IssueStatusScheduler {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 30 * time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 10
+	}
+	if cfg.PerBPPRatePerMinute <= 0 {
+		cfg.PerBPPRatePerMinute = 60
+	}
+	if cfg.MinPollInterval <= 0 {
+		cfg.MinPollInterval = 5 * time.Minute
+	}
+	if cfg.MaxPollInterval < cfg.MinPollInterval {
+		cfg.MaxPollInterval = cfg.MinPollInterval
+	}
+	return &IssueStatusScheduler{
+		issueRepo:     issueRepo,
+		onIssueRepo:   onIssueRepo,
+		statusService: statusService,
+		cfg:           cfg,
+		limiters:      map[string]*rate.Limiter{},
+	}
+}
+
+// Start runs the scheduler until ctx is cancelled.
+func (s *IssueStatusScheduler) Start(ctx context.Context) {
+	log.Printf("[IssueStatusScheduler] started interval=%v concurrency=%d per_bpp_rate=%d/min",
+		s.cfg.Interval, s.cfg.Concurrency, s.cfg.PerBPPRatePerMinute)
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Printf("[IssueStatusScheduler] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce polls one batch of due issues and waits for the polls to finish.
+func (s *IssueStatusScheduler) RunOnce(ctx context.Context) {
+	now := time.Now()
+	issues, err := s.issueRepo.FindDueForStatusPoll(ctx, now, s.cfg.BatchSize)
+	if err != nil {
+		log.Printf("[IssueStatusScheduler] failed to find due issues: %v", err)
+		return
+	}
+
+	sem := make(chan struct{}, s.cfg.Concurrency)
+	var wg sync.WaitGroup
+	for _, issue := range issues {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func(issue *models.Issue) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			s.poll(ctx, issue, now)
+		}(issue)
+	}
+	wg.Wait()
+}
+
+func (s *IssueStatusScheduler) poll(ctx context.Context, issue *models.Issue, now time.Time) {
+	latest, err := s.onIssueRepo.GetLatestOnIssueStatusResponse(ctx, issue.IssueID)
+	if err != nil {
+		log.Printf("[IssueStatusScheduler] failed to load latest callback for %s: %v", issue.IssueID, err)
+		return
+	}
+	if latest != nil && now.Sub(latest.CreatedAt) < s.cfg.FreshWindow {
+		s.reschedule(ctx, issue, nil, latest.CreatedAt.Add(s.cfg.FreshWindow))
+		return
+	}
+	if latest == nil && now.Sub(issue.CreatedAt) < s.cfg.MinPollInterval {
+		s.reschedule(ctx, issue, nil, issue.CreatedAt.Add(s.cfg.MinPollInterval))
+		return
+	}
+
+	// over the BPP's budget: retry on a later tick, behind the issues that are
+	// already due, so one busy BPP does not fill every batch
+	if !s.limiter(issue.BPPID).Allow() {
+		s.reschedule(ctx, issue, nil, now.Add(s.cfg.Interval))
+		return
+	}
+
+	if err := s.statusService.SendIssueStatus(ctx, issue); err != nil {
+		log.Printf("[IssueStatusScheduler] poll failed for %s: %v", issue.IssueID, err)
+	}
+	s.reschedule(ctx, issue, &now, now.Add(s.cfg.nextPollInterval(issue, now)))
+}
+
+func (s *IssueStatusScheduler) reschedule(ctx context.Context, issue *models.Issue, polledAt *time.Time, next time.Time) {
+	if err := s.issueRepo.UpdateStatusPollSchedule(ctx, issue.IssueID, polledAt, next); err != nil {
+		log.Printf("[IssueStatusScheduler] failed to reschedule %s: %v", issue.IssueID, err)
+	}
+}
+
+func (s *IssueStatusScheduler) limiter(bppID string) *rate.Limiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.limiters[bppID]
+	if !ok {
+		perMinute := s.cfg.PerBPPRatePerMinute
+		l = rate.NewLimiter(rate.Every(time.Minute/time.Duration(perMinute)), perMinute)
+		s.limiters[bppID] = l
+	}
+	return l
+}
+
+// nextPollInterval tightens the cadence as respond_by approaches and backs off
+// exponentially once the respondent has picked the issue up.
+func (c IssueStatusSchedulerConfig) nextPollInterval(issue *models.Issue, now time.Time) time.Duration {
+	switch issue.RespondentStatus {
+	case "PROCESSING", "RESOLVED":
+		if issue.LastStatusPollAt == nil {
+			return c.clamp(c.MaxPollInterval / 2)
+		}
+		return c.clamp(2 * now.Sub(*issue.LastStatusPollAt))
+	}
+
+	if issue.RespondBy == nil {
+		return c.MaxPollInterval
+	}
+	remaining := issue.RespondBy.Sub(now)
+	if remaining <= 0 {
+		return c.MinPollInterval
+	}
+	return c.clamp(remaining / 4)
+}
+
+func (c IssueStatusSchedulerConfig) clamp(d time.Duration) time.Duration {
+	if d < c.MinPollInterval {
+		return c.MinPollInterval
+	}
+	if d > c.MaxPollInterval {
+		return c.MaxPollInterval
+	}
+	return d
+}
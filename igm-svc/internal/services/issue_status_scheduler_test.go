@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueStatusSchedulerConfig_NextPollInterval(t *testing.T) {
+	cfg := IssueStatusSchedulerConfig{
+		MinPollInterval: 5 * time.Minute,
+		MaxPollInterval: time.Hour,
+	}
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		t := now.Add(d)
+		return &t
+	}
+
+	// far from respond_by: capped at max
+	assert.Equal(t, time.Hour, cfg.nextPollInterval(&models.Issue{RespondBy: at(8 * time.Hour)}, now))
+	// approaching respond_by: a quarter of the remaining time
+	assert.Equal(t, 15*time.Minute, cfg.nextPollInterval(&models.Issue{RespondBy: at(time.Hour)}, now))
+	// close to or past respond_by: min
+	assert.Equal(t, 5*time.Minute, cfg.nextPollInterval(&models.Issue{RespondBy: at(10 * time.Minute)}, now))
+	assert.Equal(t, 5*time.Minute, cfg.nextPollInterval(&models.Issue{RespondBy: at(-time.Minute)}, now))
+
+	// processing: doubles the previous gap up to max
+	processing := &models.Issue{RespondentStatus: "PROCESSING", RespondBy: at(10 * time.Minute), LastStatusPollAt: at(-10 * time.Minute)}
+	assert.Equal(t, 20*time.Minute, cfg.nextPollInterval(processing, now))
+	processing.LastStatusPollAt = at(-45 * time.Minute)
+	assert.Equal(t, time.Hour, cfg.nextPollInterval(processing, now))
+}
+
+type pollSchedule struct {
+	polledAt *time.Time
+	next     time.Time
+}
+
+type fakePollIssues struct {
+	repository.IssueRepository
+	mu        sync.Mutex
+	issues    []*models.Issue
+	schedules map[string]pollSchedule
+}
+
+func (f *fakePollIssues) FindDueForStatusPoll(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*models.Issue
+	for _, issue := range f.issues {
+		if s, ok := f.schedules[issue.IssueID]; ok && s.next.After(now) {
+			continue
+		}
+		out = append(out, issue)
+	}
+	return out, nil
+}
+
+func (f *fakePollIssues) UpdateStatusPollSchedule(ctx context.Context, issueID string, polledAt *time.Time, nextPollAt time.Time) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.schedules[issueID] = pollSchedule{polledAt: polledAt, next: nextPollAt}
+	return nil
+}
+
+type fakeLatestStatus struct {
+	repository.OnIssueRepository
+	latest map[string]*models.OnIssueStatusResponse
+}
+
+func (f *fakeLatestStatus) GetLatestOnIssueStatusResponse(ctx context.Context, issueID string) (*models.OnIssueStatusResponse, error) {
+	return f.latest[issueID], nil
+}
+
+func TestIssueStatusScheduler_RunOnce(t *testing.T) {
+	bpp := newTestBPP(t)
+	created := time.Now().Add(-2 * time.Hour)
+	issue := func(id, bppID string) *models.Issue {
+		return &models.Issue{IssueID: id, BPPID: bppID, BPPURI: bpp.URL, Status: "OPEN", CreatedAt: created}
+	}
+	repo := &fakePollIssues{
+		issues: []*models.Issue{
+			issue("busy-1", "bpp-busy"),
+			issue("busy-2", "bpp-busy"),
+			issue("busy-3", "bpp-busy"),
+			issue("quiet-1", "bpp-quiet"),
+			issue("fresh-1", "bpp-quiet"),
+		},
+		schedules: map[string]pollSchedule{},
+	}
+	answeredAt := time.Now().Add(-time.Minute)
+	onIssues := &fakeLatestStatus{latest: map[string]*models.OnIssueStatusResponse{
+		"fresh-1": {IssueID: "fresh-1", CreatedAt: answeredAt},
+	}}
+	statusService := NewIssueStatusService(repo, onIssues, nil, nil, nil, nil,
+		NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), &Config{SubcriberID: "buyer.example"})
+	scheduler := NewIssueStatusScheduler(repo, onIssues, statusService, IssueStatusSchedulerConfig{
+		Interval:            time.Minute,
+		Concurrency:         1,
+		PerBPPRatePerMinute: 2,
+		MinPollInterval:     5 * time.Minute,
+		MaxPollInterval:     time.Hour,
+		FreshWindow:         10 * time.Minute,
+	})
+
+	before := time.Now()
+	scheduler.RunOnce(context.Background())
+
+	polled := map[string]bool{}
+	for _, sent := range bpp.payloads {
+		polled[sent["message"].(map[string]interface{})["issue_id"].(string)] = true
+	}
+	assert.Equal(t, map[string]bool{"busy-1": true, "busy-2": true, "quiet-1": true}, polled)
+	for _, id := range []string{"busy-1", "busy-2", "quiet-1"} {
+		assert.NotNil(t, repo.schedules[id].polledAt, id)
+	}
+
+	throttled := repo.schedules["busy-3"]
+	assert.Nil(t, throttled.polledAt, "a throttled issue was not polled")
+	assert.True(t, throttled.next.After(before), "a throttled issue is no longer due")
+
+	fresh := repo.schedules["fresh-1"]
+	assert.Nil(t, fresh.polledAt, "a fresh callback skips the poll")
+	assert.Equal(t, answeredAt.Add(10*time.Minute), fresh.next)
+
+	// nothing is due until the schedules come round again
+	scheduler.RunOnce(context.Background())
+	require.Len(t, bpp.payloads, 3)
+}
@@ -4,14 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"igm-svc/internal/auth"
 	"igm-svc/internal/models"
 	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
 	"log"
 	"time"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
+	pb "igm-svc/api/proto/igm/v1"
 
-	"github.com/google/uuid"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/datatypes"
 )
@@ -19,7 +20,10 @@ import (
 type IssueStatusService struct {
 	issueRepo   repository.IssueRepository
 	onIssueRepo repository.OnIssueRepository
+	infoRepo    repository.IssueInfoRepository
+	respondents repository.IssueRespondentRepository
 	redisRepo   repository.RedisRepository
+	publisher   events.EventPublisher
 	OndcClient  *OndcClient
 	config      *Config
 }
@@ -27,14 +31,20 @@ type IssueStatusService struct {
 func NewIssueStatusService(
 	issueRepo repository.IssueRepository,
 	onIssueRepo repository.OnIssueRepository,
+	infoRepo repository.IssueInfoRepository,
+	respondents repository.IssueRespondentRepository,
 	redisRepo repository.RedisRepository,
+	publisher events.EventPublisher,
 	ondcClient *OndcClient,
 	config *Config,
 ) *IssueStatusService {
 	return &IssueStatusService{
 		issueRepo:   issueRepo,
 		onIssueRepo: onIssueRepo,
+		infoRepo:    infoRepo,
+		respondents: respondents,
 		redisRepo:   redisRepo,
+		publisher:   publisher,
 		OndcClient:  ondcClient,
 		config:      config,
 	}
@@ -49,24 +59,30 @@ func (s *IssueStatusService) ProcessIssueStatus(ctx context.Context, req *pb.Iss
 		return fmt.Errorf("missing issue_id")
 	}
 	if req.UserId == "" {
-		return fmt.Errorf("missing user_id")
+		return missingField("user_id")
 	}
 
-	userID, err := uuid.Parse(req.UserId)
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
 	if err != nil {
-		return fmt.Errorf("invalid user_id: %w", err)
+		return err
 	}
 
 	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
 	if err != nil {
-		return fmt.Errorf("issue not found or access denied: %w", err)
+		return fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
 	}
 
+	return s.SendIssueStatus(ctx, issue)
+}
+
+// SendIssueStatus sends /issue_status for an already loaded issue. It is shared
+// by the RPC and the polling scheduler.
+func (s *IssueStatusService) SendIssueStatus(ctx context.Context, issue *models.Issue) error {
 	log.Printf("[IssueStatusService] Sending issue_status for issue: %s to BPP: %s", issue.IssueID, issue.BPPURI)
 
 	// OndcClient.SendIssueStatus builds the context and payload
 
-	err = s.OndcClient.SendIssueStatus(ctx, issue)
+	err := s.OndcClient.SendIssueStatus(ctx, issue)
 	if err != nil {
 		log.Printf("[IssueStatusService] Failed to send issue_status: %v", err)
 		return fmt.Errorf("failed to send issue_status to BPP: %w", err)
@@ -78,12 +94,15 @@ func (s *IssueStatusService) ProcessIssueStatus(ctx context.Context, req *pb.Iss
 			"action":         "issue_status_sent",
 			"issue_id":       issue.IssueID,
 			"transaction_id": issue.TransactionID,
-			"user_id":        req.UserId,
+			"user_id":        issue.UserID.String(),
 			"bpp_id":         issue.BPPID,
 			"bpp_uri":        issue.BPPURI,
 		}
 		_ = s.redisRepo.SaveIssueResponse(ctx, issue.TransactionID, event)
 	}
+	if err := publishDomainEvents(ctx, s.publisher, issueStatusRequestedEvent(issue)); err != nil {
+		return err
+	}
 
 	log.Printf("[IssueStatusService] issue_status request sent successfully")
 	return nil
@@ -129,7 +148,11 @@ func (s *IssueStatusService) ProcessOnIssueStatus(ctx context.Context, transacti
 		last := ia.RespondentActions[len(ia.RespondentActions)-1]
 		if last != nil && last.GetRespondentAction() != "" {
 			updates["respondent_status"] = last.GetRespondentAction()
+			if last.GetRespondentAction() == "RESOLVED" {
+				updates["resolved_at"] = respondentActionTime(last, now)
+			}
 		}
+		recordInfoRequests(ctx, s.infoRepo, issueID, ia)
 	}
 
 	// Extract Resolution Provider
@@ -157,6 +180,9 @@ func (s *IssueStatusService) ProcessOnIssueStatus(ctx context.Context, transacti
 		}
 	}
 
+	// Track who is responsible after cascades
+	updateRespondentChain(ctx, s.respondents, issueID, ia, rp, updates)
+
 	// Update Issue Status if present
 	if payload.Issue.Status != "" {
 		updates["status"] = payload.Issue.Status
@@ -212,7 +238,9 @@ func (s *IssueStatusService) ProcessOnIssueStatus(ctx context.Context, transacti
 	}
 
 	// Update Issue in DB
-	err = s.onIssueRepo.UpdateIssueFromOnIssue(ctx, issueID, updates)
+	err = saveWithEvents(ctx, s.publisher, func(ctx context.Context) error {
+		return s.onIssueRepo.UpdateIssueFromOnIssue(ctx, issueID, updates)
+	}, respondentActionEvents(issueID, transactionID, ia, res)...)
 	if err != nil {
 		return fmt.Errorf("failed to update issue from on_issue_status: %w", err)
 	}
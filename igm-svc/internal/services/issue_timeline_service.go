@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"sort"
+	"strings"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	TimelineComplainantAction = "COMPLAINANT_ACTION"
+	TimelineRespondentAction  = "RESPONDENT_ACTION"
+	TimelineResolutionUpdated = "RESOLUTION_UPDATED"
+	TimelineStatusCallback    = "STATUS_CALLBACK"
+	TimelineStatusPoll        = "STATUS_POLL"
+
+	ActorComplainant = "COMPLAINANT"
+	ActorRespondent  = "RESPONDENT"
+	ActorSystem      = "SYSTEM"
+)
+
+// IssueTimelineService merges an issue's complainant actions, respondent
+// actions, resolutions and status exchanges into one ordered history.
+type IssueTimelineService struct {
+	issueRepo   repository.IssueRepository
+	onIssueRepo repository.OnIssueRepository
+}
+
+func NewIssueTimelineService(issueRepo repository.IssueRepository, onIssueRepo repository.OnIssueRepository) *IssueTimelineService {
+	return &IssueTimelineService{
+		issueRepo:   issueRepo,
+		onIssueRepo: onIssueRepo,
+	}
+}
+
+func (s *IssueTimelineService) GetIssueTimeline(ctx context.Context, req *pb.GetIssueTimelineRequest) (*pb.GetIssueTimelineResponse, error) {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+	history, err := s.onIssueRepo.ListOnIssueStatusResponses(ctx, issue.IssueID)
+	if err != nil {
+		return nil, err
+	}
+
+	// internal audit entries are for support; customers never see them
+	includeInternal := req.IncludeInternal && auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService) == nil
+	return &pb.GetIssueTimelineResponse{
+		IssueId: issue.IssueID,
+		Events:  buildTimeline(issue, history, includeInternal),
+	}, nil
+}
+
+type timelineEntry struct {
+	at    time.Time
+	event *pb.TimelineEvent
+}
+
+// buildTimeline orders events by time. Callbacks replay the full respondent
+// action list, so respondent actions are de-duplicated across the history.
+func buildTimeline(issue *models.Issue, history []*models.OnIssueStatusResponse, includeInternal bool) []*pb.TimelineEvent {
+	var entries []timelineEntry
+	add := func(at time.Time, event *pb.TimelineEvent) {
+		event.At = at.Format(time.RFC3339)
+		entries = append(entries, timelineEntry{at: at, event: event})
+	}
+
+	for _, entry := range mapper.ParseComplainantActions(issue.ComplainantActions) {
+		if entry.Internal && !includeInternal {
+			continue
+		}
+		action := entry.Action
+		role := ActorComplainant
+		if entry.Internal || action.GetUpdatedBy().GetPerson().GetName() == "system" {
+			role = ActorSystem
+		}
+		add(parseTimelineTime(action.GetUpdatedAt(), issue.CreatedAt), &pb.TimelineEvent{
+			Type:      TimelineComplainantAction,
+			Action:    action.GetComplainantAction(),
+			ShortDesc: action.GetShortDesc(),
+			Actor:     &pb.TimelineActor{Role: role, UpdatedBy: action.GetUpdatedBy()},
+			Internal:  entry.Internal,
+		})
+	}
+
+	seen := map[string]bool{}
+	addRespondentActions := func(actions []*pb.RespondentAction, fallback time.Time) {
+		for _, action := range actions {
+			key := strings.Join([]string{action.GetRespondentAction(), action.GetUpdatedAt(),
+				fmt.Sprint(action.GetCascadedLevel()), action.GetShortDesc()}, "|")
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			add(parseTimelineTime(action.GetUpdatedAt(), fallback), &pb.TimelineEvent{
+				Type:      TimelineRespondentAction,
+				Action:    action.GetRespondentAction(),
+				ShortDesc: action.GetShortDesc(),
+				Actor: &pb.TimelineActor{
+					Role:          ActorRespondent,
+					UpdatedBy:     action.GetUpdatedBy(),
+					CascadedLevel: action.GetCascadedLevel(),
+				},
+			})
+		}
+	}
+
+	var lastResolution *pb.Resolution
+	for _, row := range history {
+		add(row.CreatedAt, &pb.TimelineEvent{
+			Type:      TimelineStatusCallback,
+			MessageId: row.MessageID,
+			Actor:     &pb.TimelineActor{Role: ActorRespondent},
+		})
+		addRespondentActions(mapper.ParseRespondentActions(row.RespondentActions), row.CreatedAt)
+
+		res := mapper.ParseResolution(row.Resolution)
+		if res != nil && !proto.Equal(res, lastResolution) {
+			lastResolution = res
+			add(row.CreatedAt, &pb.TimelineEvent{
+				Type:       TimelineResolutionUpdated,
+				ShortDesc:  res.GetShortDesc(),
+				Resolution: res,
+				Actor:      &pb.TimelineActor{Role: ActorRespondent},
+			})
+		}
+	}
+
+	// the issue row may be ahead of the history when saving history failed
+	addRespondentActions(mapper.ParseRespondentActions(issue.RespondentActions), issue.UpdatedAt)
+	if res := mapper.ParseResolution(issue.Resolution); res != nil && !proto.Equal(res, lastResolution) {
+		add(issue.UpdatedAt, &pb.TimelineEvent{
+			Type:       TimelineResolutionUpdated,
+			ShortDesc:  res.GetShortDesc(),
+			Resolution: res,
+			Actor:      &pb.TimelineActor{Role: ActorRespondent},
+		})
+	}
+
+	if issue.LastStatusPollAt != nil {
+		add(*issue.LastStatusPollAt, &pb.TimelineEvent{
+			Type:   TimelineStatusPoll,
+			Action: "issue_status",
+			Actor:  &pb.TimelineActor{Role: ActorSystem},
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].at.Before(entries[j].at) })
+	events := make([]*pb.TimelineEvent, 0, len(entries))
+	for _, e := range entries {
+		events = append(events, e.event)
+	}
+	return events
+}
+
+func parseTimelineTime(value string, fallback time.Time) time.Time {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t
+	}
+	return fallback
+}
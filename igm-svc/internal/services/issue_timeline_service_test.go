@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestBuildTimeline(t *testing.T) {
+	created := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	issue := &models.Issue{
+		IssueID:   "issue-1",
+		CreatedAt: created,
+		UpdatedAt: created.Add(3 * time.Hour),
+		ComplainantActions: datatypes.JSON(`[
+			{"complainant_action":"OPEN","short_desc":"item damaged","updated_at":"2026-01-01T09:00:00Z"},
+			{"complainant_action":"SLA_BREACHED","updated_at":"2026-01-01T11:30:00Z","internal":true}
+		]`),
+	}
+	history := []*models.OnIssueStatusResponse{
+		{
+			MessageID:         "m1",
+			CreatedAt:         created.Add(time.Hour),
+			RespondentActions: datatypes.JSON(`{"respondentActions":[{"respondentAction":"PROCESSING","updatedAt":"2026-01-01T09:50:00Z"}]}`),
+		},
+		{
+			MessageID: "m2",
+			CreatedAt: created.Add(2 * time.Hour),
+			RespondentActions: datatypes.JSON(`{"respondentActions":[
+				{"respondentAction":"PROCESSING","updatedAt":"2026-01-01T09:50:00Z"},
+				{"respondentAction":"RESOLVED","updatedAt":"2026-01-01T10:55:00Z"}]}`),
+			Resolution: datatypes.JSON(`{"shortDesc":"refund issued"}`),
+		},
+	}
+
+	events := buildTimeline(issue, history, false)
+	var types []string
+	for _, e := range events {
+		types = append(types, e.Type+":"+e.Action)
+	}
+	require.Equal(t, []string{
+		"COMPLAINANT_ACTION:OPEN",
+		"RESPONDENT_ACTION:PROCESSING",
+		"STATUS_CALLBACK:",
+		"RESPONDENT_ACTION:RESOLVED",
+		"STATUS_CALLBACK:",
+		"RESOLUTION_UPDATED:",
+	}, types)
+	assert.Equal(t, "refund issued", events[5].Resolution.GetShortDesc())
+
+	withInternal := buildTimeline(issue, history, true)
+	require.Len(t, withInternal, 7)
+	last := withInternal[6]
+	assert.Equal(t, "SLA_BREACHED", last.Action)
+	assert.True(t, last.Internal)
+	assert.Equal(t, ActorSystem, last.Actor.Role)
+}
+
+type fakeTimelineIssueRepo struct {
+	repository.IssueRepository
+	issue *models.Issue
+}
+
+func (f *fakeTimelineIssueRepo) GetIssueExistByIssueID(issueID string, userID uuid.UUID) (*models.Issue, error) {
+	if issueID != f.issue.IssueID || userID != f.issue.UserID {
+		return nil, repository.ErrIssueNotFound
+	}
+	return f.issue, nil
+}
+
+type fakeStatusHistoryRepo struct {
+	repository.OnIssueRepository
+}
+
+func (fakeStatusHistoryRepo) ListOnIssueStatusResponses(ctx context.Context, issueID string) ([]*models.OnIssueStatusResponse, error) {
+	return nil, nil
+}
+
+func TestGetIssueTimeline_InternalEntriesForSupportOnly(t *testing.T) {
+	userID := uuid.New()
+	issue := &models.Issue{
+		IssueID:   "issue-1",
+		UserID:    userID,
+		CreatedAt: time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC),
+		ComplainantActions: datatypes.JSON(`[
+			{"complainant_action":"OPEN","updated_at":"2026-01-01T09:00:00Z"},
+			{"complainant_action":"ESCALATION_FAILED","updated_at":"2026-01-01T11:30:00Z","internal":true}
+		]`),
+	}
+	svc := NewIssueTimelineService(&fakeTimelineIssueRepo{issue: issue}, fakeStatusHistoryRepo{})
+	req := &pb.GetIssueTimelineRequest{UserId: userID.String(), IssueId: "issue-1", IncludeInternal: true}
+
+	userCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	resp, err := svc.GetIssueTimeline(userCtx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 1, "customers do not get internal entries")
+	assert.Equal(t, "OPEN", resp.Events[0].Action)
+
+	supportCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "agent-7", Role: auth.RoleSupport})
+	resp, err = svc.GetIssueTimeline(supportCtx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Events, 2)
+	assert.Equal(t, "ESCALATION_FAILED", resp.Events[1].Action)
+}
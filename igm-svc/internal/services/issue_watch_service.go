@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/eventbus"
+	"log"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	watchBlock     = 5 * time.Second
+	watchBatchSize = 100
+	// watchOwnerCacheSize bounds the ownership lookups a WatchUserIssues
+	// stream remembers for events that do not carry a user_id.
+	watchOwnerCacheSize = 1000
+)
+
+// IssueWatchService streams issue events from the Redis event stream to
+// connected clients.
+type IssueWatchService struct {
+	issueRepo repository.IssueRepository
+	redisRepo repository.RedisRepository
+}
+
+func NewIssueWatchService(issueRepo repository.IssueRepository, redisRepo repository.RedisRepository) *IssueWatchService {
+	return &IssueWatchService{
+		issueRepo: issueRepo,
+		redisRepo: redisRepo,
+	}
+}
+
+// WatchIssue sends every event for one issue until ctx is cancelled or send
+// fails.
+func (s *IssueWatchService) WatchIssue(ctx context.Context, req *pb.WatchIssueRequest, send func(*pb.IssueEvent) error) error {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return err
+	}
+	if _, err := s.issueRepo.GetIssueExistByIssueID(req.IssueId, userID); err != nil {
+		return fmt.Errorf("failed to load issue %s: %w", req.IssueId, err)
+	}
+
+	return s.watch(ctx, eventbus.IssueStream(req.IssueId), req.AfterCursor, func(record eventbus.Event) bool {
+		return record.IssueID() == req.IssueId
+	}, send)
+}
+
+// WatchUserIssues sends events for every issue the user owns, including
+// issues created after the stream was opened.
+func (s *IssueWatchService) WatchUserIssues(ctx context.Context, req *pb.WatchUserIssuesRequest, send func(*pb.IssueEvent) error) error {
+	userID, err := auth.ResolveUserID(ctx, req.UserId)
+	if err != nil {
+		return err
+	}
+
+	owned := map[string]bool{}
+	return s.watch(ctx, eventbus.GlobalStream, req.AfterCursor, func(record eventbus.Event) bool {
+		issueID := record.IssueID()
+		if issueID == "" {
+			return false
+		}
+		if owner, _ := record.Payload["user_id"].(string); owner != "" {
+			return owner == userID.String()
+		}
+		// callbacks from the BPP name only the issue
+		mine, ok := owned[issueID]
+		if !ok {
+			_, err := s.issueRepo.GetIssueExistByIssueID(issueID, userID)
+			mine = err == nil
+			if len(owned) >= watchOwnerCacheSize {
+				owned = map[string]bool{}
+			}
+			owned[issueID] = mine
+		}
+		return mine
+	}, send)
+}
+
+// watch tails stream from cursor. Cursors are ids of that stream, so a
+// WatchIssue cursor cannot resume WatchUserIssues and vice versa.
+func (s *IssueWatchService) watch(ctx context.Context, stream, cursor string, match func(record eventbus.Event) bool, send func(*pb.IssueEvent) error) error {
+	if s.redisRepo == nil {
+		return status.Error(codes.Unavailable, "event stream is not configured")
+	}
+	if cursor == "" {
+		latest, err := s.redisRepo.LatestIssueEventID(ctx, stream)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "failed to open event stream: %v", err)
+		}
+		cursor = latest
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+		records, err := s.redisRepo.ReadIssueEvents(ctx, stream, cursor, watchBlock, watchBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("[IssueWatchService] failed to read events after %s: %v", cursor, err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		for _, record := range records {
+			cursor = record.ID
+			if !match(record) {
+				continue
+			}
+			if err := send(toProtoIssueEvent(record)); err != nil {
+				return fmt.Errorf("failed to send event: %w", err)
+			}
+		}
+	}
+}
+
+// toProtoIssueEvent types the well-known fields of a stored event map; other
+// scalar fields are passed through as attributes.
+func toProtoIssueEvent(record eventbus.Event) *pb.IssueEvent {
+	event := &pb.IssueEvent{
+		Cursor:     record.ID,
+		Attributes: map[string]string{},
+	}
+	for key, value := range record.Payload {
+		switch key {
+		case "issue_id":
+			event.IssueId, _ = value.(string)
+		case "transaction_id":
+			event.TransactionId, _ = value.(string)
+		case "action":
+			event.Action, _ = value.(string)
+		case "status":
+			event.Status, _ = value.(string)
+		case "timestamp":
+			event.Timestamp, _ = value.(string)
+		case "respondent_actions":
+			if b, err := json.Marshal(value); err == nil {
+				event.RespondentActions = mapper.ParseRespondentActions(b)
+			}
+		case "resolution":
+			if b, err := json.Marshal(value); err == nil {
+				event.Resolution = mapper.ParseResolution(b)
+			}
+		case "resolution_provider":
+			if b, err := json.Marshal(value); err == nil {
+				var rp pb.ResolutionProvider
+				if protojson.Unmarshal(b, &rp) == nil {
+					event.ResolutionProvider = &rp
+				}
+			}
+		default:
+			switch v := value.(type) {
+			case string:
+				event.Attributes[key] = v
+			case bool, float64:
+				event.Attributes[key] = fmt.Sprint(v)
+			}
+		}
+	}
+	return event
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"testing"
+
+	"igm-svc/pkg/eventbus"
+	"igm-svc/pkg/redistest"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToProtoIssueEvent(t *testing.T) {
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"action": "on_issue_status",
+		"issue_id": "issue-1",
+		"transaction_id": "txn-1",
+		"status": "OPEN",
+		"timestamp": "2026-01-01T10:00:00Z",
+		"message_id": "msg-1",
+		"ondc_sent": true,
+		"respondent_actions": {"respondentActions": [{"respondentAction": "PROCESSING", "cascadedLevel": 1}]},
+		"resolution": {"shortDesc": "refund issued"}
+	}`), &payload))
+
+	event := toProtoIssueEvent(eventbus.Event{ID: "1700000000000-0", Payload: payload})
+
+	assert.Equal(t, "1700000000000-0", event.Cursor)
+	assert.Equal(t, "issue-1", event.IssueId)
+	assert.Equal(t, "on_issue_status", event.Action)
+	require.Len(t, event.RespondentActions, 1)
+	assert.Equal(t, "PROCESSING", event.RespondentActions[0].RespondentAction)
+	assert.Equal(t, "refund issued", event.Resolution.GetShortDesc())
+	assert.Equal(t, "msg-1", event.Attributes["message_id"])
+	assert.Equal(t, "true", event.Attributes["ondc_sent"])
+}
+
+type fakeIssueOwners struct {
+	repository.IssueRepository
+	owner   map[string]uuid.UUID
+	lookups int
+}
+
+func (f *fakeIssueOwners) GetIssueExistByIssueID(issueID string, userID uuid.UUID) (*models.Issue, error) {
+	f.lookups++
+	if f.owner[issueID] != userID {
+		return nil, repository.ErrIssueNotFound
+	}
+	return &models.Issue{IssueID: issueID, UserID: userID}, nil
+}
+
+func TestWatchUserIssues_FiltersOnEventOwner(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	userID, otherID := uuid.New(), uuid.New()
+	issues := &fakeIssueOwners{owner: map[string]uuid.UUID{"mine-callback": userID}}
+	redisRepo := repository.NewRedisRepository(redistest.NewClient(t), eventbus.PublisherConfig{})
+	for _, payload := range []map[string]interface{}{
+		{"action": "issue", "issue_id": "mine", "user_id": userID.String()},
+		{"action": "issue", "issue_id": "theirs", "user_id": otherID.String()},
+		{"action": "on_issue", "issue_id": "theirs-callback"},
+		{"action": "on_issue", "issue_id": "mine-callback"},
+		{"action": "on_issue", "issue_id": "mine-callback"},
+	} {
+		require.NoError(t, redisRepo.SaveIssueResponse(ctx, "txn-1", payload))
+	}
+
+	svc := NewIssueWatchService(issues, redisRepo)
+	var got []string
+	err := svc.WatchUserIssues(auth.WithPrincipal(ctx, &auth.Principal{Role: auth.RoleUser, UserID: userID}),
+		&pb.WatchUserIssuesRequest{AfterCursor: "0-0"}, func(event *pb.IssueEvent) error {
+			got = append(got, event.IssueId)
+			if len(got) == 3 {
+				cancel()
+			}
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mine", "mine-callback", "mine-callback"}, got)
+	assert.Equal(t, 2, issues.lookups, "only events without a user_id are looked up, once per issue")
+}
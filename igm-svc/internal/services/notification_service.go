@@ -0,0 +1,326 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/notify"
+	"log"
+	"strconv"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+)
+
+// notificationChannels is the order channels are attempted in.
+var notificationChannels = []string{notify.ChannelSMS, notify.ChannelEmail, notify.ChannelPush}
+
+// sendingLease is how long a worker owns a claimed notification before
+// another worker treats the send as abandoned and retries it.
+const sendingLease = 5 * time.Minute
+
+// NotificationService turns issue domain events into customer notifications
+// and records each one, sent or not.
+type NotificationService struct {
+	issueRepo        repository.IssueRepository
+	notificationRepo repository.NotificationRepository
+	notifiers        map[string]notify.Notifier
+	templates        *NotificationTemplates
+}
+
+func NewNotificationService(issueRepo repository.IssueRepository,
+	notificationRepo repository.NotificationRepository,
+	notifiers map[string]notify.Notifier,
+	templates *NotificationTemplates,
+) *NotificationService {
+	return &NotificationService{
+		issueRepo:        issueRepo,
+		notificationRepo: notificationRepo,
+		notifiers:        notifiers,
+		templates:        templates,
+	}
+}
+
+// HandleEvent is an events.Handler. It only records notifications;
+// NotificationWorker sends them. A redelivered event finds its notifications
+// already recorded and adds nothing.
+func (s *NotificationService) HandleEvent(ctx context.Context, event *eventsv1.DomainEvent) error {
+	kind, data := notificationForEvent(event)
+	if kind == "" {
+		return nil
+	}
+
+	issue, err := s.issueRepo.GetByIssueID(ctx, event.GetIssueId())
+	if errors.Is(err, repository.ErrNotFound) {
+		log.Printf("[NotificationService] issue %s not found, dropping %s", event.GetIssueId(), kind)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load issue: %w", err)
+	}
+	pref, err := s.notificationRepo.GetPreferences(ctx, issue.UserID)
+	if err != nil {
+		return err
+	}
+	if pref == nil {
+		pref = defaultNotificationPreference(issue.UserID)
+	}
+
+	data.IssueID = issue.IssueID
+	data.OrderID = issue.OrderID
+	data.Category = issue.Category
+	data.UserName = issue.UserName
+
+	now := time.Now()
+	for _, channel := range notificationChannels {
+		n := &models.Notification{
+			EventID: event.GetEventId(),
+			IssueID: issue.IssueID,
+			UserID:  issue.UserID,
+			Kind:    kind,
+			Channel: channel,
+		}
+		s.prepare(n, issue, pref, data, now)
+
+		if _, err := s.notificationRepo.Create(ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepare fills recipient, content and initial status of n.
+func (s *NotificationService) prepare(n *models.Notification, issue *models.Issue, pref *models.NotificationPreference, data NotificationData, now time.Time) {
+	var enabled bool
+	switch n.Channel {
+	case notify.ChannelSMS:
+		enabled, n.Recipient = pref.SMSEnabled, issue.UserPhone
+	case notify.ChannelEmail:
+		enabled, n.Recipient = pref.EmailEnabled, issue.UserEmail
+	case notify.ChannelPush:
+		enabled, n.Recipient = pref.PushEnabled, pref.PushToken
+	}
+
+	switch {
+	case !enabled:
+		n.Status, n.Error = models.NotificationStatusSkipped, "channel disabled by user"
+		return
+	case n.Recipient == "":
+		n.Status, n.Error = models.NotificationStatusSkipped, "no recipient"
+		return
+	case s.notifiers[n.Channel] == nil:
+		n.Status, n.Error = models.NotificationStatusSkipped, "channel not configured"
+		return
+	}
+
+	subject, body, err := s.templates.Render(n.Kind, n.Channel, data)
+	if err != nil {
+		n.Status, n.Error = models.NotificationStatusFailed, err.Error()
+		return
+	}
+	n.Subject, n.Body = subject, body
+
+	// email does not interrupt anyone, so it ignores quiet hours
+	if n.Channel != notify.ChannelEmail {
+		if until, quiet := quietHoursEnd(pref, now); quiet {
+			n.Status, n.SendAfter = models.NotificationStatusDeferred, &until
+			return
+		}
+	}
+	n.Status, n.SendAfter = models.NotificationStatusPending, &now
+}
+
+func (s *NotificationService) deliver(ctx context.Context, n *models.Notification) {
+	err := s.notifiers[n.Channel].Send(ctx, notify.Message{
+		Reference: strconv.FormatUint(uint64(n.ID), 10),
+		Channel:   n.Channel,
+		Recipient: n.Recipient,
+		Subject:   n.Subject,
+		Body:      n.Body,
+	})
+	if err != nil {
+		log.Printf("[NotificationService] failed to send %s %s for %s: %v", n.Kind, n.Channel, n.IssueID, err)
+		n.Status, n.Error = models.NotificationStatusFailed, err.Error()
+	} else {
+		now := time.Now()
+		n.Status, n.Error, n.SentAt = models.NotificationStatusSent, "", &now
+	}
+	if err := s.notificationRepo.Update(ctx, n); err != nil {
+		log.Printf("[NotificationService] %v", err)
+	}
+}
+
+// SendDue claims and sends pending notifications, deferred ones whose quiet
+// hours are over and claims abandoned by a stopped worker.
+func (s *NotificationService) SendDue(ctx context.Context, now time.Time, limit int) {
+	due, err := s.notificationRepo.ClaimDue(ctx, now, sendingLease, limit)
+	if err != nil {
+		log.Printf("[NotificationService] %v", err)
+		return
+	}
+	for _, n := range due {
+		if s.notifiers[n.Channel] == nil {
+			n.Status, n.Error = models.NotificationStatusSkipped, "channel not configured"
+			if err := s.notificationRepo.Update(ctx, n); err != nil {
+				log.Printf("[NotificationService] %v", err)
+			}
+			continue
+		}
+		s.deliver(ctx, n)
+	}
+}
+
+func (s *NotificationService) GetNotificationPreferences(ctx context.Context, req *pb.GetNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+	userID, err := auth.ResolveUserID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	pref, err := s.notificationRepo.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if pref == nil {
+		pref = defaultNotificationPreference(userID)
+	}
+	return mapper.ToProtoNotificationPreferences(pref), nil
+}
+
+func (s *NotificationService) UpdateNotificationPreferences(ctx context.Context, req *pb.UpdateNotificationPreferencesRequest) (*pb.NotificationPreferences, error) {
+	if err := ValidateNotificationPreferences(req.GetPreferences()); err != nil {
+		return nil, err
+	}
+	p := req.Preferences
+	userID, err := auth.ResolveUserID(ctx, p.UserId)
+	if err != nil {
+		return nil, err
+	}
+	pref := &models.NotificationPreference{
+		UserID:          userID,
+		SMSEnabled:      p.SmsEnabled,
+		EmailEnabled:    p.EmailEnabled,
+		PushEnabled:     p.PushEnabled,
+		PushToken:       p.PushToken,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		Timezone:        p.Timezone,
+	}
+	if err := s.notificationRepo.SavePreferences(ctx, pref); err != nil {
+		return nil, err
+	}
+	return mapper.ToProtoNotificationPreferences(pref), nil
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, req *pb.ListNotificationsRequest) (*pb.ListNotificationsResponse, error) {
+	userID, err := auth.ResolveUserID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	ns, err := s.notificationRepo.ListByUser(ctx, userID, req.GetIssueId(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListNotificationsResponse{Notifications: mapper.ToProtoNotifications(ns)}, nil
+}
+
+func defaultNotificationPreference(userID uuid.UUID) *models.NotificationPreference {
+	return &models.NotificationPreference{
+		UserID:       userID,
+		SMSEnabled:   true,
+		EmailEnabled: true,
+		PushEnabled:  true,
+	}
+}
+
+// notificationForEvent picks the notification kind for a domain event and
+// the event-specific template data. Events customers are not told about
+// return an empty kind.
+func notificationForEvent(event *eventsv1.DomainEvent) (string, NotificationData) {
+	switch p := event.GetPayload().(type) {
+	case *eventsv1.DomainEvent_IssueCreated:
+		return models.NotificationCreated, NotificationData{}
+	case *eventsv1.DomainEvent_RespondentActionReceived:
+		data := NotificationData{ShortDesc: p.RespondentActionReceived.GetShortDesc()}
+		switch p.RespondentActionReceived.GetRespondentAction() {
+		case "PROCESSING":
+			return models.NotificationRespondentProcessing, data
+		case models.InfoActionNeedMoreInfo:
+			return models.NotificationNeedMoreInfo, data
+		}
+	case *eventsv1.DomainEvent_IssueResolved:
+		return models.NotificationResolved, NotificationData{
+			ShortDesc:    p.IssueResolved.GetShortDesc(),
+			RefundAmount: p.IssueResolved.GetRefundAmount(),
+		}
+	case *eventsv1.DomainEvent_IssueClosed:
+		if p.IssueClosed.GetSource() == CloseSourceAutoClose {
+			return models.NotificationAutoClosed, NotificationData{}
+		}
+	case *eventsv1.DomainEvent_IssueSlaBreached:
+		return models.NotificationSLABreached, NotificationData{
+			Breach:   p.IssueSlaBreached.GetBreach(),
+			Deadline: p.IssueSlaBreached.GetDeadline(),
+		}
+	}
+	return "", NotificationData{}
+}
+
+// quietHoursEnd reports whether now falls in the user's quiet hours and, if
+// so, when they end. Windows may wrap midnight (22:00-07:00).
+func quietHoursEnd(pref *models.NotificationPreference, now time.Time) (time.Time, bool) {
+	if pref.QuietHoursStart == "" || pref.QuietHoursEnd == "" {
+		return time.Time{}, false
+	}
+	start, err := parseClock(pref.QuietHoursStart)
+	if err != nil {
+		return time.Time{}, false
+	}
+	end, err := parseClock(pref.QuietHoursEnd)
+	if err != nil || start == end {
+		return time.Time{}, false
+	}
+	loc := time.UTC
+	if pref.Timezone != "" {
+		if l, err := time.LoadLocation(pref.Timezone); err == nil {
+			loc = l
+		}
+	}
+
+	local := now.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	sinceMidnight := local.Sub(midnight)
+
+	if start < end {
+		if sinceMidnight >= start && sinceMidnight < end {
+			return midnight.Add(end), true
+		}
+		return time.Time{}, false
+	}
+	// wraps midnight
+	if sinceMidnight >= start {
+		return midnight.AddDate(0, 0, 1).Add(end), true
+	}
+	if sinceMidnight < end {
+		return midnight.Add(end), true
+	}
+	return time.Time{}, false
+}
+
+// parseClock parses HH:MM into the offset from midnight.
+func parseClock(v string) (time.Duration, error) {
+	t, err := time.Parse("15:04", v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", v)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
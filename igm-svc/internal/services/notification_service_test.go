@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/notify"
+	"testing"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIssueLookup struct {
+	repository.IssueRepository
+	issues map[string]*models.Issue
+}
+
+func (f *fakeIssueLookup) GetByIssueID(ctx context.Context, issueID string) (*models.Issue, error) {
+	if issue, ok := f.issues[issueID]; ok {
+		return issue, nil
+	}
+	return nil, repository.ErrIssueNotFound
+}
+
+type fakeNotificationRepo struct {
+	repository.NotificationRepository
+	prefs map[uuid.UUID]*models.NotificationPreference
+	rows  []*models.Notification
+}
+
+func (f *fakeNotificationRepo) GetPreferences(ctx context.Context, userID uuid.UUID) (*models.NotificationPreference, error) {
+	return f.prefs[userID], nil
+}
+
+func (f *fakeNotificationRepo) Create(ctx context.Context, n *models.Notification) (bool, error) {
+	for _, r := range f.rows {
+		if r.EventID == n.EventID && r.Channel == n.Channel {
+			return false, nil
+		}
+	}
+	n.ID = uint(len(f.rows) + 1)
+	f.rows = append(f.rows, n)
+	return true, nil
+}
+
+func (f *fakeNotificationRepo) Update(ctx context.Context, n *models.Notification) error {
+	return nil
+}
+
+func (f *fakeNotificationRepo) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*models.Notification, error) {
+	var out []*models.Notification
+	for _, r := range f.rows {
+		switch r.Status {
+		case models.NotificationStatusPending, models.NotificationStatusDeferred, models.NotificationStatusSending:
+		default:
+			continue
+		}
+		if len(out) == limit || r.SendAfter == nil || r.SendAfter.After(now) {
+			continue
+		}
+		until := now.Add(lease)
+		r.Status, r.SendAfter = models.NotificationStatusSending, &until
+		out = append(out, r)
+	}
+	return out, nil
+}
+
+func (f *fakeNotificationRepo) byChannel(channel string) *models.Notification {
+	for _, r := range f.rows {
+		if r.Channel == channel {
+			return r
+		}
+	}
+	return nil
+}
+
+type recordingNotifier struct {
+	sent []notify.Message
+}
+
+func (r *recordingNotifier) Send(ctx context.Context, msg notify.Message) error {
+	r.sent = append(r.sent, msg)
+	return nil
+}
+
+func TestNotificationService_HandleEvent(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	issue := &models.Issue{IssueID: "issue-1", OrderID: "order-1", UserID: userID, UserName: "Asha", UserPhone: "9999999999", UserEmail: "asha@example.com"}
+	repo := &fakeNotificationRepo{prefs: map[uuid.UUID]*models.NotificationPreference{
+		userID: {UserID: userID, SMSEnabled: false, EmailEnabled: true, PushEnabled: true, PushToken: "token-1"},
+	}}
+	sink := &recordingNotifier{}
+	templates, err := LoadNotificationTemplates("")
+	require.NoError(t, err)
+	svc := NewNotificationService(&fakeIssueLookup{issues: map[string]*models.Issue{"issue-1": issue}}, repo, map[string]notify.Notifier{
+		notify.ChannelSMS:   sink,
+		notify.ChannelEmail: sink,
+		notify.ChannelPush:  sink,
+	}, templates)
+
+	ia := &pb.IssueActions{RespondentActions: []*pb.RespondentAction{
+		{RespondentAction: "RESOLVED", ShortDesc: "refunded", UpdatedAt: "2025-01-01T12:00:00Z", CascadedLevel: 1},
+	}}
+	event := respondentActionEvents("issue-1", "txn-1", ia, &pb.Resolution{ShortDesc: "refund issued", RefundAmount: "100"})[1]
+	require.NoError(t, svc.HandleEvent(ctx, event))
+
+	// handling the event only records; the worker sends
+	require.Len(t, repo.rows, 3)
+	assert.Equal(t, models.NotificationStatusSkipped, repo.byChannel(notify.ChannelSMS).Status)
+	assert.Equal(t, models.NotificationStatusPending, repo.byChannel(notify.ChannelEmail).Status)
+	assert.Empty(t, sink.sent)
+
+	svc.SendDue(ctx, time.Now(), 10)
+	email := repo.byChannel(notify.ChannelEmail)
+	assert.Equal(t, models.NotificationStatusSent, email.Status)
+	assert.Equal(t, models.NotificationResolved, email.Kind)
+	assert.Equal(t, "Complaint issue-1 resolved", email.Subject)
+	assert.Contains(t, email.Body, "Refund amount: 100")
+	assert.Equal(t, models.NotificationStatusSent, repo.byChannel(notify.ChannelPush).Status)
+	assert.Len(t, sink.sent, 2)
+
+	// sent rows are not claimed again
+	svc.SendDue(ctx, time.Now(), 10)
+	assert.Len(t, sink.sent, 2)
+
+	// redelivery of the same event sends nothing more
+	require.NoError(t, svc.HandleEvent(ctx, event))
+	svc.SendDue(ctx, time.Now(), 10)
+	assert.Len(t, repo.rows, 3)
+	assert.Len(t, sink.sent, 2)
+
+	// events customers are not told about are ignored
+	require.NoError(t, svc.HandleEvent(ctx, issueStatusRequestedEvent(issue)))
+	assert.Len(t, repo.rows, 3)
+}
+
+func TestQuietHoursEnd(t *testing.T) {
+	pref := &models.NotificationPreference{QuietHoursStart: "22:00", QuietHoursEnd: "07:00", Timezone: "Asia/Kolkata"}
+	ist, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+
+	until, quiet := quietHoursEnd(pref, time.Date(2025, 1, 1, 23, 30, 0, 0, ist))
+	assert.True(t, quiet)
+	assert.Equal(t, time.Date(2025, 1, 2, 7, 0, 0, 0, ist), until)
+
+	until, quiet = quietHoursEnd(pref, time.Date(2025, 1, 2, 6, 0, 0, 0, ist))
+	assert.True(t, quiet)
+	assert.Equal(t, time.Date(2025, 1, 2, 7, 0, 0, 0, ist), until)
+
+	_, quiet = quietHoursEnd(pref, time.Date(2025, 1, 2, 12, 0, 0, 0, ist))
+	assert.False(t, quiet)
+
+	// same-day window, evaluated from UTC
+	day := &models.NotificationPreference{QuietHoursStart: "13:00", QuietHoursEnd: "14:00", Timezone: "Asia/Kolkata"}
+	until, quiet = quietHoursEnd(day, time.Date(2025, 1, 2, 8, 0, 0, 0, time.UTC)) // 13:30 IST
+	assert.True(t, quiet)
+	assert.Equal(t, time.Date(2025, 1, 2, 14, 0, 0, 0, ist), until)
+
+	_, quiet = quietHoursEnd(&models.NotificationPreference{}, time.Now())
+	assert.False(t, quiet)
+}
+
+func TestNotificationForEvent(t *testing.T) {
+	processing := &eventsv1.DomainEvent{Payload: &eventsv1.DomainEvent_RespondentActionReceived{RespondentActionReceived: &eventsv1.RespondentActionReceived{RespondentAction: "PROCESSING"}}}
+	kind, _ := notificationForEvent(processing)
+	assert.Equal(t, models.NotificationRespondentProcessing, kind)
+
+	cascaded := &eventsv1.DomainEvent{Payload: &eventsv1.DomainEvent_RespondentActionReceived{RespondentActionReceived: &eventsv1.RespondentActionReceived{RespondentAction: "CASCADED"}}}
+	kind, _ = notificationForEvent(cascaded)
+	assert.Empty(t, kind)
+
+	autoClosed := &eventsv1.DomainEvent{Payload: &eventsv1.DomainEvent_IssueClosed{IssueClosed: &eventsv1.IssueClosed{Source: CloseSourceAutoClose}}}
+	kind, _ = notificationForEvent(autoClosed)
+	assert.Equal(t, models.NotificationAutoClosed, kind)
+
+	byComplainant := &eventsv1.DomainEvent{Payload: &eventsv1.DomainEvent_IssueClosed{IssueClosed: &eventsv1.IssueClosed{Source: CloseSourceComplainant}}}
+	kind, _ = notificationForEvent(byComplainant)
+	assert.Empty(t, kind)
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/models"
+	"igm-svc/pkg/notify"
+	"os"
+	"text/template"
+)
+
+// NotificationTemplate is a text/template pair rendered with NotificationData.
+// Subject is only used for email.
+type NotificationTemplate struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// NotificationData is what templates can refer to.
+type NotificationData struct {
+	IssueID      string
+	OrderID      string
+	Category     string
+	UserName     string
+	ShortDesc    string
+	RefundAmount string
+	Breach       string
+	Deadline     string
+}
+
+var defaultNotificationTemplates = map[string]map[string]NotificationTemplate{
+	models.NotificationCreated: {
+		notify.ChannelSMS:   {Body: "We have registered your complaint {{.IssueID}} for order {{.OrderID}}. We will update you on progress."},
+		notify.ChannelEmail: {Subject: "Complaint {{.IssueID}} registered", Body: "Hi {{.UserName}},\n\nYour complaint {{.IssueID}} about order {{.OrderID}} ({{.Category}}) has been registered and sent to the seller.\n\nWe will keep you posted."},
+		notify.ChannelPush:  {Body: "Complaint {{.IssueID}} registered"},
+	},
+	models.NotificationRespondentProcessing: {
+		notify.ChannelSMS:   {Body: "Your complaint {{.IssueID}} is being processed by the seller.{{if .ShortDesc}} Update: {{.ShortDesc}}{{end}}"},
+		notify.ChannelEmail: {Subject: "Complaint {{.IssueID}} is being processed", Body: "Hi {{.UserName}},\n\nThe seller is working on your complaint {{.IssueID}}.{{if .ShortDesc}}\n\nUpdate: {{.ShortDesc}}{{end}}"},
+		notify.ChannelPush:  {Body: "Complaint {{.IssueID}} is being processed"},
+	},
+	models.NotificationNeedMoreInfo: {
+		notify.ChannelSMS:   {Body: "The seller needs more information on complaint {{.IssueID}}: {{.ShortDesc}}. Please reply in the app."},
+		notify.ChannelEmail: {Subject: "More information needed for complaint {{.IssueID}}", Body: "Hi {{.UserName}},\n\nThe seller needs more information to resolve complaint {{.IssueID}}:\n\n{{.ShortDesc}}\n\nPlease reply in the app."},
+		notify.ChannelPush:  {Body: "More information needed for complaint {{.IssueID}}"},
+	},
+	models.NotificationResolved: {
+		notify.ChannelSMS:   {Body: "Your complaint {{.IssueID}} has been resolved: {{.ShortDesc}}{{if .RefundAmount}} Refund: {{.RefundAmount}}{{end}}. Accept or reject in the app."},
+		notify.ChannelEmail: {Subject: "Complaint {{.IssueID}} resolved", Body: "Hi {{.UserName}},\n\nThe seller has resolved complaint {{.IssueID}}:\n\n{{.ShortDesc}}{{if .RefundAmount}}\nRefund amount: {{.RefundAmount}}{{end}}\n\nPlease accept or reject the resolution in the app."},
+		notify.ChannelPush:  {Body: "Complaint {{.IssueID}} resolved, please review"},
+	},
+	models.NotificationAutoClosed: {
+		notify.ChannelSMS:   {Body: "Your complaint {{.IssueID}} was closed as we did not hear back on the resolution."},
+		notify.ChannelEmail: {Subject: "Complaint {{.IssueID}} closed", Body: "Hi {{.UserName}},\n\nComplaint {{.IssueID}} was closed automatically because the resolution was not accepted or rejected in time."},
+		notify.ChannelPush:  {Body: "Complaint {{.IssueID}} closed"},
+	},
+	models.NotificationSLABreached: {
+		notify.ChannelSMS:   {Body: "The seller has not responded to complaint {{.IssueID}} in time. We are following up."},
+		notify.ChannelEmail: {Subject: "Complaint {{.IssueID}} is overdue", Body: "Hi {{.UserName}},\n\nThe seller missed the {{.Breach}} deadline{{if .Deadline}} ({{.Deadline}}){{end}} for complaint {{.IssueID}}. We are following up on your behalf."},
+		notify.ChannelPush:  {Body: "Complaint {{.IssueID}} is overdue, we are following up"},
+	},
+}
+
+type parsedTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+// NotificationTemplates holds the parsed template for each kind and channel.
+type NotificationTemplates struct {
+	templates map[string]map[string]parsedTemplate
+}
+
+// LoadNotificationTemplates parses the built-in templates, overridden per kind
+// and channel by path when set. The file has the same shape as
+// defaultNotificationTemplates.
+func LoadNotificationTemplates(path string) (*NotificationTemplates, error) {
+	raw := map[string]map[string]NotificationTemplate{}
+	for kind, channels := range defaultNotificationTemplates {
+		raw[kind] = map[string]NotificationTemplate{}
+		for channel, t := range channels {
+			raw[kind][channel] = t
+		}
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read notification templates file: %w", err)
+		}
+		var overrides map[string]map[string]NotificationTemplate
+		if err := json.Unmarshal(data, &overrides); err != nil {
+			return nil, fmt.Errorf("failed to parse notification templates file: %w", err)
+		}
+		for kind, channels := range overrides {
+			if raw[kind] == nil {
+				raw[kind] = map[string]NotificationTemplate{}
+			}
+			for channel, t := range channels {
+				raw[kind][channel] = t
+			}
+		}
+	}
+
+	parsed := map[string]map[string]parsedTemplate{}
+	for kind, channels := range raw {
+		parsed[kind] = map[string]parsedTemplate{}
+		for channel, t := range channels {
+			name := kind + "/" + channel
+			subject, err := template.New(name + "/subject").Parse(t.Subject)
+			if err != nil {
+				return nil, fmt.Errorf("invalid subject template %s: %w", name, err)
+			}
+			body, err := template.New(name + "/body").Parse(t.Body)
+			if err != nil {
+				return nil, fmt.Errorf("invalid body template %s: %w", name, err)
+			}
+			parsed[kind][channel] = parsedTemplate{subject: subject, body: body}
+		}
+	}
+	return &NotificationTemplates{templates: parsed}, nil
+}
+
+// Render returns the subject and body for kind on channel.
+func (t *NotificationTemplates) Render(kind, channel string, data NotificationData) (string, string, error) {
+	tmpl, ok := t.templates[kind][channel]
+	if !ok {
+		return "", "", fmt.Errorf("no %s template for %s", channel, kind)
+	}
+	var subject, body bytes.Buffer
+	if err := tmpl.subject.Execute(&subject, data); err != nil {
+		return "", "", fmt.Errorf("failed to render subject: %w", err)
+	}
+	if err := tmpl.body.Execute(&body, data); err != nil {
+		return "", "", fmt.Errorf("failed to render body: %w", err)
+	}
+	return subject.String(), body.String(), nil
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type NotificationWorkerConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// NotificationWorker sends recorded notifications, including ones held back
+// by quiet hours and ones whose send was interrupted.
+type NotificationWorker struct {
+	notificationService *NotificationService
+	workerCfg           NotificationWorkerConfig
+}
+
+func NewNotificationWorker(notificationService *NotificationService, workerCfg NotificationWorkerConfig) *NotificationWorker {
+	if workerCfg.Interval <= 0 {
+		workerCfg.Interval = 5 * time.Second
+	}
+	if workerCfg.BatchSize <= 0 {
+		workerCfg.BatchSize = 100
+	}
+	return &NotificationWorker{
+		notificationService: notificationService,
+		workerCfg:           workerCfg,
+	}
+}
+
+// Start runs the worker until ctx is cancelled.
+func (w *NotificationWorker) Start(ctx context.Context) {
+	log.Printf("[NotificationWorker] started interval=%v", w.workerCfg.Interval)
+	ticker := time.NewTicker(w.workerCfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Printf("[NotificationWorker] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *NotificationWorker) RunOnce(ctx context.Context) {
+	w.notificationService.SendDue(ctx, time.Now(), w.workerCfg.BatchSize)
+}
@@ -6,10 +6,11 @@ import (
 	"fmt"
 	"igm-svc/internal/models"
 	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
 	"log"
 	"time"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
+	pb "igm-svc/api/proto/igm/v1"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"gorm.io/datatypes"
@@ -17,18 +18,27 @@ import (
 
 type OnIssueService struct {
 	onIssueRepo repository.OnIssueRepository
+	infoRepo    repository.IssueInfoRepository
+	respondents repository.IssueRespondentRepository
 	redisRepo   repository.RedisRepository
+	publisher   events.EventPublisher
 	OndcClient  *OndcClient
 	config      *Config
 }
 
 func NewOnIssueService(onIssueRepo repository.OnIssueRepository,
+	infoRepo repository.IssueInfoRepository,
+	respondents repository.IssueRespondentRepository,
 	redisRepo repository.RedisRepository,
+	publisher events.EventPublisher,
 	ondcClient *OndcClient,
 	config *Config) *OnIssueService {
 	return &OnIssueService{
 		onIssueRepo: onIssueRepo,
+		infoRepo:    infoRepo,
+		respondents: respondents,
 		redisRepo:   redisRepo,
+		publisher:   publisher,
 		OndcClient:  ondcClient,
 		config:      config,
 	}
@@ -74,7 +84,11 @@ func (h *OnIssueService) ProcessOnIssue(ctx context.Context, transactionID, mess
 		last := ia.RespondentActions[len(ia.RespondentActions)-1]
 		if last != nil && last.GetRespondentAction() != "" {
 			updates["respondent_status"] = last.GetRespondentAction()
+			if last.GetRespondentAction() == "RESOLVED" {
+				updates["resolved_at"] = respondentActionTime(last, now)
+			}
 		}
+		recordInfoRequests(ctx, h.infoRepo, issueID, ia)
 	}
 
 	rp := payload.Issue.GetResolutionProvider()
@@ -100,6 +114,8 @@ func (h *OnIssueService) ProcessOnIssue(ctx context.Context, transactionID, mess
 		}
 	}
 
+	updateRespondentChain(ctx, h.respondents, issueID, ia, rp, updates)
+
 	updates["updated_at"] = now
 	if h.onIssueRepo != nil {
 		onIssuestatusResponse := &models.OnIssueStatusResponse{
@@ -145,7 +161,9 @@ func (h *OnIssueService) ProcessOnIssue(ctx context.Context, transactionID, mess
 
 	}
 
-	err = h.onIssueRepo.UpdateIssueFromOnIssue(ctx, issueID, updates)
+	err = saveWithEvents(ctx, h.publisher, func(ctx context.Context) error {
+		return h.onIssueRepo.UpdateIssueFromOnIssue(ctx, issueID, updates)
+	}, respondentActionEvents(issueID, transactionID, ia, res)...)
 	if err != nil {
 		return fmt.Errorf("failed to update issue from on_issue:%w", err)
 	}
@@ -153,3 +171,12 @@ func (h *OnIssueService) ProcessOnIssue(ctx context.Context, transactionID, mess
 	return nil
 
 }
+
+// respondentActionTime prefers the BPP's updated_at so repeated callbacks for
+// the same action keep the same timestamp, falling back to receipt time.
+func respondentActionTime(action *pb.RespondentAction, fallback time.Time) time.Time {
+	if t, err := time.Parse(time.RFC3339, action.GetUpdatedAt()); err == nil {
+		return t
+	}
+	return fallback
+}
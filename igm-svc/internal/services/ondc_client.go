@@ -11,77 +11,42 @@ import (
 	"net/http"
 	"time"
 
+	pb "igm-svc/api/proto/igm/v1"
+
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type OndcClient struct {
 	httpClient   *http.Client
 	subscriberID string
 	bapURI       string
+	domain       string
+	// attachments signs the issue's attachment URLs for each payload
+	attachments *AttachmentService
 }
 
-func NewOndcClient(subscriberID, bapURI string) *OndcClient {
+func NewOndcClient(subscriberID, bapURI, domain string, attachments *AttachmentService) *OndcClient {
 	return &OndcClient{
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
 		subscriberID: subscriberID,
 		bapURI:       bapURI,
+		domain:       domain,
+		attachments:  attachments,
 	}
 }
 
 func (c *OndcClient) SendIssue(ctx context.Context, issue *models.Issue, operation string) error {
 	log.Printf("sending issue to BPP :%s", issue.BPPURI)
 
-	payload, err := c.buildIssuePayload(issue, operation)
+	payload, err := c.buildIssuePayload(ctx, issue, operation)
 	if err != nil {
 		return fmt.Errorf("failed to build the payload :%w", err)
 	}
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
-	}
-
-	log.Printf("[ONDC] Request payload: %s", string(body))
-	authHeader, err := c.createAuthHeader(body)
-	if err != nil {
-		return fmt.Errorf("failed to create auth header: %w", err)
-	}
-
-	url := issue.BPPURI
-	if url[len(url)-1] != '/' {
-		url += "/"
-	}
-	url += "issue"
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
-	if err != nil {
-		return fmt.Errorf("failed to create HTTP request: %w", err)
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("Authorization", authHeader)
-
-	resp, err := c.httpClient.Do(httpReq)
-	if err != nil {
-		return fmt.Errorf("HTTP request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	log.Printf("BPP response status: %d", resp.StatusCode)
-	log.Printf("BPP response body: %s", string(respBody))
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("BPP returned error status %d: %s", resp.StatusCode, string(respBody))
-	}
-
-	return nil
-
+	return c.post(ctx, issue.BPPURI, "issue", payload)
 }
 
 // SendIssueStatus sends issue_status request to BPP to check current status
@@ -92,24 +57,42 @@ func (c *OndcClient) SendIssueStatus(ctx context.Context, issue *models.Issue) e
 	if err != nil {
 		return fmt.Errorf("failed to build issue_status payload: %w", err)
 	}
+	return c.post(ctx, issue.BPPURI, "issue_status", payload)
+}
+
+// SendDispute forwards a DISPUTE issue to the selected ODR provider's /issue.
+func (c *OndcClient) SendDispute(ctx context.Context, issue *models.Issue, odr *models.OdrProvider) error {
+	log.Printf("Sending dispute to ODR: %s for issue: %s", odr.URI, issue.IssueID)
 
+	payload, err := c.buildIssuePayload(ctx, issue, "DISPUTE")
+	if err != nil {
+		return fmt.Errorf("failed to build dispute payload: %w", err)
+	}
+	payload["context"].(map[string]interface{})["bpp_id"] = odr.ID
+	payload["context"].(map[string]interface{})["bpp_uri"] = odr.URI
+	return c.post(ctx, odr.URI, "issue", payload)
+}
+
+func (c *OndcClient) post(ctx context.Context, baseURI, action string, payload map[string]interface{}) error {
 	body, err := json.Marshal(payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal payload: %w", err)
 	}
 
-	log.Printf("[ONDC] issue_status request payload: %s", string(body))
-
+	log.Printf("[ONDC] %s request payload: %s", action, string(body))
 	authHeader, err := c.createAuthHeader(body)
 	if err != nil {
 		return fmt.Errorf("failed to create auth header: %w", err)
 	}
 
-	url := issue.BPPURI
+	if baseURI == "" {
+		return fmt.Errorf("empty network participant uri")
+	}
+	url := baseURI
 	if url[len(url)-1] != '/' {
 		url += "/"
 	}
-	url += "issue_status"
+	url += action
 
 	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(body))
 	if err != nil {
@@ -130,11 +113,11 @@ func (c *OndcClient) SendIssueStatus(ctx context.Context, issue *models.Issue) e
 		return fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	log.Printf("BPP issue_status response status: %d", resp.StatusCode)
-	log.Printf("BPP issue_status response body: %s", string(respBody))
+	log.Printf("%s response status: %d", action, resp.StatusCode)
+	log.Printf("%s response body: %s", action, string(respBody))
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("BPP returned error status %d: %s", resp.StatusCode, string(respBody))
+		return fmt.Errorf("network participant returned error status %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	return nil
@@ -142,7 +125,7 @@ func (c *OndcClient) SendIssueStatus(ctx context.Context, issue *models.Issue) e
 
 func (c *OndcClient) buildIssueStatusPayload(issue *models.Issue) (map[string]interface{}, error) {
 	ctx := map[string]interface{}{
-		"domain":         "nic2004:60232",
+		"domain":         c.domain,
 		"country":        "IND",
 		"city":           "std:080",
 		"action":         "issue_status", 
@@ -166,9 +149,9 @@ func (c *OndcClient) buildIssueStatusPayload(issue *models.Issue) (map[string]in
 	}, nil
 }
 
-func (c *OndcClient) buildIssuePayload(issue *models.Issue, operation string) (map[string]interface{}, error) {
-	ctx := map[string]interface{}{
-		"domain":         "nic2004:60232",
+func (c *OndcClient) buildIssuePayload(ctx context.Context, issue *models.Issue, operation string) (map[string]interface{}, error) {
+	wireCtx := map[string]interface{}{
+		"domain":         c.domain,
 		"country":        "IND",
 		"city":           "std:080",
 		"action":         "issue",
@@ -182,25 +165,29 @@ func (c *OndcClient) buildIssuePayload(issue *models.Issue, operation string) (m
 		"timestamp":      time.Now().UTC().Format(time.RFC3339),
 		"ttl":            "PT30S",
 	}
-	issueBody, err := c.mapIssueToONDCFormat(issue, operation)
+	issueBody, err := c.mapIssueToONDCFormat(ctx, issue, operation)
 	if err != nil {
 		return nil, err
 	}
 
 	return map[string]interface{}{
-		"context": ctx,
+		"context": wireCtx,
 		"message": map[string]interface{}{
 			"issue": issueBody,
 		},
 	}, nil
 }
 
-func (c *OndcClient) mapIssueToONDCFormat(issue *models.Issue, operation string) (map[string]interface{}, error) {
-	var images []string
-	if len(issue.Images) > 0 {
-		if err := json.Unmarshal(issue.Images, &images); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal images: %w", err)
-		}
+func (c *OndcClient) mapIssueToONDCFormat(ctx context.Context, issue *models.Issue, operation string) (map[string]interface{}, error) {
+	// attachments are signed per payload so a resend never carries an
+	// expired URL
+	images, err := c.attachments.ImageURLs(ctx, issue.Images, issue.AttachmentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign images: %w", err)
+	}
+	descURL, err := c.attachments.DescriptionURL(ctx, issue.DescriptionURL, issue.DescriptionAttachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign additional_desc: %w", err)
 	}
 
 	var orderDetails map[string]interface{}
@@ -212,9 +199,17 @@ func (c *OndcClient) mapIssueToONDCFormat(issue *models.Issue, operation string)
 
 	var complainantActions []map[string]interface{}
 	if len(issue.ComplainantActions) > 0 {
-		if err := json.Unmarshal(issue.ComplainantActions, &complainantActions); err != nil {
+		var stored []map[string]interface{}
+		if err := json.Unmarshal(issue.ComplainantActions, &stored); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal complainant actions: %w", err)
 		}
+		// internal entries are audit records (e.g. SLA breaches), not ONDC actions
+		for _, action := range stored {
+			if internal, _ := action["internal"].(bool); internal {
+				continue
+			}
+			complainantActions = append(complainantActions, action)
+		}
 	}
 
 	baseIssue := map[string]interface{}{
@@ -241,7 +236,7 @@ func (c *OndcClient) mapIssueToONDCFormat(issue *models.Issue, operation string)
 			"short_desc": issue.DescriptionShort,
 			"long_desc":  issue.DescriptionLong,
 			"additional_desc": map[string]interface{}{
-				"url":          issue.DescriptionURL,
+				"url":          descURL,
 				"content_type": issue.DescriptionContentType,
 			},
 			"images": images,
@@ -275,11 +270,76 @@ func (c *OndcClient) mapIssueToONDCFormat(issue *models.Issue, operation string)
 		baseIssue["issue_actions"] = map[string]interface{}{
 			"complainant_actions": complainantActions,
 		}
+
+	case "INFO_PROVIDED":
+		baseIssue["status"] = issue.Status
+		baseIssue["issue_type"] = issue.IssueType
+		baseIssue["description"] = map[string]interface{}{
+			"short_desc": issue.DescriptionShort,
+			"long_desc":  issue.DescriptionLong,
+			"additional_desc": map[string]interface{}{
+				"url":          descURL,
+				"content_type": issue.DescriptionContentType,
+			},
+			"images": images,
+		}
+		baseIssue["issue_actions"] = map[string]interface{}{
+			"complainant_actions": complainantActions,
+		}
+
+	case "DISPUTE":
+		// the ODR has no prior context, so it receives the full issue along
+		// with the respondent's side of the story
+		var respondentActions interface{}
+		if actions, ok := toWireJSON(issue.RespondentActions, &pb.IssueActions{}).(map[string]interface{}); ok {
+			respondentActions = actions["respondent_actions"]
+		}
+		resolutionProvider := toWireJSON(issue.ResolutionProvider, &pb.ResolutionProvider{})
+		resolution := toWireJSON(issue.Resolution, &pb.Resolution{})
+
+		baseIssue["category"] = issue.Category
+		baseIssue["sub_category"] = issue.SubCategory
+		baseIssue["order_details"] = orderDetails
+		baseIssue["description"] = map[string]interface{}{
+			"short_desc": issue.DescriptionShort,
+			"long_desc":  issue.DescriptionLong,
+			"images":     images,
+		}
+		baseIssue["source"] = map[string]interface{}{
+			"network_participant_id": issue.SourceNPID,
+			"type":                   issue.SourceType,
+		}
+		baseIssue["status"] = issue.Status
+		baseIssue["issue_type"] = "DISPUTE"
+		baseIssue["issue_actions"] = map[string]interface{}{
+			"complainant_actions": complainantActions,
+			"respondent_actions":  respondentActions,
+		}
+		baseIssue["resolution_provider"] = resolutionProvider
+		baseIssue["resolution"] = resolution
 	}
 
 	return baseIssue, nil
 }
 
+// toWireJSON converts a JSONB column stored with protojson defaults
+// (lowerCamelCase) into the snake_case shape ONDC expects.
+func toWireJSON(raw []byte, msg proto.Message) interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := protojson.Unmarshal(raw, msg); err != nil {
+		return nil
+	}
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	var out interface{}
+	_ = json.Unmarshal(b, &out)
+	return out
+}
+
 func (c *OndcClient) createAuthHeader(body []byte) (string, error) {
 	// Placeholder - return dummy signature
 	return "Signature keyId=\"preprod.effimove.in|unique-key-id|ed25519\",algorithm=\"ed25519\",created=\"timestamp\",expires=\"timestamp\",headers=\"(created) (expires) digest\",signature=\"base64-signature\"", nil
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+func TestMapIssueToONDCFormat_SkipsInternalActions(t *testing.T) {
+	client := NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil)
+	issue := &models.Issue{
+		IssueID:   "issue-1",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		ComplainantActions: datatypes.JSON(`[
+			{"complainant_action":"OPEN","updated_at":"2026-01-01T09:00:00Z"},
+			{"complainant_action":"SLA_BREACHED","internal":true},
+			{"complainant_action":"ESCALATE","internal":false}]`),
+	}
+
+	for _, op := range []string{"OPEN", "ESCALATE", "INFO_PROVIDED", "CLOSE", "DISPUTE"} {
+		body, err := client.mapIssueToONDCFormat(context.Background(), issue, op)
+		require.NoError(t, err, op)
+		actions := body["issue_actions"].(map[string]interface{})["complainant_actions"].([]map[string]interface{})
+		require.Len(t, actions, 2, op)
+		assert.Equal(t, "OPEN", actions[0]["complainant_action"], op)
+		assert.Equal(t, "ESCALATE", actions[1]["complainant_action"], op)
+	}
+}
+
+func TestMapIssueToONDCFormat_SignsAttachmentsPerPayload(t *testing.T) {
+	userID := uuid.New()
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: auth.RoleUser, UserID: userID})
+	attachments := newTestAttachmentService(t)
+	img, err := upload(attachments, ctx, "image/png", pngBytes(t, 10, 10))
+	require.NoError(t, err)
+	doc, err := upload(attachments, ctx, "application/pdf", []byte("%PDF-1.4 receipt"))
+	require.NoError(t, err)
+	docID := uuid.MustParse(doc.AttachmentId)
+
+	client := NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", attachments)
+	issue := &models.Issue{
+		IssueID:                 "issue-1",
+		Images:                  datatypes.JSON(`["https://cdn.example/a.jpg"]`),
+		AttachmentIDs:           datatypes.JSON(`["` + img.AttachmentId + `"]`),
+		DescriptionAttachmentID: &docID,
+		DescriptionContentType:  "application/pdf",
+	}
+
+	body, err := client.mapIssueToONDCFormat(context.Background(), issue, "INFO_PROVIDED")
+	require.NoError(t, err)
+	desc := body["description"].(map[string]interface{})
+	images := desc["images"].([]string)
+	require.Len(t, images, 2)
+	assert.Equal(t, "https://cdn.example/a.jpg", images[0])
+	assert.Contains(t, images[1], img.AttachmentId+".png?expires=")
+	assert.Contains(t, desc["additional_desc"].(map[string]interface{})["url"], doc.AttachmentId+".pdf?expires=")
+}
+
+func TestOndcClient_UsesConfiguredDomain(t *testing.T) {
+	client := NewOndcClient("buyer.example", "https://buyer.example", "ONDC:RET10", nil)
+	issue := &models.Issue{IssueID: "issue-1", CreatedAt: time.Now(), UpdatedAt: time.Now()}
+
+	payload, err := client.buildIssuePayload(context.Background(), issue, "OPEN")
+	require.NoError(t, err)
+	assert.Equal(t, "ONDC:RET10", payload["context"].(map[string]interface{})["domain"])
+
+	payload, err = client.buildIssueStatusPayload(issue)
+	require.NoError(t, err)
+	assert.Equal(t, "ONDC:RET10", payload["context"].(map[string]interface{})["domain"])
+}
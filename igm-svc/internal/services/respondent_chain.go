@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"log"
+	"sort"
+	"time"
+
+	pb "igm-svc/api/proto/igm/v1"
+)
+
+const (
+	GroTypeInterfacing         = "INTERFACING-NP-GRO"
+	GroTypeTransactionCounterp = "TRANSACTION-COUNTERPARTY-NP-GRO"
+	GroTypeCascadedCounterp    = "CASCADED-COUNTERPARTY-NP-GRO"
+)
+
+// updateRespondentChain folds a callback's respondent actions and resolution
+// provider into the issue's respondent chain and moves the issue's
+// cascaded_level, and with it the escalation target, to the deepest level.
+func updateRespondentChain(ctx context.Context, respondentRepo repository.IssueRespondentRepository, issueID string,
+	ia *pb.IssueActions, rp *pb.ResolutionProvider, updates map[string]interface{}) {
+	if respondentRepo == nil || (len(ia.GetRespondentActions()) == 0 && rp == nil) {
+		return
+	}
+	existing, err := respondentRepo.ListByIssueID(ctx, issueID)
+	if err != nil {
+		log.Printf("warn: failed to load respondent chain for %s: %v", issueID, err)
+		return
+	}
+	chain, current := mergeRespondentChain(existing, issueID, ia, rp)
+	if len(chain) == 0 {
+		return
+	}
+	if err := respondentRepo.SaveChain(ctx, chain); err != nil {
+		log.Printf("warn: failed to save respondent chain for %s: %v", issueID, err)
+		return
+	}
+	updates["cascaded_level"] = current
+}
+
+// mergeRespondentChain returns the updated chain ordered by level and the level
+// currently responsible. An action's cascaded_level identifies who took it
+// (0 is treated as the BPP, level 1); a CASCADED action at level N hands the
+// issue to level N+1 even before that party has acted.
+func mergeRespondentChain(existing []*models.IssueRespondent, issueID string, ia *pb.IssueActions, rp *pb.ResolutionProvider) ([]*models.IssueRespondent, int) {
+	byLevel := map[int]*models.IssueRespondent{}
+	current := 0
+	for _, row := range existing {
+		byLevel[row.CascadedLevel] = row
+		if row.CascadedLevel > current {
+			current = row.CascadedLevel
+		}
+	}
+	level := func(n int) *models.IssueRespondent {
+		row, ok := byLevel[n]
+		if !ok {
+			row = &models.IssueRespondent{IssueID: issueID, CascadedLevel: n}
+			byLevel[n] = row
+		}
+		if n > current {
+			current = n
+		}
+		return row
+	}
+
+	for _, action := range ia.GetRespondentActions() {
+		n := int(action.GetCascadedLevel())
+		if n < 1 {
+			n = 1
+		}
+		row := level(n)
+		if by := action.GetUpdatedBy(); by != nil {
+			if name := by.GetOrg().GetName(); name != "" {
+				row.OrgName = name
+			}
+			if name := by.GetPerson().GetName(); name != "" {
+				row.PersonName = name
+			}
+			if phone := by.GetContact().GetPhone(); phone != "" {
+				row.Phone = phone
+			}
+			if email := by.GetContact().GetEmail(); email != "" {
+				row.Email = email
+			}
+		}
+		row.LastAction = action.GetRespondentAction()
+		if t, err := time.Parse(time.RFC3339, action.GetUpdatedAt()); err == nil {
+			row.LastActionAt = &t
+		}
+		if action.GetRespondentAction() == "CASCADED" {
+			level(n + 1)
+		}
+	}
+
+	info := rp.GetRespondentInfo()
+	if info != nil && current == 0 {
+		level(1)
+	}
+	if org := info.GetOrganization(); org != nil && current > 0 {
+		row := byLevel[current]
+		if row.OrgName == "" {
+			row.OrgName = org.GetOrg().GetName()
+			row.PersonName = org.GetPerson().GetName()
+			row.Phone = org.GetContact().GetPhone()
+			row.Email = org.GetContact().GetEmail()
+		}
+	}
+	for _, gro := range info.GetResolutionSupport().GetGros() {
+		var row *models.IssueRespondent
+		switch gro.GetGroType() {
+		case GroTypeInterfacing:
+			row = level(1)
+		case GroTypeTransactionCounterp:
+			// only stands in for the BPP when it has not named its own GRO
+			row = level(1)
+			if row.GroType == GroTypeInterfacing {
+				continue
+			}
+		case GroTypeCascadedCounterp:
+			if current < 2 {
+				continue
+			}
+			row = byLevel[current]
+		default:
+			continue
+		}
+		row.GroName = gro.GetPerson().GetName()
+		row.GroPhone = gro.GetContact().GetPhone()
+		row.GroEmail = gro.GetContact().GetEmail()
+		row.GroType = gro.GetGroType()
+	}
+
+	chain := make([]*models.IssueRespondent, 0, len(byLevel))
+	for _, row := range byLevel {
+		chain = append(chain, row)
+	}
+	sort.Slice(chain, func(i, j int) bool { return chain[i].CascadedLevel < chain[j].CascadedLevel })
+	return chain, current
+}
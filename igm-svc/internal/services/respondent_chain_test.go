@@ -0,0 +1,58 @@
+package services
+
+import (
+	"testing"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeRespondentChainCascaded(t *testing.T) {
+	ia := &pb.IssueActions{
+		RespondentActions: []*pb.RespondentAction{
+			{RespondentAction: "PROCESSING", UpdatedAt: "2026-01-01T10:00:00Z", CascadedLevel: 1,
+				UpdatedBy: &pb.UpdatedBy{Org: &pb.Org{Name: "seller.example.com"}}},
+			{RespondentAction: "CASCADED", UpdatedAt: "2026-01-01T11:00:00Z", CascadedLevel: 1,
+				UpdatedBy: &pb.UpdatedBy{Org: &pb.Org{Name: "seller.example.com"}}},
+		},
+	}
+	rp := &pb.ResolutionProvider{
+		RespondentInfo: &pb.ResolutionProviderInfo{
+			ResolutionSupport: &pb.ResolutionSupport{
+				Gros: []*pb.Gro{
+					{GroType: GroTypeInterfacing, Person: &pb.Person{Name: "Seller GRO"}},
+					{GroType: GroTypeCascadedCounterp, Person: &pb.Person{Name: "Logistics GRO"},
+						Contact: &pb.Contact{Email: "gro@logistics.example.com"}},
+				},
+			},
+		},
+	}
+
+	chain, current := mergeRespondentChain(nil, "issue-1", ia, rp)
+	require.Len(t, chain, 2)
+	assert.Equal(t, 2, current)
+
+	assert.Equal(t, 1, chain[0].CascadedLevel)
+	assert.Equal(t, "seller.example.com", chain[0].OrgName)
+	assert.Equal(t, "CASCADED", chain[0].LastAction)
+	assert.Equal(t, "Seller GRO", chain[0].GroName)
+
+	assert.Equal(t, 2, chain[1].CascadedLevel)
+	assert.Equal(t, "Logistics GRO", chain[1].GroName)
+	assert.Equal(t, "gro@logistics.example.com", chain[1].GroEmail)
+}
+
+func TestMergeRespondentChainDefaultsToBPP(t *testing.T) {
+	ia := &pb.IssueActions{
+		RespondentActions: []*pb.RespondentAction{
+			{RespondentAction: "PROCESSING", UpdatedAt: "2026-01-01T10:00:00Z"},
+		},
+	}
+
+	chain, current := mergeRespondentChain(nil, "issue-1", ia, nil)
+	require.Len(t, chain, 1)
+	assert.Equal(t, 1, current)
+	assert.Equal(t, "PROCESSING", chain[0].LastAction)
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
+	"log"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+)
+
+const (
+	SLABreachResponse   = "RESPONSE"
+	SLABreachResolution = "RESOLUTION"
+)
+
+type SLABreachWorkerConfig struct {
+	Interval     time.Duration
+	BatchSize    int
+	AutoEscalate bool
+}
+
+// SLABreachWorker periodically marks issues whose response or resolution
+// deadline has passed and, when allowed, escalates them to GRIEVANCE.
+type SLABreachWorker struct {
+	issueRepo  repository.IssueRepository
+	redisRepo  repository.RedisRepository
+	publisher  events.EventPublisher
+	OndcClient *OndcClient
+	config     *Config
+	workerCfg  SLABreachWorkerConfig
+}
+
+func NewSLABreachWorker(issueRepo repository.IssueRepository,
+	redisRepo repository.RedisRepository,
+	publisher events.EventPublisher,
+	ondcClient *OndcClient,
+	config *Config,
+	workerCfg SLABreachWorkerConfig,
+) *SLABreachWorker {
+	if workerCfg.Interval <= 0 {
+		workerCfg.Interval = time.Minute
+	}
+	if workerCfg.BatchSize <= 0 {
+		workerCfg.BatchSize = 100
+	}
+	return &SLABreachWorker{
+		issueRepo:  issueRepo,
+		redisRepo:  redisRepo,
+		publisher:  publisher,
+		OndcClient: ondcClient,
+		config:     config,
+		workerCfg:  workerCfg,
+	}
+}
+
+// Start runs the worker until ctx is cancelled.
+func (w *SLABreachWorker) Start(ctx context.Context) {
+	log.Printf("[SLABreachWorker] started interval=%v auto_escalate=%v", w.workerCfg.Interval, w.workerCfg.AutoEscalate)
+	ticker := time.NewTicker(w.workerCfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		w.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Printf("[SLABreachWorker] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce processes one batch of response breaches and one batch of
+// resolution breaches.
+func (w *SLABreachWorker) RunOnce(ctx context.Context) {
+	now := time.Now()
+
+	issues, err := w.issueRepo.FindResponseBreaches(ctx, now, w.workerCfg.BatchSize)
+	if err != nil {
+		log.Printf("[SLABreachWorker] failed to find response breaches: %v", err)
+	}
+	for _, issue := range issues {
+		if err := w.handleBreach(ctx, issue, SLABreachResponse, now); err != nil {
+			log.Printf("[SLABreachWorker] failed to handle response breach for %s: %v", issue.IssueID, err)
+		}
+	}
+
+	issues, err = w.issueRepo.FindResolutionBreaches(ctx, now, w.workerCfg.BatchSize)
+	if err != nil {
+		log.Printf("[SLABreachWorker] failed to find resolution breaches: %v", err)
+	}
+	for _, issue := range issues {
+		if err := w.handleBreach(ctx, issue, SLABreachResolution, now); err != nil {
+			log.Printf("[SLABreachWorker] failed to handle resolution breach for %s: %v", issue.IssueID, err)
+		}
+	}
+}
+
+func (w *SLABreachWorker) handleBreach(ctx context.Context, issue *models.Issue, kind string, now time.Time) error {
+	var shortDesc string
+	switch kind {
+	case SLABreachResponse:
+		issue.ResponseBreachedAt = &now
+		shortDesc = fmt.Sprintf("response SLA breached: no respondent action by %s", issue.RespondBy.Format(time.RFC3339))
+	case SLABreachResolution:
+		issue.ResolutionBreachedAt = &now
+		shortDesc = fmt.Sprintf("resolution SLA breached: not resolved by %s", issue.ResolveBy.Format(time.RFC3339))
+	default:
+		return fmt.Errorf("unknown sla breach kind %q", kind)
+	}
+	log.Printf("[SLABreachWorker] issue %s: %s", issue.IssueID, shortDesc)
+
+	err := appendComplainantAction(issue, newInternalComplainantAction("SLA_BREACHED", shortDesc, w.config.SubcriberID, now))
+	if err != nil {
+		return err
+	}
+
+	escalate := w.canEscalate(issue)
+	fromIssueType := issue.IssueType
+	if escalate {
+		issue.IssueType = "GRIEVANCE"
+		err = appendComplainantAction(issue, newComplainantAction("ESCALATE", "auto-escalated, "+shortDesc, w.config.SubcriberID, now))
+		if err != nil {
+			return err
+		}
+	}
+
+	issue.UpdatedAt = now
+	// the escalation goes out before anything is saved: a failed send
+	// leaves the breach unmarked, so the next run finds it and retries
+	if escalate {
+		if err := w.OndcClient.SendIssue(ctx, issue, "ESCALATE"); err != nil {
+			return fmt.Errorf("failed to send escalation: %w", err)
+		}
+	}
+	deadline := issue.RespondBy
+	if kind == SLABreachResolution {
+		deadline = issue.ResolveBy
+	}
+	evs := []*eventsv1.DomainEvent{issueSLABreachedEvent(issue, kind, deadline, escalate)}
+	if escalate {
+		evs = append(evs, issueEscalatedEvent(issue, fromIssueType, shortDesc, EscalationSourceSLABreach))
+	}
+	err = saveWithEvents(ctx, w.publisher, func(ctx context.Context) error {
+		return w.issueRepo.MarkSLABreach(ctx, issue)
+	}, evs...)
+	if err != nil {
+		return fmt.Errorf("failed to mark breach: %w", err)
+	}
+
+	if w.redisRepo != nil {
+		event := map[string]interface{}{
+			"action":         "sla_breached",
+			"issue_id":       issue.IssueID,
+			"transaction_id": issue.TransactionID,
+			"user_id":        issue.UserID.String(),
+			"breach":         kind,
+			"escalated":      escalate,
+			"ondc_sent":      escalate,
+			"timestamp":      now.Format(time.RFC3339),
+		}
+		if err := w.redisRepo.SaveIssueResponse(ctx, issue.TransactionID, event); err != nil {
+			log.Printf("warn: failed to push redis event: %v", err)
+		}
+	}
+	return nil
+}
+
+// canEscalate allows one automatic ISSUE -> GRIEVANCE escalation; grievances
+// and disputes are only marked as breached.
+func (w *SLABreachWorker) canEscalate(issue *models.Issue) bool {
+	return w.workerCfg.AutoEscalate && issue.IssueType == "ISSUE" && issue.Status != "CLOSED"
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+// fakeBreaches mirrors the breach queries of the issue repository.
+type fakeBreaches struct {
+	repository.IssueRepository
+	issues  []*models.Issue
+	updated []*models.Issue
+}
+
+func (f *fakeBreaches) FindResponseBreaches(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error) {
+	var out []*models.Issue
+	for _, issue := range f.issues {
+		if issue.Status != "CLOSED" && issue.RespondBy != nil && issue.RespondBy.Before(now) &&
+			issue.ResponseBreachedAt == nil && issue.RespondentStatus == "" {
+			copied := *issue
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeBreaches) FindResolutionBreaches(ctx context.Context, now time.Time, limit int) ([]*models.Issue, error) {
+	var out []*models.Issue
+	for _, issue := range f.issues {
+		if issue.Status != "CLOSED" && issue.ResolveBy != nil && issue.ResolveBy.Before(now) &&
+			issue.ResolutionBreachedAt == nil && issue.RespondentStatus != "RESOLVED" {
+			copied := *issue
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeBreaches) MarkSLABreach(ctx context.Context, issue *models.Issue) error {
+	f.updated = append(f.updated, issue)
+	for _, stored := range f.issues {
+		if stored.IssueID == issue.IssueID {
+			stored.ResponseBreachedAt = issue.ResponseBreachedAt
+			stored.ResolutionBreachedAt = issue.ResolutionBreachedAt
+			stored.IssueType = issue.IssueType
+			stored.ComplainantActions = issue.ComplainantActions
+		}
+	}
+	return nil
+}
+
+func complainantActionNames(t *testing.T, issue *models.Issue) []string {
+	var actions []map[string]interface{}
+	require.NoError(t, json.Unmarshal(issue.ComplainantActions, &actions))
+	var names []string
+	for _, a := range actions {
+		names = append(names, a["complainant_action"].(string))
+	}
+	return names
+}
+
+func TestSLABreachWorker_EscalatesOnlyAfterBPPAccepts(t *testing.T) {
+	bpp := newTestBPP(t)
+	respondBy := time.Now().Add(-time.Hour)
+	repo := &fakeBreaches{issues: []*models.Issue{{
+		IssueID:            "issue-1",
+		UserID:             uuid.New(),
+		BPPURI:             bpp.URL,
+		Status:             "OPEN",
+		IssueType:          "ISSUE",
+		RespondBy:          &respondBy,
+		ComplainantActions: datatypes.JSON(`[{"complainant_action":"OPEN","updated_at":"2026-01-01T09:00:00Z"}]`),
+	}}}
+	worker := NewSLABreachWorker(repo, nil, nil, NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), &Config{SubcriberID: "buyer.example"}, SLABreachWorkerConfig{AutoEscalate: true})
+
+	bpp.setFailing(true)
+	worker.RunOnce(context.Background())
+	assert.Empty(t, repo.updated, "a failed escalation is not saved")
+	assert.Equal(t, "ISSUE", repo.issues[0].IssueType)
+	assert.Nil(t, repo.issues[0].ResponseBreachedAt)
+
+	bpp.setFailing(false)
+	bpp.setOnAccept(func() { repo.issues[0].RespondentStatus = "PROCESSING" })
+	worker.RunOnce(context.Background())
+	require.Len(t, repo.updated, 1)
+	stored := repo.issues[0]
+	assert.Equal(t, "PROCESSING", stored.RespondentStatus, "a callback stored while escalating is kept")
+	assert.Equal(t, "GRIEVANCE", stored.IssueType)
+	assert.NotNil(t, stored.ResponseBreachedAt)
+	assert.Equal(t, []string{"OPEN", "SLA_BREACHED", "ESCALATE"}, complainantActionNames(t, stored))
+
+	sent := bpp.issues()
+	require.Len(t, sent, 1)
+	assert.Equal(t, "GRIEVANCE", sent[0]["issue_type"])
+	wireActions := sent[0]["issue_actions"].(map[string]interface{})["complainant_actions"].([]interface{})
+	require.Len(t, wireActions, 2, "the SLA_BREACHED audit record stays internal")
+	assert.Equal(t, "ESCALATE", wireActions[1].(map[string]interface{})["complainant_action"])
+
+	worker.RunOnce(context.Background())
+	assert.Len(t, repo.updated, 1, "a marked breach is not handled again")
+	assert.Len(t, bpp.issues(), 1)
+}
+
+func TestSLABreachWorker_MarksWithoutEscalating(t *testing.T) {
+	bpp := newTestBPP(t)
+	resolveBy := time.Now().Add(-time.Hour)
+	repo := &fakeBreaches{issues: []*models.Issue{
+		{IssueID: "grievance", BPPURI: bpp.URL, Status: "OPEN", IssueType: "GRIEVANCE", RespondentStatus: "PROCESSING", ResolveBy: &resolveBy},
+		{IssueID: "resolved", BPPURI: bpp.URL, Status: "OPEN", IssueType: "ISSUE", RespondentStatus: "RESOLVED", ResolveBy: &resolveBy},
+	}}
+	worker := NewSLABreachWorker(repo, nil, nil, NewOndcClient("buyer.example", "https://buyer.example", "nic2004:60232", nil), &Config{SubcriberID: "buyer.example"}, SLABreachWorkerConfig{AutoEscalate: true})
+
+	worker.RunOnce(context.Background())
+	require.Len(t, repo.updated, 1)
+	assert.Equal(t, "grievance", repo.updated[0].IssueID)
+	assert.Equal(t, "GRIEVANCE", repo.updated[0].IssueType)
+	assert.NotNil(t, repo.updated[0].ResolutionBreachedAt)
+	assert.Equal(t, []string{"SLA_BREACHED"}, complainantActionNames(t, repo.updated[0]))
+	assert.Empty(t, bpp.issues(), "grievances are only marked")
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultExpectedResponseTime   = "PT2H"
+	DefaultExpectedResolutionTime = "P1D"
+)
+
+// SLATimeline is the policy applied to a single issue: the ISO-8601 durations
+// sent to the BPP and the concrete deadlines derived from them.
+type SLATimeline struct {
+	ExpectedResponseTime   string
+	ExpectedResolutionTime string
+	RespondBy              time.Time
+	ResolveBy              time.Time
+}
+
+type slaKey struct {
+	domain      string
+	category    string
+	subCategory string
+}
+
+type SLAPolicyService struct {
+	repo       repository.SLAPolicyRepository
+	policyFile string
+
+	mu       sync.RWMutex
+	policies map[slaKey]*models.SLAPolicy
+}
+
+func NewSLAPolicyService(repo repository.SLAPolicyRepository, policyFile string) *SLAPolicyService {
+	return &SLAPolicyService{
+		repo:       repo,
+		policyFile: policyFile,
+		policies:   map[slaKey]*models.SLAPolicy{},
+	}
+}
+
+// Load reads the policy table from the configured JSON file, or from the
+// sla_policies table when no file is set. It replaces the cached table.
+func (s *SLAPolicyService) Load(ctx context.Context) error {
+	var policies []*models.SLAPolicy
+	var err error
+
+	if s.policyFile != "" {
+		policies, err = loadSLAPoliciesFromFile(s.policyFile)
+	} else if s.repo != nil {
+		policies, err = s.repo.ListPolicies(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	table := make(map[slaKey]*models.SLAPolicy, len(policies))
+	for _, p := range policies {
+		if _, err := ParseISODuration(p.ExpectedResponseTime); err != nil {
+			return fmt.Errorf("invalid expected_response_time for %s/%s/%s: %w", p.Domain, p.Category, p.SubCategory, err)
+		}
+		if _, err := ParseISODuration(p.ExpectedResolutionTime); err != nil {
+			return fmt.Errorf("invalid expected_resolution_time for %s/%s/%s: %w", p.Domain, p.Category, p.SubCategory, err)
+		}
+		table[slaKey{p.Domain, p.Category, p.SubCategory}] = p
+	}
+
+	s.mu.Lock()
+	s.policies = table
+	s.mu.Unlock()
+
+	log.Printf("[SLAPolicyService] loaded %d sla policies", len(table))
+	return nil
+}
+
+func loadSLAPoliciesFromFile(path string) ([]*models.SLAPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sla policy file: %w", err)
+	}
+	var policies []*models.SLAPolicy
+	if err := json.Unmarshal(data, &policies); err != nil {
+		return nil, fmt.Errorf("failed to parse sla policy file: %w", err)
+	}
+	return policies, nil
+}
+
+// Timeline picks the most specific policy for the key and computes deadlines
+// from the given start time. Lookup falls back from sub-category to category
+// to domain, then to wildcard domain, and finally to PT2H / P1D.
+func (s *SLAPolicyService) Timeline(domain, category, subCategory string, from time.Time) (*SLATimeline, error) {
+	responseTime, resolutionTime := DefaultExpectedResponseTime, DefaultExpectedResolutionTime
+	if p := s.lookup(domain, category, subCategory); p != nil {
+		responseTime, resolutionTime = p.ExpectedResponseTime, p.ExpectedResolutionTime
+	}
+
+	respondIn, err := ParseISODuration(responseTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expected_response_time: %w", err)
+	}
+	resolveIn, err := ParseISODuration(resolutionTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expected_resolution_time: %w", err)
+	}
+
+	return &SLATimeline{
+		ExpectedResponseTime:   responseTime,
+		ExpectedResolutionTime: resolutionTime,
+		RespondBy:              from.Add(respondIn),
+		ResolveBy:              from.Add(resolveIn),
+	}, nil
+}
+
+func (s *SLAPolicyService) lookup(domain, category, subCategory string) *models.SLAPolicy {
+	if s == nil {
+		return nil
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	candidates := []slaKey{
+		{domain, category, subCategory},
+		{domain, category, ""},
+		{domain, "", ""},
+		{"", category, subCategory},
+		{"", category, ""},
+		{"", "", ""},
+	}
+	for _, k := range candidates {
+		if p, ok := s.policies[k]; ok {
+			return p
+		}
+	}
+	return nil
+}
+
+// ParseISODuration parses the ISO-8601 durations used by ONDC, e.g. PT2H,
+// P1D, P1DT12H or PT30M. Years and months are rejected as they have no fixed
+// length.
+func ParseISODuration(value string) (time.Duration, error) {
+	if len(value) < 2 || value[0] != 'P' {
+		return 0, fmt.Errorf("invalid ISO-8601 duration %q", value)
+	}
+
+	var total time.Duration
+	inTime := false
+	num := ""
+	for _, ch := range value[1:] {
+		switch {
+		case ch == 'T':
+			if inTime || num != "" {
+				return 0, fmt.Errorf("invalid ISO-8601 duration %q", value)
+			}
+			inTime = true
+		case (ch >= '0' && ch <= '9') || ch == '.':
+			num += string(ch)
+		default:
+			if num == "" {
+				return 0, fmt.Errorf("invalid ISO-8601 duration %q", value)
+			}
+			n, err := strconv.ParseFloat(num, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid ISO-8601 duration %q: %w", value, err)
+			}
+			var unit time.Duration
+			switch {
+			case !inTime && ch == 'W':
+				unit = 7 * 24 * time.Hour
+			case !inTime && ch == 'D':
+				unit = 24 * time.Hour
+			case inTime && ch == 'H':
+				unit = time.Hour
+			case inTime && ch == 'M':
+				unit = time.Minute
+			case inTime && ch == 'S':
+				unit = time.Second
+			default:
+				return 0, fmt.Errorf("unsupported unit %q in ISO-8601 duration %q", ch, value)
+			}
+			total += time.Duration(n * float64(unit))
+			num = ""
+		}
+	}
+	if num != "" || total <= 0 {
+		return 0, fmt.Errorf("invalid ISO-8601 duration %q", value)
+	}
+	return total, nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseISODuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"PT2H":    2 * time.Hour,
+		"P1D":     24 * time.Hour,
+		"P1DT12H": 36 * time.Hour,
+		"PT30M":   30 * time.Minute,
+		"PT1H30M": 90 * time.Minute,
+		"P1W":     7 * 24 * time.Hour,
+		"PT0.5H":  30 * time.Minute,
+	}
+	for in, want := range cases {
+		got, err := ParseISODuration(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	for _, in := range []string{"", "P", "PT", "2H", "P1Y", "P1M", "PT1D", "P1H", "PTH"} {
+		_, err := ParseISODuration(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestSLAPolicyService_Timeline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sla.json")
+	policies := `[
+		{"domain":"nic2004:60232","category":"FULFILLMENT","sub_category":"","expected_response_time":"PT1H","expected_resolution_time":"PT12H"},
+		{"domain":"nic2004:60232","category":"FULFILLMENT","sub_category":"FLM02","expected_response_time":"PT30M","expected_resolution_time":"PT4H"},
+		{"domain":"","category":"PAYMENT","sub_category":"","expected_response_time":"PT3H","expected_resolution_time":"P2D"}
+	]`
+	require.NoError(t, os.WriteFile(path, []byte(policies), 0o600))
+
+	svc := NewSLAPolicyService(nil, path)
+	require.NoError(t, svc.Load(context.Background()))
+
+	from := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	sla, err := svc.Timeline("nic2004:60232", "FULFILLMENT", "FLM02", from)
+	require.NoError(t, err)
+	assert.Equal(t, "PT30M", sla.ExpectedResponseTime)
+	assert.Equal(t, from.Add(30*time.Minute), sla.RespondBy)
+	assert.Equal(t, from.Add(4*time.Hour), sla.ResolveBy)
+
+	sla, err = svc.Timeline("nic2004:60232", "FULFILLMENT", "FLM01", from)
+	require.NoError(t, err)
+	assert.Equal(t, "PT1H", sla.ExpectedResponseTime)
+	assert.Equal(t, "PT12H", sla.ExpectedResolutionTime)
+
+	sla, err = svc.Timeline("ONDC:RET10", "PAYMENT", "PMT01", from)
+	require.NoError(t, err)
+	assert.Equal(t, "PT3H", sla.ExpectedResponseTime)
+	assert.Equal(t, from.Add(48*time.Hour), sla.ResolveBy)
+
+	sla, err = svc.Timeline("ONDC:RET10", "ITEM", "ITM01", from)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultExpectedResponseTime, sla.ExpectedResponseTime)
+	assert.Equal(t, DefaultExpectedResolutionTime, sla.ExpectedResolutionTime)
+}
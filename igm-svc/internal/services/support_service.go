@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
+	"strconv"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+	pb "igm-svc/api/proto/igm/v1"
+
+	"gorm.io/datatypes"
+)
+
+// CloseSourceSupport marks issues closed by a support agent's status override.
+const CloseSourceSupport = "SUPPORT"
+
+// SupportService is the support-agent API across all users. Every call,
+// reads included, is written to the support audit log.
+type SupportService struct {
+	issueRepo   repository.IssueRepository
+	onIssueRepo repository.OnIssueRepository
+	supportRepo repository.SupportRepository
+	publisher   events.EventPublisher
+	config      *Config
+}
+
+func NewSupportService(issueRepo repository.IssueRepository,
+	onIssueRepo repository.OnIssueRepository,
+	supportRepo repository.SupportRepository,
+	publisher events.EventPublisher,
+	config *Config,
+) *SupportService {
+	return &SupportService{
+		issueRepo:   issueRepo,
+		onIssueRepo: onIssueRepo,
+		supportRepo: supportRepo,
+		publisher:   publisher,
+		config:      config,
+	}
+}
+
+func (s *SupportService) SearchIssues(ctx context.Context, req *pb.SearchIssuesRequest) (*pb.SearchIssuesResponse, error) {
+	agent, err := supportAgent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter := repository.IssueSearchFilter{
+		Status:      req.Status,
+		BPPID:       req.BppId,
+		Category:    req.Category,
+		AssignedTo:  req.AssignedTo,
+		SLABreached: req.SlaBreached,
+	}
+	if filter.CreatedFrom, err = parseOptionalTime("created_from", req.CreatedFrom); err != nil {
+		return nil, err
+	}
+	if filter.CreatedTo, err = parseOptionalTime("created_to", req.CreatedTo); err != nil {
+		return nil, err
+	}
+	if req.PageSize <= 0 || req.PageSize > 100 {
+		req.PageSize = 20
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	// the search is audited before it runs so that a failing query is
+	// still on record
+	audit := newSupportAudit(agent, "", models.SupportActionSearch, "", map[string]string{
+		"status":       req.Status,
+		"bpp_id":       req.BppId,
+		"category":     req.Category,
+		"created_from": req.CreatedFrom,
+		"created_to":   req.CreatedTo,
+		"sla_breached": strconv.FormatBool(req.SlaBreached),
+		"assigned_to":  req.AssignedTo,
+	})
+	if err := s.supportRepo.RecordAudit(ctx, audit); err != nil {
+		return nil, err
+	}
+
+	offset := (int(req.Page) - 1) * int(req.PageSize)
+	issues, total, err := s.supportRepo.SearchIssues(ctx, filter, int(req.PageSize), offset)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SearchIssuesResponse{
+		Issues:     mapper.ToProtoSupportIssues(issues),
+		TotalCount: int32(total),
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+	}, nil
+}
+
+// GetIssueDetails returns everything known about an issue: the issue, its
+// timeline with internal entries, the raw ONDC callbacks, notes and audit
+// history.
+func (s *SupportService) GetIssueDetails(ctx context.Context, req *pb.GetIssueDetailsRequest) (*pb.IssueDetails, error) {
+	agent, err := supportAgent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.supportRepo.RecordAudit(ctx, newSupportAudit(agent, issue.IssueID, models.SupportActionViewDetails, "", nil)); err != nil {
+		return nil, err
+	}
+
+	history, err := s.onIssueRepo.ListOnIssueStatusResponses(ctx, issue.IssueID)
+	if err != nil {
+		return nil, err
+	}
+	callbacks, err := s.supportRepo.ListCallbacks(ctx, issue.TransactionID, issue.IssueID)
+	if err != nil {
+		return nil, err
+	}
+	notes, err := s.supportRepo.ListNotes(ctx, issue.IssueID)
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := s.supportRepo.ListAudit(ctx, issue.IssueID, "", 100)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.IssueDetails{
+		Issue:     mapper.ToProtoSupportIssue(issue),
+		Timeline:  buildTimeline(issue, history, true),
+		Callbacks: mapper.ToProtoRawCallbacks(callbacks),
+		Notes:     mapper.ToProtoInternalNotes(notes),
+		AuditLog:  mapper.ToProtoSupportAuditEntries(auditLog),
+	}, nil
+}
+
+func (s *SupportService) AddInternalNote(ctx context.Context, req *pb.AddInternalNoteRequest) (*pb.InternalNote, error) {
+	agent, err := supportAgent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &models.IssueNote{
+		IssueID: issue.IssueID,
+		Author:  agent.Subject,
+		Body:    req.Body,
+	}
+	audit := newSupportAudit(agent, issue.IssueID, models.SupportActionAddNote, "", nil)
+	if err := s.supportRepo.AddNote(ctx, note, audit); err != nil {
+		return nil, err
+	}
+	return mapper.ToProtoInternalNote(note), nil
+}
+
+// ReassignIssue hands the issue to another agent; an empty assignee
+// unassigns it.
+func (s *SupportService) ReassignIssue(ctx context.Context, req *pb.ReassignIssueRequest) (*pb.ReassignIssueResponse, error) {
+	agent, err := supportAgent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+
+	audit := newSupportAudit(agent, issue.IssueID, models.SupportActionReassign, req.Reason, map[string]string{
+		"from": issue.AssignedTo,
+		"to":   req.Assignee,
+	})
+	now := time.Now()
+	issue.AssignedTo = req.Assignee
+	issue.AssignedAt = &now
+	if req.Assignee == "" {
+		issue.AssignedAt = nil
+	}
+	issue.UpdatedAt = now
+	if err := s.supportRepo.UpdateIssue(ctx, issue, audit); err != nil {
+		return nil, err
+	}
+	return &pb.ReassignIssueResponse{Issue: mapper.ToProtoSupportIssue(issue)}, nil
+}
+
+// ForceIssueStatus overrides the issue status without an ONDC exchange, for
+// issues stuck because the BPP or complainant stopped responding. The
+// reason is mandatory and kept in the audit log and as an internal
+// complainant action on the timeline.
+func (s *SupportService) ForceIssueStatus(ctx context.Context, req *pb.ForceIssueStatusRequest) (*pb.ForceIssueStatusResponse, error) {
+	agent, err := supportAgent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateStatus("status", req.Status); err != nil {
+		return nil, err
+	}
+	issue, err := s.getIssue(ctx, req.IssueId)
+	if err != nil {
+		return nil, err
+	}
+	if issue.Status == req.Status {
+		return nil, failedPrecondition("issue is already %s", issue.Status)
+	}
+
+	audit := newSupportAudit(agent, issue.IssueID, models.SupportActionForceStatus, req.Reason, map[string]string{
+		"from": issue.Status,
+		"to":   req.Status,
+	})
+	now := time.Now()
+	issue.Status = req.Status
+	issue.UpdatedAt = now
+	if err := appendComplainantAction(issue, newInternalComplainantAction("STATUS_OVERRIDDEN", req.Reason, s.config.SubcriberID, now)); err != nil {
+		return nil, err
+	}
+	var event *eventsv1.DomainEvent
+	if issue.Status == "CLOSED" {
+		event = issueClosedEvent(issue, CloseSourceSupport)
+	} else {
+		event = issueUpdatedEvent(issue)
+	}
+	err = saveWithEvents(ctx, s.publisher, func(ctx context.Context) error {
+		return s.supportRepo.UpdateIssue(ctx, issue, audit)
+	}, event)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ForceIssueStatusResponse{Issue: mapper.ToProtoSupportIssue(issue)}, nil
+}
+
+// ListSupportAuditLog is itself audited: reading the log shows who acted on
+// which issues.
+func (s *SupportService) ListSupportAuditLog(ctx context.Context, req *pb.ListSupportAuditLogRequest) (*pb.ListSupportAuditLogResponse, error) {
+	agent, err := supportAgent(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	audit := newSupportAudit(agent, req.GetIssueId(), models.SupportActionViewAudit, "", map[string]string{
+		"actor": req.GetActor(),
+		"limit": strconv.Itoa(limit),
+	})
+	if err := s.supportRepo.RecordAudit(ctx, audit); err != nil {
+		return nil, err
+	}
+	entries, err := s.supportRepo.ListAudit(ctx, req.GetIssueId(), req.GetActor(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListSupportAuditLogResponse{Entries: mapper.ToProtoSupportAuditEntries(entries)}, nil
+}
+
+func (s *SupportService) getIssue(ctx context.Context, issueID string) (*models.Issue, error) {
+	issue, err := s.issueRepo.GetByIssueID(ctx, issueID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load issue %s: %w", issueID, err)
+	}
+	return issue, nil
+}
+
+func supportAgent(ctx context.Context) (*auth.Principal, error) {
+	if err := auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService); err != nil {
+		return nil, err
+	}
+	p, _ := auth.FromContext(ctx)
+	return p, nil
+}
+
+func newSupportAudit(agent *auth.Principal, issueID, action, reason string, details map[string]string) *models.SupportAuditEntry {
+	entry := &models.SupportAuditEntry{
+		IssueID:   issueID,
+		Actor:     agent.Subject,
+		ActorRole: string(agent.Role),
+		Action:    action,
+		Reason:    reason,
+	}
+	if len(details) > 0 {
+		if raw, err := json.Marshal(details); err == nil {
+			entry.Details = datatypes.JSON(raw)
+		}
+	}
+	return entry
+}
+
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, invalidField(field, "invalid %s: must be RFC3339", field)
+	}
+	return &t, nil
+}
@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
+	"testing"
+
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeSupportRepo struct {
+	repository.SupportRepository
+	audit  []*models.SupportAuditEntry
+	notes  []*models.IssueNote
+	saved  []*models.Issue
+	filter repository.IssueSearchFilter
+}
+
+func (f *fakeSupportRepo) SearchIssues(ctx context.Context, filter repository.IssueSearchFilter, limit int, offset int) ([]*models.Issue, int, error) {
+	f.filter = filter
+	return nil, 0, nil
+}
+
+func (f *fakeSupportRepo) AddNote(ctx context.Context, note *models.IssueNote, audit *models.SupportAuditEntry) error {
+	f.notes = append(f.notes, note)
+	f.audit = append(f.audit, audit)
+	return nil
+}
+
+func (f *fakeSupportRepo) UpdateIssue(ctx context.Context, issue *models.Issue, audit *models.SupportAuditEntry) error {
+	f.saved = append(f.saved, issue)
+	f.audit = append(f.audit, audit)
+	return nil
+}
+
+func (f *fakeSupportRepo) RecordAudit(ctx context.Context, entry *models.SupportAuditEntry) error {
+	f.audit = append(f.audit, entry)
+	return nil
+}
+
+func (f *fakeSupportRepo) ListAudit(ctx context.Context, issueID, actor string, limit int) ([]*models.SupportAuditEntry, error) {
+	var out []*models.SupportAuditEntry
+	for _, entry := range f.audit {
+		if (issueID == "" || entry.IssueID == issueID) && (actor == "" || entry.Actor == actor) && len(out) < limit {
+			out = append(out, entry)
+		}
+	}
+	return out, nil
+}
+
+func newTestSupportService() (*SupportService, *fakeSupportRepo, *events.MemoryPublisher) {
+	issues := &fakeIssueLookup{issues: map[string]*models.Issue{
+		"issue-1": {IssueID: "issue-1", TransactionID: "txn-1", UserID: uuid.New(), Status: "OPEN"},
+	}}
+	repo := &fakeSupportRepo{}
+	publisher := events.NewMemoryPublisher()
+	svc := NewSupportService(issues, nil, repo, publisher, &Config{SubcriberID: "buyer.example"})
+	return svc, repo, publisher
+}
+
+func supportCtx() context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "agent-7", Role: auth.RoleSupport})
+}
+
+func TestSupportService_RequiresSupportRole(t *testing.T) {
+	svc, repo, _ := newTestSupportService()
+	userID := uuid.New()
+	userCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: userID.String(), Role: auth.RoleUser, UserID: userID})
+
+	_, err := svc.SearchIssues(userCtx, &pb.SearchIssuesRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = svc.ForceIssueStatus(userCtx, &pb.ForceIssueStatusRequest{IssueId: "issue-1", Status: "CLOSED", Reason: "stuck"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Empty(t, repo.audit)
+}
+
+func TestSupportService_SearchIsAudited(t *testing.T) {
+	svc, repo, _ := newTestSupportService()
+
+	resp, err := svc.SearchIssues(supportCtx(), &pb.SearchIssuesRequest{
+		Status:      "OPEN",
+		BppId:       "bpp.example",
+		CreatedFrom: "2026-01-01T00:00:00Z",
+		SlaBreached: true,
+	})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, resp.Page)
+	assert.EqualValues(t, 20, resp.PageSize)
+	assert.Equal(t, "bpp.example", repo.filter.BPPID)
+	require.NotNil(t, repo.filter.CreatedFrom)
+	assert.True(t, repo.filter.SLABreached)
+
+	require.Len(t, repo.audit, 1)
+	assert.Equal(t, models.SupportActionSearch, repo.audit[0].Action)
+	assert.Equal(t, "agent-7", repo.audit[0].Actor)
+	assert.Contains(t, string(repo.audit[0].Details), `"bpp_id":"bpp.example"`)
+
+	_, err = svc.SearchIssues(supportCtx(), &pb.SearchIssuesRequest{CreatedTo: "yesterday"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestSupportService_ForceIssueStatus(t *testing.T) {
+	svc, repo, publisher := newTestSupportService()
+
+	_, err := svc.ForceIssueStatus(supportCtx(), &pb.ForceIssueStatusRequest{IssueId: "issue-1", Status: "OPEN", Reason: "x"})
+	assert.ErrorIs(t, err, ErrFailedPrecondition)
+	_, err = svc.ForceIssueStatus(supportCtx(), &pb.ForceIssueStatusRequest{IssueId: "missing", Status: "CLOSED", Reason: "x"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	resp, err := svc.ForceIssueStatus(supportCtx(), &pb.ForceIssueStatusRequest{IssueId: "issue-1", Status: "CLOSED", Reason: "BPP unreachable for 10 days"})
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", resp.Issue.Issue.Status)
+
+	require.Len(t, repo.audit, 1)
+	entry := repo.audit[0]
+	assert.Equal(t, models.SupportActionForceStatus, entry.Action)
+	assert.Equal(t, "BPP unreachable for 10 days", entry.Reason)
+	assert.JSONEq(t, `{"from":"OPEN","to":"CLOSED"}`, string(entry.Details))
+	assert.Contains(t, string(repo.saved[0].ComplainantActions), "STATUS_OVERRIDDEN")
+
+	require.Len(t, publisher.Events(), 1)
+	assert.Equal(t, CloseSourceSupport, publisher.Events()[0].GetIssueClosed().GetSource())
+}
+
+func TestSupportService_ReassignAndNote(t *testing.T) {
+	svc, repo, _ := newTestSupportService()
+
+	resp, err := svc.ReassignIssue(supportCtx(), &pb.ReassignIssueRequest{IssueId: "issue-1", Assignee: "agent-9", Reason: "shift change"})
+	require.NoError(t, err)
+	assert.Equal(t, "agent-9", resp.Issue.AssignedTo)
+	assert.NotEmpty(t, resp.Issue.AssignedAt)
+
+	note, err := svc.AddInternalNote(supportCtx(), &pb.AddInternalNoteRequest{IssueId: "issue-1", Body: "called the seller"})
+	require.NoError(t, err)
+	assert.Equal(t, "agent-7", note.Author)
+
+	require.Len(t, repo.audit, 2)
+	assert.Equal(t, models.SupportActionReassign, repo.audit[0].Action)
+	assert.Equal(t, models.SupportActionAddNote, repo.audit[1].Action)
+}
+
+func TestSupportService_ListAuditLogIsAudited(t *testing.T) {
+	svc, repo, _ := newTestSupportService()
+
+	repo.audit = append(repo.audit, &models.SupportAuditEntry{IssueID: "issue-1", Actor: "agent-9", Action: models.SupportActionReassign})
+
+	resp, err := svc.ListSupportAuditLog(supportCtx(), &pb.ListSupportAuditLogRequest{IssueId: "issue-1", Limit: 1000})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Entries)
+
+	require.Len(t, repo.audit, 2)
+	entry := repo.audit[1]
+	assert.Equal(t, models.SupportActionViewAudit, entry.Action)
+	assert.Equal(t, "agent-7", entry.Actor)
+	assert.Equal(t, "issue-1", entry.IssueID)
+	assert.JSONEq(t, `{"actor":"","limit":"100"}`, string(entry.Details))
+}
@@ -3,61 +3,36 @@ package services
 import (
 	"encoding/json"
 	"fmt"
+	"igm-svc/internal/mapper"
 	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"net/url"
+	"strings"
 	"time"
 
-	pb "github.com/parthav-effimove/ONDC-Protos/protos/ondc/igm/v1"
+	pb "igm-svc/api/proto/igm/v1"
+	igmv2 "igm-svc/api/proto/igm/v2"
 
 	"github.com/google/uuid"
 	"gorm.io/datatypes"
 )
 
-func (s *IssueService) validateCreateRequest(req *pb.CreateIssueRequest) error {
+// Required fields, lengths and formats are declared as buf.validate rules in
+// issue.proto and enforced by the gRPC validation interceptor. The helpers
+// below only check what the rules cannot express: the ONDC enum sets shared
+// with v2, cross-field constraints and business rules.
 
-	if req.UserId == "" {
-		return fmt.Errorf("user_id is required")
-	}
-	if req.OrderId == "" {
-		return fmt.Errorf("order_id is required")
-	}
-	if req.Category == "" {
-		return fmt.Errorf("catergory is required")
-	}
-	if req.SubCategory == "" {
-		return fmt.Errorf("sub_category is required")
-	}
-	if req.IssueType == "" {
-		return fmt.Errorf("issue_type is required")
-	}
-	if req.Description == "" {
-		return fmt.Errorf("description is required")
-	}
-	if len(req.Items) == 0 {
-		return fmt.Errorf("atleast one item is required")
-	}
-
-	for i, item := range req.Items {
-		if item.Id == "" {
-			return fmt.Errorf("missing item id at index %d", i)
-		}
-		if item.Quantity <= 0 {
-			return fmt.Errorf("invalid quantity of ite, %s", item.Id)
-		}
-	}
-	validCategoies := []string{"ORDER", "FULFILLMENT", "PAYMENT", "ITEM", "AGENT", "CUSTOMER", "TECHNICAL", "VISIBILITY", "POLICY BREACH", "BUSINESS"}
-	if !Contains(validCategoies, req.Category) {
-		return fmt.Errorf("invalid category , must be one of %v", validCategoies)
-	}
-	validIssueTypes := []string{"ISSUE", "GRIEVANCE"}
-	if !Contains(validIssueTypes, req.IssueType) {
-		return fmt.Errorf("invalid issue type , must be one of %v", validIssueTypes)
+func (s *IssueService) validateCreateRequest(req *pb.CreateIssueRequest) error {
+	if err := validateCategory("category", req.Category); err != nil {
+		return err
 	}
-	return nil
+	return validateComplainantIssueType("issue_type", req.IssueType)
 }
 
 func (s *IssueService) buildIssueFromRequest(req *pb.CreateIssueRequest,
 	userID uuid.UUID,
 	bppID, bppURI string,
+	sla *SLATimeline,
 ) (*models.Issue, error) {
 
 	now := time.Now()
@@ -136,8 +111,11 @@ func (s *IssueService) buildIssueFromRequest(req *pb.CreateIssueRequest,
 		ComplainantActions:     datatypes.JSON(complaintActionJSON),
 		SourceNPID:             s.config.SubcriberID,
 		SourceType:             "CONSUMER",
-		ExpectedResponseTime:   "PT2H",
-		ExpectedResolutionTime: "P1D",
+		ExpectedResponseTime:   sla.ExpectedResponseTime,
+		ExpectedResolutionTime: sla.ExpectedResolutionTime,
+		RespondBy:              &sla.RespondBy,
+		ResolveBy:              &sla.ResolveBy,
+		CascadedLevel:          1,
 		CreatedAt:              now,
 		UpdatedAt:              now,
 	}
@@ -146,50 +124,155 @@ func (s *IssueService) buildIssueFromRequest(req *pb.CreateIssueRequest,
 }
 
 func ValidateUpdateIssueRequest(req *pb.UpdateIssueRequest) error {
-	if req.UserId == "" {
-		return fmt.Errorf("missing required field: user_id")
+	if err := validateStatus("status", req.Status); err != nil {
+		return err
 	}
-	if req.IssueId == "" {
-		return fmt.Errorf("missing required field: issue_id")
+	if req.IssueType != "" {
+		return validateComplainantIssueType("issue_type", req.IssueType)
 	}
-	if req.OrderId == "" {
-		return fmt.Errorf("missing required field: order_id")
+	return nil
+}
+
+func ValidateProvideIssueInfoRequest(req *pb.ProvideIssueInfoRequest) error {
+	if req.AdditionalDesc != nil && req.AdditionalDesc.Url != "" && req.AdditionalDesc.ContentType == "" {
+		return missingField("additional_desc.content_type")
 	}
-	if req.Status == "" {
-		return fmt.Errorf("missing required field: status")
+	return nil
+}
+
+func ValidateCloseIssueRequest(req *pb.CloseIssueRequest) error {
+	if err := validateStatus("status", req.Status); err != nil {
+		return err
 	}
-	if req.IssueType != "" {
-		validIssueTypes := []string{"ISSUE", "GRIEVANCE", "DISPUTE"}
-		if !Contains(validIssueTypes, req.IssueType) {
-			return fmt.Errorf("invalid issue_type. Must be 'ISSUE', 'GRIEVANCE', or 'DISPUTE'")
-		}
+	return validateRating("rating", req.Rating)
+}
+
+func ValidateAcceptResolutionRequest(req *pb.AcceptResolutionRequest) error {
+	return validateRating("rating", req.Rating)
+}
+
+// The valid wire strings come from the mapper's enum tables so v1 and v2
+// accept the same values.
+
+func validateStatus(field, value string) error {
+	if mapper.IssueStatusFromWire(value) == igmv2.IssueStatus_ISSUE_STATUS_UNSPECIFIED {
+		return invalidField(field, "invalid %s. Must be one of %v", field, mapper.IssueStatusWireValues())
 	}
 	return nil
 }
 
-func ValidateCloseIssueRequest(req *pb.CloseIssueRequest) error {
-	if req.UserId == "" {
-		return fmt.Errorf("missing required field: user_id")
+func validateCategory(field, value string) error {
+	if mapper.IssueCategoryFromWire(value) == igmv2.IssueCategory_ISSUE_CATEGORY_UNSPECIFIED {
+		return invalidField(field, "invalid %s. Must be one of %v", field, mapper.IssueCategoryWireValues())
 	}
-	if req.IssueId == "" {
-		return fmt.Errorf("missing required field: issue_id")
+	return nil
+}
+
+// validateComplainantIssueType accepts the types a complainant may set
+// directly; DISPUTE is only reached through SelectOdr.
+func validateComplainantIssueType(field, value string) error {
+	switch mapper.IssueTypeFromWire(value) {
+	case igmv2.IssueType_ISSUE_TYPE_ISSUE, igmv2.IssueType_ISSUE_TYPE_GRIEVANCE:
+		return nil
+	case igmv2.IssueType_ISSUE_TYPE_DISPUTE:
+		return invalidField(field, "%s DISPUTE must be raised through SelectOdr", field)
+	}
+	return invalidField(field, "invalid %s. Must be 'ISSUE' or 'GRIEVANCE'", field)
+}
+
+func validateIssueType(field, value string) error {
+	if mapper.IssueTypeFromWire(value) == igmv2.IssueType_ISSUE_TYPE_UNSPECIFIED {
+		return invalidField(field, "invalid %s. Must be one of %v", field, mapper.IssueTypeWireValues())
 	}
-	if req.OrderId == "" {
-		return fmt.Errorf("missing required field: order_id")
+	return nil
+}
+
+func validateRating(field, value string) error {
+	if mapper.RatingFromWire(value) == igmv2.Rating_RATING_UNSPECIFIED {
+		return invalidField(field, "invalid %s. Must be one of %v", field, mapper.RatingWireValues())
 	}
-	if req.Status == "" {
-		return fmt.Errorf("missing required field: status")
+	return nil
+}
+
+// validateResolutionPending checks that the respondent has proposed a
+// resolution the complainant can still act on. A resolution the complainant
+// has already answered, e.g. rejected, stays stored on the issue until the
+// respondent sends a newer one, so it is not pending.
+func validateResolutionPending(issue *models.Issue) error {
+	if issue.Status == "CLOSED" {
+		return failedPrecondition("issue %s is already closed", issue.IssueID)
 	}
-	if req.Rating == "" {
-		return fmt.Errorf("missing required field: rating")
+	var resolution map[string]interface{}
+	if len(issue.Resolution) == 0 || json.Unmarshal(issue.Resolution, &resolution) != nil || len(resolution) == 0 {
+		return failedPrecondition("issue %s has no resolution to act on", issue.IssueID)
 	}
-	validRatings := []string{"THUMBS-UP", "THUMBS-DOWN"}
-	if !Contains(validRatings, req.Rating) {
-		return fmt.Errorf("invalid rating. Must be 'THUMBS-UP' or 'THUMBS-DOWN'")
+	if issue.ResolvedAt == nil || answeredSince(issue, *issue.ResolvedAt) {
+		return failedPrecondition("issue %s has no new resolution since the last complainant action", issue.IssueID)
 	}
 	return nil
 }
 
+// answeredSince reports whether the complainant acted on the issue after t.
+// Internal entries are the service's own audit records and do not count.
+func answeredSince(issue *models.Issue, t time.Time) bool {
+	var actions []map[string]interface{}
+	if len(issue.ComplainantActions) == 0 || json.Unmarshal(issue.ComplainantActions, &actions) != nil {
+		return false
+	}
+	for _, action := range actions {
+		if internal, _ := action["internal"].(bool); internal {
+			continue
+		}
+		raw, _ := action["updated_at"].(string)
+		if at, err := time.Parse(time.RFC3339, raw); err == nil && at.After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// newComplainantAction builds a complainant action attributed to the BAP itself,
+// used for actions taken by this service rather than by the user.
+func newComplainantAction(action, shortDesc, orgName string, at time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"complainant_action": action,
+		"short_desc":         shortDesc,
+		"updated_at":         at.Format(time.RFC3339),
+		"updated_by": map[string]interface{}{
+			"org": map[string]interface{}{
+				"name": orgName,
+			},
+			"person": map[string]interface{}{
+				"name": "system",
+			},
+		},
+	}
+}
+
+// newInternalComplainantAction is recorded for audit only and is never sent
+// to the BPP.
+func newInternalComplainantAction(action, shortDesc, orgName string, at time.Time) map[string]interface{} {
+	entry := newComplainantAction(action, shortDesc, orgName, at)
+	entry["internal"] = true
+	return entry
+}
+
+func appendComplainantAction(issue *models.Issue, entry map[string]interface{}) error {
+	var actions []map[string]interface{}
+	if len(issue.ComplainantActions) > 0 {
+		if err := json.Unmarshal(issue.ComplainantActions, &actions); err != nil {
+			return fmt.Errorf("failed to unmarshal complainant actions: %w", err)
+		}
+	}
+	actions = append(actions, entry)
+	actionsJSON, err := json.Marshal(actions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal complainant actions: %w", err)
+	}
+	issue.ComplainantActions = datatypes.JSON(actionsJSON)
+	return nil
+}
+
 func Contains(slice []string, item string) bool {
 	for _, s := range slice {
 		if s == item {
@@ -203,7 +286,7 @@ func Contains(slice []string, item string) bool {
 func (s *IssueService) ValidateNoActiveIssueExistsWithSameCategory(req *pb.CreateIssueRequest) error {
 	userID, err := uuid.Parse(req.UserId)
 	if err != nil {
-		return fmt.Errorf("invalid user_id: %w", err)
+		return invalidField("user_id", "invalid user_id: %v", err)
 	}
 
 	exists, err := s.issueRepo.HasActiveIssueWithSameCategory(userID, req.Category, req.OrderId)
@@ -211,8 +294,94 @@ func (s *IssueService) ValidateNoActiveIssueExistsWithSameCategory(req *pb.Creat
 		return fmt.Errorf("failed to check active issue: %w", err)
 	}
 	if exists {
-		return fmt.Errorf("active issue with same category already exists for order %s in category %s", req.OrderId, req.Category)
+		return fmt.Errorf("%w: active issue with same category already exists for order %s in category %s", ErrAlreadyExists, req.OrderId, req.Category)
+	}
+	return nil
+
+}
+
+func ValidateNotificationPreferences(p *pb.NotificationPreferences) error {
+	if p == nil {
+		return missingField("preferences")
+	}
+	if (p.QuietHoursStart == "") != (p.QuietHoursEnd == "") {
+		return invalidField("quiet_hours_end", "quiet_hours_start and quiet_hours_end must be set together")
+	}
+	if p.Timezone != "" {
+		if _, err := time.LoadLocation(p.Timezone); err != nil {
+			return invalidField("timezone", "unknown timezone %q", p.Timezone)
+		}
+	}
+	return nil
+}
+
+// ValidateWebhookSubscription narrows the uri rule to http(s) and checks
+// the event type patterns the dispatcher can match.
+func ValidateWebhookSubscription(rawURL string, eventTypes []string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return invalidField("url", "url must be an absolute http(s) url")
+	}
+	for _, t := range eventTypes {
+		if strings.ContainsAny(t, " \t") {
+			return invalidField("event_types", "invalid event type %q", t)
+		}
+		if t != "*" && strings.Contains(strings.TrimSuffix(t, ".*"), "*") {
+			return invalidField("event_types", "invalid event type %q: only a trailing .* is supported", t)
+		}
 	}
 	return nil
+}
+
+func issueQueryFromRequest(userID uuid.UUID, req *pb.ListIssueRequest) (repository.IssueQuery, error) {
+	q := repository.IssueQuery{
+		UserID:        userID,
+		Status:        req.Status,
+		Category:      req.Category,
+		IssueType:     req.IssueType,
+		OrderID:       req.OrderId,
+		HasResolution: req.HasResolution,
+		SortBy:        req.SortBy,
+		Asc:           req.SortOrder == "ASC",
+	}
+	if req.Status != "" {
+		if err := validateStatus("status", req.Status); err != nil {
+			return q, err
+		}
+	}
+	if req.Category != "" {
+		if err := validateCategory("category", req.Category); err != nil {
+			return q, err
+		}
+	}
+	if req.IssueType != "" {
+		if err := validateIssueType("issue_type", req.IssueType); err != nil {
+			return q, err
+		}
+	}
+	if req.CreatedFrom != "" {
+		t, err := time.Parse(time.RFC3339, req.CreatedFrom)
+		if err != nil {
+			return q, invalidField("created_from", "invalid created_from: must be RFC3339")
+		}
+		q.CreatedFrom = &t
+	}
+	if req.CreatedTo != "" {
+		t, err := time.Parse(time.RFC3339, req.CreatedTo)
+		if err != nil {
+			return q, invalidField("created_to", "invalid created_to: must be RFC3339")
+		}
+		q.CreatedTo = &t
+	}
 
+	if req.Cursor != "" {
+		after, err := q.DecodeIssueCursor(req.Cursor)
+		if err != nil {
+			return q, invalidField("cursor", "%v", err)
+		}
+		q.After = after
+	} else {
+		q.Offset = (int(req.Page) - 1) * int(req.PageSize)
+	}
+	return q, nil
 }
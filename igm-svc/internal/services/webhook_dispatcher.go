@@ -0,0 +1,181 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/webhook"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookDispatcherConfig struct {
+	Interval  time.Duration
+	BatchSize int
+	Timeout   time.Duration
+	// MaxAttempts per delivery before it is marked DEAD.
+	MaxAttempts int
+	// BaseBackoff doubles per attempt up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// DisableAfter consecutive failed attempts across deliveries disables
+	// the subscription.
+	DisableAfter int
+}
+
+// WebhookDispatcher POSTs queued deliveries to subscribers, signing each
+// request with the subscription secret (see pkg/webhook).
+type WebhookDispatcher struct {
+	webhookRepo   repository.WebhookRepository
+	client        *http.Client
+	dispatcherCfg WebhookDispatcherConfig
+}
+
+func NewWebhookDispatcher(webhookRepo repository.WebhookRepository, dispatcherCfg WebhookDispatcherConfig) *WebhookDispatcher {
+	if dispatcherCfg.Interval <= 0 {
+		dispatcherCfg.Interval = 5 * time.Second
+	}
+	if dispatcherCfg.BatchSize <= 0 {
+		dispatcherCfg.BatchSize = 50
+	}
+	if dispatcherCfg.Timeout <= 0 {
+		dispatcherCfg.Timeout = 10 * time.Second
+	}
+	if dispatcherCfg.MaxAttempts <= 0 {
+		dispatcherCfg.MaxAttempts = 8
+	}
+	if dispatcherCfg.BaseBackoff <= 0 {
+		dispatcherCfg.BaseBackoff = 30 * time.Second
+	}
+	if dispatcherCfg.MaxBackoff <= 0 {
+		dispatcherCfg.MaxBackoff = time.Hour
+	}
+	if dispatcherCfg.DisableAfter <= 0 {
+		dispatcherCfg.DisableAfter = 20
+	}
+	return &WebhookDispatcher{
+		webhookRepo:   webhookRepo,
+		client:        &http.Client{Timeout: dispatcherCfg.Timeout},
+		dispatcherCfg: dispatcherCfg,
+	}
+}
+
+// Start runs the dispatcher until ctx is cancelled.
+func (d *WebhookDispatcher) Start(ctx context.Context) {
+	log.Printf("[WebhookDispatcher] started interval=%v", d.dispatcherCfg.Interval)
+	ticker := time.NewTicker(d.dispatcherCfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		d.RunOnce(ctx)
+		select {
+		case <-ctx.Done():
+			log.Printf("[WebhookDispatcher] stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *WebhookDispatcher) RunOnce(ctx context.Context) {
+	due, err := d.webhookRepo.FindDueDeliveries(ctx, time.Now(), d.dispatcherCfg.BatchSize)
+	if err != nil {
+		log.Printf("[WebhookDispatcher] %v", err)
+		return
+	}
+	subs := map[uuid.UUID]*models.WebhookSubscription{}
+	for _, delivery := range due {
+		sub, ok := subs[delivery.SubscriptionID]
+		if !ok {
+			if sub, err = d.webhookRepo.GetSubscription(ctx, delivery.SubscriptionID); err != nil {
+				log.Printf("[WebhookDispatcher] %v", err)
+				continue
+			}
+			subs[sub.ID] = sub
+		}
+		// an earlier delivery in this batch may have disabled it
+		if !sub.Active {
+			continue
+		}
+		d.attempt(ctx, sub, delivery)
+	}
+}
+
+func (d *WebhookDispatcher) attempt(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery) {
+	now := time.Now()
+	code, err := d.post(ctx, sub, delivery, now)
+	delivery.Attempts++
+	delivery.LastStatusCode = code
+
+	if err == nil {
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.LastError = ""
+		delivery.DeliveredAt = &now
+		if err := d.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+			log.Printf("[WebhookDispatcher] %v", err)
+		}
+		if sub.ConsecutiveFailures > 0 {
+			sub.ConsecutiveFailures = 0
+			if err := d.webhookRepo.RecordSuccess(ctx, sub.ID); err != nil {
+				log.Printf("[WebhookDispatcher] %v", err)
+			}
+		}
+		return
+	}
+
+	log.Printf("[WebhookDispatcher] delivery %d of %s to %s failed (attempt %d): %v", delivery.ID, delivery.EventID, sub.Name, delivery.Attempts, err)
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.dispatcherCfg.MaxAttempts {
+		delivery.Status = models.WebhookDeliveryDead
+	} else {
+		delivery.NextAttemptAt = now.Add(retryBackoff(d.dispatcherCfg.BaseBackoff, d.dispatcherCfg.MaxBackoff, delivery.Attempts))
+	}
+	if err := d.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		log.Printf("[WebhookDispatcher] %v", err)
+	}
+
+	sub.ConsecutiveFailures++
+	reason := fmt.Sprintf("%d consecutive failed deliveries, last: %s", d.dispatcherCfg.DisableAfter, delivery.LastError)
+	disabled, err := d.webhookRepo.RecordFailure(ctx, sub.ID, d.dispatcherCfg.DisableAfter, reason)
+	if err != nil {
+		log.Printf("[WebhookDispatcher] %v", err)
+		return
+	}
+	if disabled {
+		sub.Active = false
+		log.Printf("[WebhookDispatcher] disabled subscription %s (%s): %s", sub.ID, sub.Name, reason)
+	}
+}
+
+// post sends the delivery and returns the response status code, if any.
+func (d *WebhookDispatcher) post(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery, now time.Time) (int, error) {
+	body := []byte(delivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+	ts := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhook.HeaderEventID, delivery.EventID)
+	req.Header.Set(webhook.HeaderEventType, delivery.EventType)
+	req.Header.Set(webhook.HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(webhook.HeaderSignature, webhook.Sign(sub.Secret, ts, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/webhook"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/datatypes"
+)
+
+type fakeWebhookRepo struct {
+	repository.WebhookRepository
+	sub        *models.WebhookSubscription
+	deliveries []*models.WebhookDelivery
+}
+
+func (f *fakeWebhookRepo) GetSubscription(ctx context.Context, id uuid.UUID) (*models.WebhookSubscription, error) {
+	copied := *f.sub
+	return &copied, nil
+}
+
+func (f *fakeWebhookRepo) FindDueDeliveries(ctx context.Context, now time.Time, limit int) ([]*models.WebhookDelivery, error) {
+	var out []*models.WebhookDelivery
+	for _, d := range f.deliveries {
+		if f.sub.Active && d.Status == models.WebhookDeliveryPending && !d.NextAttemptAt.After(now) {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeWebhookRepo) UpdateDelivery(ctx context.Context, d *models.WebhookDelivery) error {
+	return nil
+}
+
+func (f *fakeWebhookRepo) RecordSuccess(ctx context.Context, id uuid.UUID) error {
+	f.sub.ConsecutiveFailures = 0
+	return nil
+}
+
+func (f *fakeWebhookRepo) RecordFailure(ctx context.Context, id uuid.UUID, disableAfter int, reason string) (bool, error) {
+	f.sub.ConsecutiveFailures++
+	if f.sub.Active && f.sub.ConsecutiveFailures >= disableAfter {
+		f.sub.Active = false
+		f.sub.DisabledReason = reason
+		return true, nil
+	}
+	return false, nil
+}
+
+func newTestDelivery(subID uuid.UUID, eventID string) *models.WebhookDelivery {
+	return &models.WebhookDelivery{
+		ID:             1,
+		SubscriptionID: subID,
+		EventID:        eventID,
+		EventType:      "igm.issue.resolved",
+		Payload:        datatypes.JSON(`{"event_id":"` + eventID + `"}`),
+		Status:         models.WebhookDeliveryPending,
+	}
+}
+
+func TestWebhookDispatcher_SignsAndDelivers(t *testing.T) {
+	var got *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sub := &models.WebhookSubscription{ID: uuid.New(), Name: "crm", URL: server.URL, Secret: "s3cret", Active: true, ConsecutiveFailures: 2}
+	delivery := newTestDelivery(sub.ID, "e-1")
+	repo := &fakeWebhookRepo{sub: sub, deliveries: []*models.WebhookDelivery{delivery}}
+
+	NewWebhookDispatcher(repo, WebhookDispatcherConfig{}).RunOnce(context.Background())
+
+	require.NotNil(t, got)
+	assert.Equal(t, "e-1", got.Header.Get(webhook.HeaderEventID))
+	assert.Equal(t, "igm.issue.resolved", got.Header.Get(webhook.HeaderEventType))
+	assert.NoError(t, webhook.Verify("s3cret", got.Header.Get(webhook.HeaderTimestamp), got.Header.Get(webhook.HeaderSignature), body, time.Minute, time.Now()))
+
+	assert.Equal(t, models.WebhookDeliverySucceeded, delivery.Status)
+	assert.Equal(t, http.StatusNoContent, delivery.LastStatusCode)
+	assert.NotNil(t, delivery.DeliveredAt)
+	assert.Zero(t, sub.ConsecutiveFailures)
+}
+
+func TestWebhookDispatcher_BacksOffAndDisables(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	sub := &models.WebhookSubscription{ID: uuid.New(), Name: "crm", URL: server.URL, Secret: "s3cret", Active: true}
+	delivery := newTestDelivery(sub.ID, "e-1")
+	repo := &fakeWebhookRepo{sub: sub, deliveries: []*models.WebhookDelivery{delivery}}
+	dispatcher := NewWebhookDispatcher(repo, WebhookDispatcherConfig{MaxAttempts: 3, BaseBackoff: time.Minute, DisableAfter: 2})
+
+	before := time.Now()
+	dispatcher.RunOnce(context.Background())
+	assert.Equal(t, models.WebhookDeliveryPending, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusBadGateway, delivery.LastStatusCode)
+	assert.True(t, delivery.NextAttemptAt.After(before.Add(59*time.Second)))
+	assert.True(t, sub.Active)
+
+	delivery.NextAttemptAt = time.Now()
+	dispatcher.RunOnce(context.Background())
+	assert.Equal(t, 2, delivery.Attempts)
+	assert.False(t, sub.Active)
+	assert.Contains(t, sub.DisabledReason, "unexpected status 502")
+
+	// disabled subscriptions are not attempted
+	delivery.NextAttemptAt = time.Now()
+	dispatcher.RunOnce(context.Background())
+	assert.Equal(t, 2, delivery.Attempts)
+}
+
+func TestWebhookDispatcher_MarksDeadAfterMaxAttempts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sub := &models.WebhookSubscription{ID: uuid.New(), URL: server.URL, Active: true}
+	delivery := newTestDelivery(sub.ID, "e-1")
+	delivery.Attempts = 1
+	repo := &fakeWebhookRepo{sub: sub, deliveries: []*models.WebhookDelivery{delivery}}
+
+	NewWebhookDispatcher(repo, WebhookDispatcherConfig{MaxAttempts: 2}).RunOnce(context.Background())
+	assert.Equal(t, models.WebhookDeliveryDead, delivery.Status)
+}
+
+func TestWebhookMatches(t *testing.T) {
+	sub := func(types ...string) *models.WebhookSubscription {
+		b, _ := json.Marshal(types)
+		return &models.WebhookSubscription{EventTypes: datatypes.JSON(b)}
+	}
+	assert.True(t, webhookMatches(&models.WebhookSubscription{}, "igm.issue.created"))
+	assert.True(t, webhookMatches(sub(), "igm.issue.created"))
+	assert.True(t, webhookMatches(sub("*"), "igm.issue.created"))
+	assert.True(t, webhookMatches(sub("igm.issue.resolved", "igm.issue.closed"), "igm.issue.closed"))
+	assert.False(t, webhookMatches(sub("igm.issue.resolved"), "igm.issue.created"))
+	assert.True(t, webhookMatches(sub("igm.issue.*"), "igm.issue.sla_breached"))
+	assert.False(t, webhookMatches(sub("igm.order.*"), "igm.issue.created"))
+}
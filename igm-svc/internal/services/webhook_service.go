@@ -0,0 +1,231 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"igm-svc/internal/auth"
+	"igm-svc/internal/mapper"
+	"igm-svc/internal/models"
+	"igm-svc/internal/repository"
+	"igm-svc/pkg/events"
+	"igm-svc/pkg/webhook"
+	"strings"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+	pb "igm-svc/api/proto/igm/v1"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// WebhookService manages webhook subscriptions and queues domain events for
+// them; WebhookDispatcher does the HTTP delivery.
+type WebhookService struct {
+	webhookRepo repository.WebhookRepository
+}
+
+func NewWebhookService(webhookRepo repository.WebhookRepository) *WebhookService {
+	return &WebhookService{webhookRepo: webhookRepo}
+}
+
+// HandleEvent is an events.Handler queueing event for every active
+// subscription whose filter matches. Disabled subscriptions miss events
+// until they are re-enabled.
+func (s *WebhookService) HandleEvent(ctx context.Context, event *eventsv1.DomainEvent) error {
+	subs, err := s.webhookRepo.ListSubscriptions(ctx, true)
+	if err != nil {
+		return err
+	}
+	var payload []byte
+	for _, sub := range subs {
+		if !webhookMatches(sub, event.GetEventType()) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = events.Encode(event); err != nil {
+				return fmt.Errorf("failed to encode event: %w", err)
+			}
+		}
+		err := s.webhookRepo.EnqueueDelivery(ctx, &models.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        event.GetEventId(),
+			EventType:      event.GetEventType(),
+			Payload:        datatypes.JSON(payload),
+			Status:         models.WebhookDeliveryPending,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *WebhookService) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	if err := auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService); err != nil {
+		return nil, err
+	}
+	if err := ValidateWebhookSubscription(req.Url, req.EventTypes); err != nil {
+		return nil, err
+	}
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, err
+		}
+	}
+	eventTypes, err := json.Marshal(req.EventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event_types: %w", err)
+	}
+
+	sub := &models.WebhookSubscription{
+		ID:         uuid.New(),
+		Name:       req.Name,
+		URL:        req.Url,
+		Secret:     secret,
+		EventTypes: datatypes.JSON(eventTypes),
+		Active:     true,
+	}
+	if err := s.webhookRepo.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	resp := mapper.ToProtoWebhookSubscription(sub)
+	resp.Secret = secret
+	return resp, nil
+}
+
+func (s *WebhookService) GetWebhookSubscription(ctx context.Context, req *pb.GetWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	if err := auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService); err != nil {
+		return nil, err
+	}
+	sub, err := s.getSubscription(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return mapper.ToProtoWebhookSubscription(sub), nil
+}
+
+func (s *WebhookService) ListWebhookSubscriptions(ctx context.Context, req *pb.ListWebhookSubscriptionsRequest) (*pb.ListWebhookSubscriptionsResponse, error) {
+	if err := auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService); err != nil {
+		return nil, err
+	}
+	subs, err := s.webhookRepo.ListSubscriptions(ctx, req.GetActiveOnly())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListWebhookSubscriptionsResponse{Subscriptions: mapper.ToProtoWebhookSubscriptions(subs)}, nil
+}
+
+// UpdateWebhookSubscription replaces name, url, filter and active flag.
+// Re-enabling clears the failure streak.
+func (s *WebhookService) UpdateWebhookSubscription(ctx context.Context, req *pb.UpdateWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	if err := auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService); err != nil {
+		return nil, err
+	}
+	if err := ValidateWebhookSubscription(req.Url, req.EventTypes); err != nil {
+		return nil, err
+	}
+	sub, err := s.getSubscription(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := json.Marshal(req.EventTypes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event_types: %w", err)
+	}
+
+	sub.Name = req.Name
+	sub.URL = req.Url
+	sub.EventTypes = datatypes.JSON(eventTypes)
+	if req.Active && !sub.Active {
+		sub.ConsecutiveFailures = 0
+		sub.DisabledAt = nil
+		sub.DisabledReason = ""
+	}
+	if !req.Active && sub.Active {
+		sub.DisabledReason = "disabled by operator"
+	}
+	sub.Active = req.Active
+
+	var secret string
+	if req.RotateSecret {
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, err
+		}
+		sub.Secret = secret
+	}
+	if err := s.webhookRepo.UpdateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	resp := mapper.ToProtoWebhookSubscription(sub)
+	resp.Secret = secret
+	return resp, nil
+}
+
+func (s *WebhookService) DeleteWebhookSubscription(ctx context.Context, req *pb.DeleteWebhookSubscriptionRequest) (*pb.DeleteWebhookSubscriptionResponse, error) {
+	if err := auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, invalidField("id", "invalid id")
+	}
+	deleted, err := s.webhookRepo.DeleteSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, repository.ErrWebhookSubscriptionNotFound
+	}
+	return &pb.DeleteWebhookSubscriptionResponse{Id: id.String(), Deleted: true}, nil
+}
+
+func (s *WebhookService) ListWebhookDeliveries(ctx context.Context, req *pb.ListWebhookDeliveriesRequest) (*pb.ListWebhookDeliveriesResponse, error) {
+	if err := auth.RequireRole(ctx, auth.RoleSupport, auth.RoleService); err != nil {
+		return nil, err
+	}
+	sub, err := s.getSubscription(ctx, req.GetSubscriptionId())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	deliveries, err := s.webhookRepo.ListDeliveries(ctx, sub.ID, req.GetStatus(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListWebhookDeliveriesResponse{Deliveries: mapper.ToProtoWebhookDeliveries(deliveries)}, nil
+}
+
+func (s *WebhookService) getSubscription(ctx context.Context, rawID string) (*models.WebhookSubscription, error) {
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, invalidField("id", "invalid id")
+	}
+	return s.webhookRepo.GetSubscription(ctx, id)
+}
+
+// webhookMatches applies the subscription filter: exact event types,
+// "prefix.*" patterns, "*", or no filter for everything.
+func webhookMatches(sub *models.WebhookSubscription, eventType string) bool {
+	var filters []string
+	if len(sub.EventTypes) > 0 {
+		_ = json.Unmarshal(sub.EventTypes, &filters)
+	}
+	if len(filters) == 0 {
+		return true
+	}
+	for _, f := range filters {
+		if f == "*" || f == eventType {
+			return true
+		}
+		if strings.HasSuffix(f, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(f, "*")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_sla_policies_key;
+DROP TABLE IF EXISTS sla_policies;
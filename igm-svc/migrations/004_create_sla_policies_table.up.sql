@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS sla_policies (
+    id SERIAL PRIMARY KEY,
+
+    -- Lookup key; empty string acts as a wildcard
+    domain VARCHAR(100) NOT NULL DEFAULT '',
+    category VARCHAR(100) NOT NULL DEFAULT '',
+    sub_category VARCHAR(100) NOT NULL DEFAULT '',
+
+    -- ISO-8601 durations
+    expected_response_time VARCHAR(50) NOT NULL,
+    expected_resolution_time VARCHAR(50) NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sla_policies_key
+    ON sla_policies (domain, category, sub_category);
+
+
+INSERT INTO sla_policies (domain, category, sub_category, expected_response_time, expected_resolution_time)
+VALUES ('', '', '', 'PT2H', 'P1D')
+ON CONFLICT (domain, category, sub_category) DO NOTHING;
+
+
+COMMENT ON TABLE sla_policies IS 'IGM response/resolution timelines keyed by domain, category and sub-category';
+COMMENT ON COLUMN sla_policies.domain IS 'ONDC domain (e.g. nic2004:60232), empty matches any domain';
+COMMENT ON COLUMN sla_policies.sub_category IS 'ONDC sub-category code (e.g. FLM02), empty matches any sub-category';
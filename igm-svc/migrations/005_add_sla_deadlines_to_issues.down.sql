@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_issues_resolve_by;
+DROP INDEX IF EXISTS idx_issues_respond_by;
+
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS resolve_by,
+    DROP COLUMN IF EXISTS respond_by;
@@ -0,0 +1,11 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS respond_by TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS resolve_by TIMESTAMPTZ;
+
+
+CREATE INDEX IF NOT EXISTS idx_issues_respond_by ON issues(respond_by);
+CREATE INDEX IF NOT EXISTS idx_issues_resolve_by ON issues(resolve_by);
+
+
+COMMENT ON COLUMN issues.respond_by IS 'created_at + expected_response_time';
+COMMENT ON COLUMN issues.resolve_by IS 'created_at + expected_resolution_time';
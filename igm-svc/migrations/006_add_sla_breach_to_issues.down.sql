@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_issues_resolution_breached_at;
+DROP INDEX IF EXISTS idx_issues_response_breached_at;
+
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS resolution_breached_at,
+    DROP COLUMN IF EXISTS response_breached_at;
@@ -0,0 +1,11 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS response_breached_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS resolution_breached_at TIMESTAMPTZ;
+
+
+CREATE INDEX IF NOT EXISTS idx_issues_response_breached_at ON issues(response_breached_at);
+CREATE INDEX IF NOT EXISTS idx_issues_resolution_breached_at ON issues(resolution_breached_at);
+
+
+COMMENT ON COLUMN issues.response_breached_at IS 'Set when respond_by passed without any respondent action';
+COMMENT ON COLUMN issues.resolution_breached_at IS 'Set when resolve_by passed without a RESOLVED respondent action';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_issues_next_status_poll_at;
+
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS next_status_poll_at,
+    DROP COLUMN IF EXISTS last_status_poll_at;
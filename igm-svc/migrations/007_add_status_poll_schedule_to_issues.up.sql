@@ -0,0 +1,10 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS last_status_poll_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS next_status_poll_at TIMESTAMPTZ;
+
+
+CREATE INDEX IF NOT EXISTS idx_issues_next_status_poll_at ON issues(next_status_poll_at);
+
+
+COMMENT ON COLUMN issues.last_status_poll_at IS 'Last time the scheduler sent /issue_status for this issue';
+COMMENT ON COLUMN issues.next_status_poll_at IS 'Earliest time the scheduler may poll this issue again; NULL means due';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_issues_resolved_at;
+
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS auto_closed_at,
+    DROP COLUMN IF EXISTS resolved_at;
@@ -0,0 +1,10 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS resolved_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS auto_closed_at TIMESTAMPTZ;
+
+
+CREATE INDEX IF NOT EXISTS idx_issues_resolved_at ON issues(resolved_at);
+
+
+COMMENT ON COLUMN issues.resolved_at IS 'updated_at of the RESOLVED respondent action that started the acceptance window';
+COMMENT ON COLUMN issues.auto_closed_at IS 'Set when the BAP closed the issue because the complainant did not respond in time';
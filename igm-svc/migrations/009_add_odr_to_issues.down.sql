@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_issues_odr_provider_id;
+
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS dispute_raised_at,
+    DROP COLUMN IF EXISTS odr_provider_uri,
+    DROP COLUMN IF EXISTS odr_provider_id;
@@ -0,0 +1,11 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS odr_provider_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS odr_provider_uri TEXT,
+    ADD COLUMN IF NOT EXISTS dispute_raised_at TIMESTAMPTZ;
+
+
+CREATE INDEX IF NOT EXISTS idx_issues_odr_provider_id ON issues(odr_provider_id);
+
+
+COMMENT ON COLUMN issues.odr_provider_id IS 'Online Dispute Resolution provider selected by the complainant';
+COMMENT ON COLUMN issues.odr_provider_uri IS 'URI the dispute was forwarded to';
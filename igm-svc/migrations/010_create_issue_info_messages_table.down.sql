@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_issue_info_messages_issue_id;
+DROP INDEX IF EXISTS idx_issue_info_messages_round;
+
+DROP TABLE IF EXISTS issue_info_messages;
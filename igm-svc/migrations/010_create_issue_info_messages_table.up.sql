@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS issue_info_messages (
+    id BIGSERIAL PRIMARY KEY,
+
+    issue_id TEXT NOT NULL,
+
+    -- NEED-MORE-INFO (respondent) or INFO_PROVIDED (complainant)
+    action VARCHAR(50) NOT NULL,
+    actor VARCHAR(20) NOT NULL,
+
+    short_desc TEXT,
+    long_desc TEXT,
+    images JSONB,
+    additional_desc_url TEXT,
+    additional_desc_content_type VARCHAR(100),
+    updated_by VARCHAR(255),
+
+    -- time of the action itself, as reported by the network participant
+    action_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+
+-- respondent actions are replayed on every callback; this keeps each round once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_issue_info_messages_round
+    ON issue_info_messages (issue_id, action, action_at);
+
+CREATE INDEX IF NOT EXISTS idx_issue_info_messages_issue_id
+    ON issue_info_messages (issue_id, action_at);
+
+
+COMMENT ON TABLE issue_info_messages IS 'NEED-MORE-INFO / INFO_PROVIDED exchanges between respondent and complainant';
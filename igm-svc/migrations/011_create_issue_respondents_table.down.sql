@@ -0,0 +1,6 @@
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS cascaded_level;
+
+DROP INDEX IF EXISTS idx_issue_respondents_level;
+
+DROP TABLE IF EXISTS issue_respondents;
@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS issue_respondents (
+    id BIGSERIAL PRIMARY KEY,
+
+    issue_id TEXT NOT NULL,
+    -- 1 is the BPP; each cascade adds a level
+    cascaded_level INT NOT NULL,
+
+    org_name VARCHAR(255),
+    person_name VARCHAR(255),
+    phone VARCHAR(20),
+    email VARCHAR(255),
+
+    -- grievance redressal officer for this level
+    gro_name VARCHAR(255),
+    gro_phone VARCHAR(20),
+    gro_email VARCHAR(255),
+    gro_type VARCHAR(50),
+
+    last_action VARCHAR(50),
+    last_action_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_issue_respondents_level
+    ON issue_respondents (issue_id, cascaded_level);
+
+
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS cascaded_level INT NOT NULL DEFAULT 1;
+
+
+COMMENT ON TABLE issue_respondents IS 'Respondent chain of an issue, one row per cascade level';
+COMMENT ON COLUMN issues.cascaded_level IS 'Cascade level currently responsible for the issue';
@@ -0,0 +1,2 @@
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS refund_amount;
@@ -0,0 +1,5 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS refund_amount VARCHAR(50);
+
+
+COMMENT ON COLUMN issues.refund_amount IS 'Refund amount from the latest resolution, as sent by the BPP';
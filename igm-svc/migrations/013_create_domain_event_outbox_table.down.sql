@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_domain_event_outbox_pending;
+DROP INDEX IF EXISTS idx_domain_event_outbox_event_id;
+
+DROP TABLE IF EXISTS domain_event_outbox;
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS domain_event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- consumer-side dedupe id, kept across redeliveries
+    event_id TEXT NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    issue_id TEXT,
+
+    -- igm.events.v1.DomainEvent as protojson
+    payload JSONB NOT NULL,
+
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_domain_event_outbox_event_id
+    ON domain_event_outbox (event_id);
+
+CREATE INDEX IF NOT EXISTS idx_domain_event_outbox_pending
+    ON domain_event_outbox (next_attempt_at, id)
+    WHERE published_at IS NULL;
+
+
+COMMENT ON TABLE domain_event_outbox IS 'Domain events waiting to be relayed to the event publisher';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_notifications_due;
+DROP INDEX IF EXISTS idx_notifications_user_id;
+DROP INDEX IF EXISTS idx_notifications_event_channel;
+
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS notification_preferences;
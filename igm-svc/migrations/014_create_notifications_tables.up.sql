@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY,
+
+    sms_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    email_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    push_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    push_token TEXT,
+
+    -- HH:MM in timezone; SMS and push wait until quiet_hours_end
+    quiet_hours_start VARCHAR(5),
+    quiet_hours_end VARCHAR(5),
+    timezone VARCHAR(64),
+
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+
+    event_id TEXT NOT NULL,
+    issue_id TEXT NOT NULL,
+    user_id UUID NOT NULL,
+
+    -- CREATED, RESPONDENT_PROCESSING, NEED_MORE_INFO, RESOLVED, AUTO_CLOSED, SLA_BREACHED
+    kind VARCHAR(50) NOT NULL,
+    -- SMS, EMAIL, PUSH
+    channel VARCHAR(20) NOT NULL,
+    recipient TEXT,
+    subject TEXT,
+    body TEXT,
+
+    -- PENDING, SENDING, SENT, DEFERRED, SKIPPED, FAILED
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    send_after TIMESTAMPTZ,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+
+-- domain events are redelivered; each event notifies a channel once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_notifications_event_channel
+    ON notifications (event_id, channel);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id
+    ON notifications (user_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_due
+    ON notifications (send_after)
+    WHERE status IN ('PENDING', 'SENDING', 'DEFERRED');
+
+
+COMMENT ON TABLE notifications IS 'Audit of every customer notification, including skipped and deferred ones';
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription;
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_event;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
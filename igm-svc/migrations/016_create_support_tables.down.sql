@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_support_audit_log_actor;
+DROP INDEX IF EXISTS idx_support_audit_log_issue_id;
+DROP INDEX IF EXISTS idx_issue_notes_issue_id;
+
+DROP TABLE IF EXISTS support_audit_log;
+DROP TABLE IF EXISTS issue_notes;
+
+DROP INDEX IF EXISTS idx_issues_assigned_to;
+
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS assigned_at,
+    DROP COLUMN IF EXISTS assigned_to;
@@ -0,0 +1,46 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS assigned_to TEXT,
+    ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_issues_assigned_to
+    ON issues (assigned_to)
+    WHERE assigned_to IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS issue_notes (
+    id BIGSERIAL PRIMARY KEY,
+
+    issue_id TEXT NOT NULL,
+    author TEXT NOT NULL,
+    body TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS support_audit_log (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- empty for actions across issues, such as SEARCH
+    issue_id TEXT,
+    actor TEXT NOT NULL,
+    actor_role VARCHAR(20) NOT NULL,
+    -- SEARCH, VIEW_DETAILS, ADD_NOTE, REASSIGN, FORCE_STATUS, VIEW_AUDIT_LOG
+    action VARCHAR(50) NOT NULL,
+    reason TEXT,
+    details JSONB,
+
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+
+CREATE INDEX IF NOT EXISTS idx_issue_notes_issue_id
+    ON issue_notes (issue_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_support_audit_log_issue_id
+    ON support_audit_log (issue_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_support_audit_log_actor
+    ON support_audit_log (actor, created_at DESC);
+
+
+COMMENT ON TABLE issue_notes IS 'Support-only notes on an issue, never shown to the complainant or sent to the BPP';
+COMMENT ON TABLE support_audit_log IS 'Every SupportService call, including reads';
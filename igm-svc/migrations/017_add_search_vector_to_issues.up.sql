@@ -0,0 +1,15 @@
+-- respondent_actions holds a protojson IssueActions message
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('english'::regconfig, coalesce(description_short, '')), 'A') ||
+        setweight(to_tsvector('english'::regconfig, coalesce(description_long, '')), 'B') ||
+        setweight(to_tsvector('english'::regconfig,
+            coalesce(jsonb_path_query_array(respondent_actions, '$.respondentActions[*].shortDesc')::text, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_issues_search_vector
+    ON issues USING GIN (search_vector);
+
+
+COMMENT ON COLUMN issues.search_vector IS 'Full-text index over the descriptions and respondent short descriptions, used by SearchIssues';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_attachments_user;
+DROP INDEX IF EXISTS idx_attachments_storage_key;
+
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(128) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    -- pixel dimensions, images only
+    width INTEGER,
+    height INTEGER,
+    sha256 CHAR(64) NOT NULL,
+    -- object key in the configured store (local directory or S3 bucket)
+    storage_key TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attachments_storage_key
+    ON attachments (storage_key);
+
+CREATE INDEX IF NOT EXISTS idx_attachments_user
+    ON attachments (user_id, created_at DESC);
+
+
+COMMENT ON TABLE attachments IS 'Files uploaded by complainants; issues reference them through signed URLs';
@@ -0,0 +1,7 @@
+ALTER TABLE issue_info_messages
+    DROP COLUMN IF EXISTS additional_desc_attachment_id,
+    DROP COLUMN IF EXISTS attachment_ids;
+
+ALTER TABLE issues
+    DROP COLUMN IF EXISTS description_attachment_id,
+    DROP COLUMN IF EXISTS attachment_ids;
@@ -0,0 +1,11 @@
+ALTER TABLE issues
+    ADD COLUMN IF NOT EXISTS attachment_ids JSONB,
+    ADD COLUMN IF NOT EXISTS description_attachment_id UUID;
+
+ALTER TABLE issue_info_messages
+    ADD COLUMN IF NOT EXISTS attachment_ids JSONB,
+    ADD COLUMN IF NOT EXISTS additional_desc_attachment_id UUID;
+
+
+COMMENT ON COLUMN issues.attachment_ids IS 'Uploaded images; signed URLs are generated for every payload because they expire';
+COMMENT ON COLUMN issues.description_attachment_id IS 'Uploaded additional_desc file, used instead of description_url';
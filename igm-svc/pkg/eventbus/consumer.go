@@ -0,0 +1,265 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Handler processes one event. Returning nil acknowledges it; an error leaves
+// it pending so it is redelivered after MinIdle.
+type Handler func(ctx context.Context, event Event) error
+
+type ConsumerConfig struct {
+	// Stream defaults to GlobalStream.
+	Stream string
+	// Group is shared by all instances of a consuming service.
+	Group string
+	// Consumer identifies this instance within the group, e.g. the hostname.
+	Consumer string
+	// StartID is where a newly created group starts: "$" (default) for new
+	// events only, "0" for the whole retained stream.
+	StartID string
+
+	BatchSize int64
+	Block     time.Duration
+
+	// MinIdle is how long an entry stays pending on a consumer before another
+	// consumer may reclaim it.
+	MinIdle         time.Duration
+	ReclaimInterval time.Duration
+	// MaxDeliveries drops an entry after this many failed deliveries,
+	// copying it to DeadLetterStream when set. 0 retries forever.
+	MaxDeliveries    int64
+	DeadLetterStream string
+}
+
+// Consumer reads a stream through a consumer group, acknowledging events its
+// handler processed and reclaiming entries left pending by crashed consumers.
+type Consumer struct {
+	client  redis.UniversalClient
+	cfg     ConsumerConfig
+	handler Handler
+}
+
+func NewConsumer(client redis.UniversalClient, cfg ConsumerConfig, handler Handler) (*Consumer, error) {
+	if cfg.Group == "" {
+		return nil, fmt.Errorf("eventbus: consumer group is required")
+	}
+	if cfg.Consumer == "" {
+		return nil, fmt.Errorf("eventbus: consumer name is required")
+	}
+	if handler == nil {
+		return nil, fmt.Errorf("eventbus: handler is required")
+	}
+	if cfg.Stream == "" {
+		cfg.Stream = GlobalStream
+	}
+	if cfg.StartID == "" {
+		cfg.StartID = "$"
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 50
+	}
+	if cfg.Block <= 0 {
+		cfg.Block = 5 * time.Second
+	}
+	if cfg.MinIdle <= 0 {
+		cfg.MinIdle = time.Minute
+	}
+	if cfg.ReclaimInterval <= 0 {
+		cfg.ReclaimInterval = 30 * time.Second
+	}
+	return &Consumer{client: client, cfg: cfg, handler: handler}, nil
+}
+
+// Run consumes until ctx is cancelled. It first replays entries still pending
+// on this consumer from a previous run.
+func (c *Consumer) Run(ctx context.Context) error {
+	if err := c.ensureGroup(ctx); err != nil {
+		return err
+	}
+	if err := c.drainOwnPending(ctx); err != nil {
+		return err
+	}
+
+	lastReclaim := time.Time{}
+	for ctx.Err() == nil {
+		if time.Since(lastReclaim) >= c.cfg.ReclaimInterval {
+			if err := c.Reclaim(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("[eventbus] reclaim on %s/%s failed: %v", c.cfg.Stream, c.cfg.Group, err)
+			}
+			lastReclaim = time.Now()
+		}
+
+		events, err := c.read(ctx, ">")
+		if err != nil {
+			if ctx.Err() != nil {
+				break
+			}
+			log.Printf("[eventbus] read on %s/%s failed: %v", c.cfg.Stream, c.cfg.Group, err)
+			sleep(ctx, time.Second)
+			continue
+		}
+		c.process(ctx, events)
+	}
+	return nil
+}
+
+// Reclaim takes over entries idle on other consumers for at least MinIdle and
+// processes them, dropping those past MaxDeliveries.
+//
+// It uses XPENDING + XCLAIM rather than XAUTOCLAIM, whose Redis 7 reply
+// go-redis v8 cannot parse.
+func (c *Consumer) Reclaim(ctx context.Context) error {
+	start := "-"
+	for ctx.Err() == nil {
+		pending, err := c.client.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: c.cfg.Stream,
+			Group:  c.cfg.Group,
+			Idle:   c.cfg.MinIdle,
+			Start:  start,
+			End:    "+",
+			Count:  c.cfg.BatchSize,
+		}).Result()
+		if err != nil && err != redis.Nil {
+			return fmt.Errorf("redis XPENDING failed: %w", err)
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		var ids []string
+		for _, p := range pending {
+			if c.cfg.MaxDeliveries > 0 && p.RetryCount >= c.cfg.MaxDeliveries {
+				c.drop(ctx, p)
+				continue
+			}
+			ids = append(ids, p.ID)
+		}
+		if len(ids) > 0 {
+			msgs, err := c.client.XClaim(ctx, &redis.XClaimArgs{
+				Stream:   c.cfg.Stream,
+				Group:    c.cfg.Group,
+				Consumer: c.cfg.Consumer,
+				MinIdle:  c.cfg.MinIdle,
+				Messages: ids,
+			}).Result()
+			if err != nil && err != redis.Nil {
+				return fmt.Errorf("redis XCLAIM failed: %w", err)
+			}
+			c.process(ctx, decode(c.cfg.Stream, msgs))
+		}
+
+		if int64(len(pending)) < c.cfg.BatchSize {
+			return nil
+		}
+		start = "(" + pending[len(pending)-1].ID
+	}
+	return nil
+}
+
+func (c *Consumer) ensureGroup(ctx context.Context) error {
+	err := c.client.XGroupCreateMkStream(ctx, c.cfg.Stream, c.cfg.Group, c.cfg.StartID).Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("failed to create consumer group %s: %w", c.cfg.Group, err)
+	}
+	return nil
+}
+
+func (c *Consumer) drainOwnPending(ctx context.Context) error {
+	for ctx.Err() == nil {
+		events, err := c.read(ctx, "0")
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		acked := c.process(ctx, events)
+		if acked == 0 {
+			// everything failed again; leave it to Reclaim
+			return nil
+		}
+	}
+	return nil
+}
+
+func (c *Consumer) read(ctx context.Context, id string) ([]Event, error) {
+	block := c.cfg.Block
+	if id != ">" {
+		// history reads return immediately
+		block = -1
+	}
+	streams, err := c.client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    c.cfg.Group,
+		Consumer: c.cfg.Consumer,
+		Streams:  []string{c.cfg.Stream, id},
+		Count:    c.cfg.BatchSize,
+		Block:    block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis XREADGROUP failed: %w", err)
+	}
+	var events []Event
+	for _, s := range streams {
+		events = append(events, decode(s.Stream, s.Messages)...)
+	}
+	return events, nil
+}
+
+// process runs the handler for each event and returns how many were acked.
+func (c *Consumer) process(ctx context.Context, events []Event) int {
+	acked := 0
+	for _, event := range events {
+		if ctx.Err() != nil {
+			return acked
+		}
+		if err := c.handler(ctx, event); err != nil {
+			log.Printf("[eventbus] handler failed for %s %s: %v", c.cfg.Stream, event.ID, err)
+			continue
+		}
+		if err := c.client.XAck(ctx, c.cfg.Stream, c.cfg.Group, event.ID).Err(); err != nil {
+			log.Printf("[eventbus] ack failed for %s %s: %v", c.cfg.Stream, event.ID, err)
+			continue
+		}
+		acked++
+	}
+	return acked
+}
+
+// drop acknowledges an entry that exhausted MaxDeliveries, copying it to
+// DeadLetterStream first when configured.
+func (c *Consumer) drop(ctx context.Context, p redis.XPendingExt) {
+	log.Printf("[eventbus] dropping %s %s after %d deliveries", c.cfg.Stream, p.ID, p.RetryCount)
+	if c.cfg.DeadLetterStream != "" {
+		msgs, err := c.client.XRangeN(ctx, c.cfg.Stream, p.ID, p.ID, 1).Result()
+		if err == nil && len(msgs) == 1 {
+			values := msgs[0].Values
+			values["source_id"] = p.ID
+			values["group"] = c.cfg.Group
+			if err := c.client.XAdd(ctx, &redis.XAddArgs{Stream: c.cfg.DeadLetterStream, Values: values}).Err(); err != nil {
+				log.Printf("[eventbus] dead-letter failed for %s: %v", p.ID, err)
+				return
+			}
+		}
+	}
+	if err := c.client.XAck(ctx, c.cfg.Stream, c.cfg.Group, p.ID).Err(); err != nil {
+		log.Printf("[eventbus] ack failed for %s %s: %v", c.cfg.Stream, p.ID, err)
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
@@ -0,0 +1,154 @@
+// Package eventbus is the Redis Streams event bus of the IGM service. Every
+// issue event is appended to the global stream and to a per-issue stream;
+// other services consume them through a consumer group with Consumer.
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	// GlobalStream receives every event.
+	GlobalStream = "igm:events"
+
+	issueStreamPrefix = "igm:events:issue:"
+
+	// PayloadField is the stream entry field holding the JSON event. Other
+	// publishers writing streams read with this package must use it too.
+	PayloadField = "payload"
+)
+
+// IssueStream is the stream holding only the events of one issue.
+func IssueStream(issueID string) string {
+	return issueStreamPrefix + issueID
+}
+
+// Event is one stream entry. Payload is the JSON object the IGM service
+// published; it always carries "action" and usually "issue_id" and
+// "transaction_id".
+type Event struct {
+	ID      string
+	Stream  string
+	Payload map[string]interface{}
+}
+
+func (e Event) Action() string {
+	action, _ := e.Payload["action"].(string)
+	return action
+}
+
+func (e Event) IssueID() string {
+	issueID, _ := e.Payload["issue_id"].(string)
+	return issueID
+}
+
+type PublisherConfig struct {
+	// MaxLen bounds the global stream (approximate trimming).
+	MaxLen int64
+	// IssueMaxLen bounds each per-issue stream.
+	IssueMaxLen int64
+	// IssueTTL expires per-issue streams that stop receiving events.
+	IssueTTL time.Duration
+}
+
+type Publisher struct {
+	client redis.UniversalClient
+	cfg    PublisherConfig
+}
+
+func NewPublisher(client redis.UniversalClient, cfg PublisherConfig) *Publisher {
+	if cfg.MaxLen <= 0 {
+		cfg.MaxLen = 100000
+	}
+	if cfg.IssueMaxLen <= 0 {
+		cfg.IssueMaxLen = 1000
+	}
+	if cfg.IssueTTL <= 0 {
+		cfg.IssueTTL = 30 * 24 * time.Hour
+	}
+	return &Publisher{client: client, cfg: cfg}
+}
+
+// Publish appends the event to the global stream and, when it names an
+// issue, to that issue's stream. It returns the global stream id.
+func (p *Publisher) Publish(ctx context.Context, payload map[string]interface{}) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal event: %w", err)
+	}
+	values := map[string]interface{}{PayloadField: data}
+
+	pipe := p.client.TxPipeline()
+	global := pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: GlobalStream,
+		MaxLen: p.cfg.MaxLen,
+		Approx: true,
+		Values: values,
+	})
+	if issueID, _ := payload["issue_id"].(string); issueID != "" {
+		stream := IssueStream(issueID)
+		pipe.XAdd(ctx, &redis.XAddArgs{
+			Stream: stream,
+			MaxLen: p.cfg.IssueMaxLen,
+			Approx: true,
+			Values: values,
+		})
+		pipe.Expire(ctx, stream, p.cfg.IssueTTL)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", fmt.Errorf("failed to publish event: %w", err)
+	}
+	return global.Val(), nil
+}
+
+// Read returns up to count events after afterID, blocking up to block when
+// there are none. It does not use a consumer group; use it for tailing a
+// stream from a client-held cursor.
+func Read(ctx context.Context, client redis.UniversalClient, stream, afterID string, block time.Duration, count int64) ([]Event, error) {
+	streams, err := client.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{stream, afterID},
+		Count:   count,
+		Block:   block,
+	}).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("redis XREAD failed: %w", err)
+	}
+	var events []Event
+	for _, s := range streams {
+		events = append(events, decode(s.Stream, s.Messages)...)
+	}
+	return events, nil
+}
+
+// LatestID returns the id of the newest entry, or "0-0" for an empty stream,
+// so a reader can start with only new events.
+func LatestID(ctx context.Context, client redis.UniversalClient, stream string) (string, error) {
+	msgs, err := client.XRevRangeN(ctx, stream, "+", "-", 1).Result()
+	if err != nil && err != redis.Nil {
+		return "", fmt.Errorf("redis XREVRANGE failed: %w", err)
+	}
+	if len(msgs) == 0 {
+		return "0-0", nil
+	}
+	return msgs[0].ID, nil
+}
+
+func decode(stream string, msgs []redis.XMessage) []Event {
+	events := make([]Event, 0, len(msgs))
+	for _, msg := range msgs {
+		event := Event{ID: msg.ID, Stream: stream}
+		if raw, ok := msg.Values[PayloadField].(string); ok {
+			_ = json.Unmarshal([]byte(raw), &event.Payload)
+		}
+		events = append(events, event)
+	}
+	return events
+}
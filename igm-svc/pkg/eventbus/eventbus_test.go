@@ -0,0 +1,85 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"igm-svc/pkg/redistest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishWritesGlobalAndIssueStreams(t *testing.T) {
+	ctx := context.Background()
+	client := redistest.NewClient(t)
+	pub := NewPublisher(client, PublisherConfig{})
+
+	_, err := pub.Publish(ctx, map[string]interface{}{"action": "issue", "issue_id": "issue-1"})
+	require.NoError(t, err)
+	_, err = pub.Publish(ctx, map[string]interface{}{"action": "issue", "issue_id": "issue-2"})
+	require.NoError(t, err)
+
+	global, err := Read(ctx, client, GlobalStream, "0-0", -1, 10)
+	require.NoError(t, err)
+	assert.Len(t, global, 2)
+
+	issue, err := Read(ctx, client, IssueStream("issue-1"), "0-0", -1, 10)
+	require.NoError(t, err)
+	require.Len(t, issue, 1)
+	assert.Equal(t, "issue-1", issue[0].IssueID())
+	assert.Equal(t, "issue", issue[0].Action())
+
+	latest, err := LatestID(ctx, client, GlobalStream)
+	require.NoError(t, err)
+	assert.Equal(t, global[1].ID, latest)
+}
+
+func TestConsumerAcksAndReclaims(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := redistest.NewClient(t)
+	pub := NewPublisher(client, PublisherConfig{})
+
+	cfg := ConsumerConfig{Group: "notifications", StartID: "0", Block: 10 * time.Millisecond}
+
+	// the first consumer fails every event, leaving them pending
+	failing, err := NewConsumer(client, withConsumer(cfg, "a"), func(ctx context.Context, e Event) error {
+		return errors.New("boom")
+	})
+	require.NoError(t, err)
+	require.NoError(t, failing.ensureGroup(ctx))
+
+	_, err = pub.Publish(ctx, map[string]interface{}{"action": "issue", "issue_id": "issue-1"})
+	require.NoError(t, err)
+	events, err := failing.read(ctx, ">")
+	require.NoError(t, err)
+	assert.Equal(t, 0, failing.process(ctx, events))
+
+	var mu sync.Mutex
+	var seen []string
+	reclaimCfg := withConsumer(cfg, "b")
+	reclaimCfg.MinIdle = time.Millisecond
+	healthy, err := NewConsumer(client, reclaimCfg, func(ctx context.Context, e Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		seen = append(seen, e.IssueID())
+		return nil
+	})
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+	require.NoError(t, healthy.Reclaim(ctx))
+	assert.Equal(t, []string{"issue-1"}, seen)
+
+	pending, err := client.XPending(ctx, GlobalStream, "notifications").Result()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), pending.Count)
+}
+
+func withConsumer(cfg ConsumerConfig, name string) ConsumerConfig {
+	cfg.Consumer = name
+	return cfg
+}
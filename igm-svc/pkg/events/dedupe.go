@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Deduper remembers handled event ids on the consumer side.
+type Deduper interface {
+	// FirstSeen records eventID and reports whether it was new.
+	FirstSeen(ctx context.Context, eventID string) (bool, error)
+}
+
+// RedisDeduper shares seen ids between the instances of a consuming service.
+type RedisDeduper struct {
+	client redis.UniversalClient
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedisDeduper keeps ids for ttl under "<prefix>:<event_id>"; use one
+// prefix per consuming service.
+func NewRedisDeduper(client redis.UniversalClient, prefix string, ttl time.Duration) *RedisDeduper {
+	return &RedisDeduper{client: client, prefix: prefix, ttl: ttl}
+}
+
+func (d *RedisDeduper) FirstSeen(ctx context.Context, eventID string) (bool, error) {
+	ok, err := d.client.SetNX(ctx, d.prefix+":"+eventID, 1, d.ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to record event %s: %w", eventID, err)
+	}
+	return ok, nil
+}
+
+// MemoryDeduper is a process-local Deduper that forgets ids after ttl.
+type MemoryDeduper struct {
+	mu   sync.Mutex
+	ttl  time.Duration
+	seen map[string]time.Time
+}
+
+func NewMemoryDeduper(ttl time.Duration) *MemoryDeduper {
+	return &MemoryDeduper{ttl: ttl, seen: map[string]time.Time{}}
+}
+
+func (d *MemoryDeduper) FirstSeen(ctx context.Context, eventID string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	if at, ok := d.seen[eventID]; ok && now.Sub(at) < d.ttl {
+		return false, nil
+	}
+	d.seen[eventID] = now
+	if len(d.seen) > 10000 {
+		for id, at := range d.seen {
+			if now.Sub(at) >= d.ttl {
+				delete(d.seen, id)
+			}
+		}
+	}
+	return true, nil
+}
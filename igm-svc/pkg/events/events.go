@@ -0,0 +1,121 @@
+// Package events defines the IGM domain events, versioned in
+// api/proto/igm/events/v1, and the publishers that deliver them.
+//
+// Delivery is at-least-once. Every event carries an event_id that stays the
+// same across redeliveries; consumers should drop ids they have already
+// handled, e.g. with a Deduper.
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// SchemaVersion is the version of api/proto/igm/events/v1 this package emits.
+const SchemaVersion = 1
+
+const (
+	TypeIssueCreated             = "igm.issue.created"
+	TypeIssueUpdated             = "igm.issue.updated"
+	TypeIssueEscalated           = "igm.issue.escalated"
+	TypeIssueResolved            = "igm.issue.resolved"
+	TypeIssueClosed              = "igm.issue.closed"
+	TypeRespondentActionReceived = "igm.issue.respondent_action_received"
+	TypeIssueStatusRequested     = "igm.issue.status_requested"
+	TypeIssueSLABreached         = "igm.issue.sla_breached"
+)
+
+// EventPublisher delivers domain events. Publish returns once the event is
+// durably accepted by the backend.
+type EventPublisher interface {
+	Publish(ctx context.Context, event *eventsv1.DomainEvent) error
+	Close() error
+}
+
+// New returns an envelope with a random event id. Use NewWithKey when the same
+// occurrence may be reported more than once.
+func New(issueID, transactionID string) *eventsv1.DomainEvent {
+	return &eventsv1.DomainEvent{
+		EventId:       uuid.New().String(),
+		SchemaVersion: SchemaVersion,
+		OccurredAt:    time.Now().UTC().Format(time.RFC3339),
+		IssueId:       issueID,
+		TransactionId: transactionID,
+	}
+}
+
+// NewWithKey derives the event id from key, so repeated reports of the same
+// occurrence (e.g. a BPP resending a callback) get the same id.
+func NewWithKey(issueID, transactionID, key string) *eventsv1.DomainEvent {
+	event := New(issueID, transactionID)
+	event.EventId = uuid.NewSHA1(uuid.NameSpaceOID, []byte(key)).String()
+	return event
+}
+
+// Type returns the event type for the envelope's payload.
+func Type(event *eventsv1.DomainEvent) string {
+	switch event.GetPayload().(type) {
+	case *eventsv1.DomainEvent_IssueCreated:
+		return TypeIssueCreated
+	case *eventsv1.DomainEvent_IssueUpdated:
+		return TypeIssueUpdated
+	case *eventsv1.DomainEvent_IssueEscalated:
+		return TypeIssueEscalated
+	case *eventsv1.DomainEvent_IssueResolved:
+		return TypeIssueResolved
+	case *eventsv1.DomainEvent_IssueClosed:
+		return TypeIssueClosed
+	case *eventsv1.DomainEvent_RespondentActionReceived:
+		return TypeRespondentActionReceived
+	case *eventsv1.DomainEvent_IssueStatusRequested:
+		return TypeIssueStatusRequested
+	case *eventsv1.DomainEvent_IssueSlaBreached:
+		return TypeIssueSLABreached
+	}
+	return ""
+}
+
+// Prepare fills the envelope fields a publisher relies on.
+func Prepare(event *eventsv1.DomainEvent) error {
+	if event == nil {
+		return fmt.Errorf("nil event")
+	}
+	if event.EventType == "" {
+		event.EventType = Type(event)
+	}
+	if event.EventType == "" {
+		return fmt.Errorf("event %s has no payload", event.EventId)
+	}
+	if event.EventId == "" {
+		event.EventId = uuid.New().String()
+	}
+	if event.SchemaVersion == 0 {
+		event.SchemaVersion = SchemaVersion
+	}
+	if event.OccurredAt == "" {
+		event.OccurredAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	return nil
+}
+
+// Encode is the wire format shared by all publishers: protojson with the
+// proto field names.
+func Encode(event *eventsv1.DomainEvent) ([]byte, error) {
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(event)
+}
+
+// Decode accepts events written by this or a later schema version; unknown
+// fields are ignored.
+func Decode(data []byte) (*eventsv1.DomainEvent, error) {
+	var event eventsv1.DomainEvent
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("failed to decode domain event: %w", err)
+	}
+	return &event, nil
+}
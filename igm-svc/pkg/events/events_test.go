@@ -0,0 +1,106 @@
+package events
+
+import (
+	"context"
+	"igm-svc/pkg/eventbus"
+	"igm-svc/pkg/redistest"
+	"testing"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func closedEvent() *eventsv1.DomainEvent {
+	event := New("issue-1", "txn-1")
+	event.Payload = &eventsv1.DomainEvent_IssueClosed{IssueClosed: &eventsv1.IssueClosed{Rating: "THUMBS-UP", Source: "COMPLAINANT"}}
+	return event
+}
+
+func TestRedisPublisherRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := redistest.NewClient(t)
+	pub := NewRedisPublisher(client, "", 0)
+
+	event := closedEvent()
+	require.NoError(t, pub.Publish(ctx, event))
+	assert.Equal(t, TypeIssueClosed, event.EventType)
+
+	msgs, err := client.XRange(ctx, DomainEventStream, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, event.EventId, msgs[0].Values["event_id"])
+	assert.Equal(t, TypeIssueClosed, msgs[0].Values["event_type"])
+
+	// what eventbus readers and consumers see
+	entries, err := eventbus.Read(ctx, client, DomainEventStream, "0-0", -1, 10)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.NotNil(t, entries[0].Payload)
+	assert.Equal(t, "issue-1", entries[0].IssueID())
+
+	decoded, err := DecodeBusEvent(entries[0])
+	require.NoError(t, err)
+	assert.Equal(t, event.EventId, decoded.EventId)
+	assert.Equal(t, int32(SchemaVersion), decoded.SchemaVersion)
+	assert.Equal(t, "THUMBS-UP", decoded.GetIssueClosed().GetRating())
+}
+
+func TestPublishRejectsEventWithoutPayload(t *testing.T) {
+	err := NewMemoryPublisher().Publish(context.Background(), New("issue-1", "txn-1"))
+	assert.Error(t, err)
+}
+
+func TestDecodeIgnoresUnknownFields(t *testing.T) {
+	decoded, err := Decode([]byte(`{"event_id":"e-1","event_type":"igm.issue.closed","added_in_v2":"x","issue_closed":{"rating":"THUMBS-DOWN"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "e-1", decoded.EventId)
+	assert.Equal(t, "THUMBS-DOWN", decoded.GetIssueClosed().GetRating())
+}
+
+func TestNewWithKeyIsStable(t *testing.T) {
+	a := NewWithKey("issue-1", "txn-1", "RESOLVED|2024-01-01T00:00:00Z")
+	b := NewWithKey("issue-1", "txn-1", "RESOLVED|2024-01-01T00:00:00Z")
+	c := NewWithKey("issue-1", "txn-1", "PROCESSING|2024-01-01T00:00:00Z")
+	assert.Equal(t, a.EventId, b.EventId)
+	assert.NotEqual(t, a.EventId, c.EventId)
+}
+
+func TestMemoryPublisherNotifiesSubscribers(t *testing.T) {
+	pub := NewMemoryPublisher()
+	ch := pub.Subscribe(1)
+
+	require.NoError(t, pub.Publish(context.Background(), closedEvent()))
+	select {
+	case got := <-ch:
+		assert.Equal(t, TypeIssueClosed, got.EventType)
+	case <-time.After(time.Second):
+		t.Fatal("subscriber did not receive event")
+	}
+	assert.Len(t, pub.Events(), 1)
+	require.NoError(t, pub.Close())
+}
+
+func TestDedupers(t *testing.T) {
+	ctx := context.Background()
+	for name, d := range map[string]Deduper{
+		"redis":  NewRedisDeduper(redistest.NewClient(t), "igm:test:seen", time.Hour),
+		"memory": NewMemoryDeduper(time.Hour),
+	} {
+		t.Run(name, func(t *testing.T) {
+			first, err := d.FirstSeen(ctx, "e-1")
+			require.NoError(t, err)
+			assert.True(t, first)
+
+			again, err := d.FirstSeen(ctx, "e-1")
+			require.NoError(t, err)
+			assert.False(t, again)
+
+			other, err := d.FirstSeen(ctx, "e-2")
+			require.NoError(t, err)
+			assert.True(t, other)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package events
+
+import (
+	"context"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+)
+
+// Handler reacts to an event inside this service. It sees every event at
+// least once and must be idempotent on event_id.
+type Handler func(ctx context.Context, event *eventsv1.DomainEvent) error
+
+type fanout struct {
+	publisher EventPublisher
+	handlers  []Handler
+}
+
+// Fanout publishes to publisher and then runs handlers in order. The first
+// error is returned so the caller retries the event; the publisher may
+// therefore see it again, which consumers already dedupe.
+func Fanout(publisher EventPublisher, handlers ...Handler) EventPublisher {
+	return &fanout{publisher: publisher, handlers: handlers}
+}
+
+func (f *fanout) Publish(ctx context.Context, event *eventsv1.DomainEvent) error {
+	if err := f.publisher.Publish(ctx, event); err != nil {
+		return err
+	}
+	for _, handle := range f.handlers {
+		if err := handle(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fanout) Close() error {
+	return f.publisher.Close()
+}
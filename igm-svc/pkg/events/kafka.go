@@ -0,0 +1,57 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaPublisher writes events to a Kafka-protocol topic keyed by issue id,
+// so events of one issue stay ordered within a partition.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaPublisher(brokers []string, topic string) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, event *eventsv1.DomainEvent) error {
+	if err := Prepare(event); err != nil {
+		return err
+	}
+	data, err := Encode(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.IssueId),
+		Value: data,
+		Headers: []kafka.Header{
+			{Key: "event_id", Value: []byte(event.EventId)},
+			{Key: "event_type", Value: []byte(event.EventType)},
+			{Key: "schema_version", Value: []byte(strconv.Itoa(int(event.SchemaVersion)))},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish event %s: %w", event.EventId, err)
+	}
+	return nil
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,69 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// MemoryPublisher keeps events in process. It is meant for tests and local
+// runs without Redis or Kafka.
+type MemoryPublisher struct {
+	mu          sync.Mutex
+	events      []*eventsv1.DomainEvent
+	subscribers []chan *eventsv1.DomainEvent
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (p *MemoryPublisher) Publish(ctx context.Context, event *eventsv1.DomainEvent) error {
+	if err := Prepare(event); err != nil {
+		return err
+	}
+	stored := proto.Clone(event).(*eventsv1.DomainEvent)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, stored)
+	for _, ch := range p.subscribers {
+		select {
+		case ch <- stored:
+		default:
+			// slow subscribers miss events rather than block publishers
+		}
+	}
+	return nil
+}
+
+// Events returns everything published so far.
+func (p *MemoryPublisher) Events() []*eventsv1.DomainEvent {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	out := make([]*eventsv1.DomainEvent, len(p.events))
+	copy(out, p.events)
+	return out
+}
+
+// Subscribe returns a channel receiving events published from now on.
+func (p *MemoryPublisher) Subscribe(buffer int) <-chan *eventsv1.DomainEvent {
+	ch := make(chan *eventsv1.DomainEvent, buffer)
+	p.mu.Lock()
+	p.subscribers = append(p.subscribers, ch)
+	p.mu.Unlock()
+	return ch
+}
+
+func (p *MemoryPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, ch := range p.subscribers {
+		close(ch)
+	}
+	p.subscribers = nil
+	return nil
+}
@@ -0,0 +1,75 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"igm-svc/pkg/eventbus"
+
+	eventsv1 "igm-svc/api/proto/igm/events/v1"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// DomainEventStream is the default stream for RedisPublisher. Consume it with
+// eventbus.Consumer and turn each entry back into the event with DecodeBusEvent.
+const DomainEventStream = "igm:domain-events"
+
+type RedisPublisher struct {
+	client redis.UniversalClient
+	stream string
+	maxLen int64
+}
+
+func NewRedisPublisher(client redis.UniversalClient, stream string, maxLen int64) *RedisPublisher {
+	if stream == "" {
+		stream = DomainEventStream
+	}
+	if maxLen <= 0 {
+		maxLen = 100000
+	}
+	return &RedisPublisher{client: client, stream: stream, maxLen: maxLen}
+}
+
+func (p *RedisPublisher) Publish(ctx context.Context, event *eventsv1.DomainEvent) error {
+	if err := Prepare(event); err != nil {
+		return err
+	}
+	data, err := Encode(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+	err = p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: true,
+		Values: map[string]interface{}{
+			"event_id":            event.EventId,
+			"event_type":          event.EventType,
+			"schema_version":      event.SchemaVersion,
+			"issue_id":            event.IssueId,
+			eventbus.PayloadField: data,
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to publish event %s: %w", event.EventId, err)
+	}
+	return nil
+}
+
+func (p *RedisPublisher) Close() error {
+	return nil
+}
+
+// DecodeBusEvent decodes an entry of a RedisPublisher stream read with
+// eventbus.Consumer or eventbus.Read.
+func DecodeBusEvent(e eventbus.Event) (*eventsv1.DomainEvent, error) {
+	if e.Payload == nil {
+		return nil, fmt.Errorf("stream entry %s has no %s field", e.ID, eventbus.PayloadField)
+	}
+	data, err := json.Marshal(e.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode stream entry %s: %w", e.ID, err)
+	}
+	return Decode(data)
+}
@@ -0,0 +1,78 @@
+// Package notify delivers rendered customer notifications over a channel.
+// Providers (SMS gateway, mail relay, push service) implement Notifier; the
+// log and file sinks here stand in for them during development.
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	ChannelSMS   = "SMS"
+	ChannelEmail = "EMAIL"
+	ChannelPush  = "PUSH"
+)
+
+// Message is a rendered notification. Reference identifies it in provider
+// logs and callbacks.
+type Message struct {
+	Reference string `json:"reference"`
+	Channel   string `json:"channel"`
+	Recipient string `json:"recipient"`
+	Subject   string `json:"subject,omitempty"`
+	Body      string `json:"body"`
+}
+
+type Notifier interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogNotifier writes messages to the service log.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Send(ctx context.Context, msg Message) error {
+	log.Printf("[Notify] %s to %s ref=%s subject=%q body=%q", msg.Channel, msg.Recipient, msg.Reference, msg.Subject, msg.Body)
+	return nil
+}
+
+// FileNotifier appends messages as JSON lines to a file.
+type FileNotifier struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+func (n *FileNotifier) Send(ctx context.Context, msg Message) error {
+	line, err := json.Marshal(struct {
+		Message
+		SentAt string `json:"sent_at"`
+	}{msg, time.Now().UTC().Format(time.RFC3339)})
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open notification file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+	return nil
+}
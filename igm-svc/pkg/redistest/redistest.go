@@ -0,0 +1,19 @@
+// Package redistest provides an in-memory Redis for tests.
+package redistest
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+// NewClient returns a client for a miniredis server that is shut down, with
+// the client, when t ends.
+func NewClient(t testing.TB) *redis.Client {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalPathPrefix is where LocalStore.Handler expects to be mounted.
+const LocalPathPrefix = "/attachments/"
+
+// LocalStore keeps objects under a directory. Its signed URLs point at
+// Handler, mounted under LocalPathPrefix on a listener reachable at baseURL:
+//
+//	<baseURL>/attachments/<key>?expires=<unix>&signature=<hex>
+//
+// The signature is an HMAC-SHA256 over "<key>\n<expires>". Keys should
+// carry a file extension; Handler derives the Content-Type from it.
+type LocalStore struct {
+	dir        string
+	baseURL    string
+	signingKey []byte
+	now        func() time.Time
+}
+
+func NewLocalStore(dir, baseURL, signingKey string) (*LocalStore, error) {
+	if signingKey == "" {
+		return nil, fmt.Errorf("local store needs a signing key")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create attachment dir: %w", err)
+	}
+	return &LocalStore{
+		dir:        dir,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		signingKey: []byte(signingKey),
+		now:        time.Now,
+	}, nil
+}
+
+// Put writes to a temporary file and renames it, so readers never see a
+// partial object.
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if !validKey(key) {
+		return ErrInvalidKey
+	}
+	dst := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return fmt.Errorf("failed to create object dir: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create object: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	n, err := io.Copy(tmp, io.LimitReader(r, size+1))
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if n != size {
+		return fmt.Errorf("object is %d bytes, expected %d", n, size)
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		return fmt.Errorf("failed to store object: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	if !validKey(key) {
+		return ErrInvalidKey
+	}
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalStore) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+	expires := s.now().Add(ttl).Unix()
+	query := url.Values{
+		"expires":   {strconv.FormatInt(expires, 10)},
+		"signature": {s.sign(key, expires)},
+	}
+	escaped := (&url.URL{Path: key}).EscapedPath()
+	return s.baseURL + LocalPathPrefix + escaped + "?" + query.Encode(), nil
+}
+
+// Handler serves GET and HEAD for URLs issued by SignedURL. Unsigned,
+// tampered and expired URLs get 403.
+func (s *LocalStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, LocalPathPrefix)
+		if !validKey(key) {
+			http.NotFound(w, r)
+			return
+		}
+		expires, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+		if err != nil || s.now().Unix() > expires ||
+			!hmac.Equal([]byte(r.URL.Query().Get("signature")), []byte(s.sign(key, expires))) {
+			http.Error(w, "invalid or expired signature", http.StatusForbidden)
+			return
+		}
+
+		f, err := os.Open(s.path(key))
+		if errors.Is(err, os.ErrNotExist) {
+			http.NotFound(w, r)
+			return
+		}
+		if err != nil {
+			http.Error(w, "failed to open object", http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			http.Error(w, "failed to open object", http.StatusInternalServerError)
+			return
+		}
+
+		contentType := mime.TypeByExtension(path.Ext(key))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", max(expires-s.now().Unix(), 0)))
+		http.ServeContent(w, r, "", info.ModTime(), f)
+	})
+}
+
+func (s *LocalStore) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *LocalStore) path(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
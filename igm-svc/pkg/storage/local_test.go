@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLocalStore(t *testing.T) *LocalStore {
+	store, err := NewLocalStore(t.TempDir(), "https://igm.example/", "test-key")
+	require.NoError(t, err)
+	store.now = func() time.Time { return time.Unix(1700000000, 0) }
+	return store
+}
+
+func fetch(store *LocalStore, rawURL string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	store.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, rawURL, nil))
+	return rec
+}
+
+func TestLocalStore_SignedURLRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store := newTestLocalStore(t)
+	require.NoError(t, store.Put(ctx, "user-1/photo.png", strings.NewReader("png-bytes"), 9, "image/png"))
+
+	signed, err := store.SignedURL(ctx, "user-1/photo.png", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(signed, "https://igm.example/attachments/user-1/photo.png?"))
+
+	rec := fetch(store, signed)
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "png-bytes", rec.Body.String())
+	assert.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+
+	tampered := strings.Replace(signed, "photo.png", "other.png", 1)
+	assert.Equal(t, http.StatusForbidden, fetch(store, tampered).Code)
+
+	u, _ := url.Parse(signed)
+	q := u.Query()
+	q.Set("expires", "1800000000")
+	u.RawQuery = q.Encode()
+	assert.Equal(t, http.StatusForbidden, fetch(store, u.String()).Code, "extended expiry breaks the signature")
+
+	store.now = func() time.Time { return time.Unix(1700000000, 0).Add(2 * time.Hour) }
+	assert.Equal(t, http.StatusForbidden, fetch(store, signed).Code, "expired")
+}
+
+func TestLocalStore_Put(t *testing.T) {
+	ctx := context.Background()
+	store := newTestLocalStore(t)
+
+	err := store.Put(ctx, "short.pdf", strings.NewReader("abc"), 4, "application/pdf")
+	assert.Error(t, err, "fewer bytes than declared")
+	err = store.Put(ctx, "long.pdf", strings.NewReader("abcde"), 4, "application/pdf")
+	assert.Error(t, err, "more bytes than declared")
+
+	signed, err := store.SignedURL(ctx, "short.pdf", time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, fetch(store, signed).Code, "failed puts leave nothing behind")
+
+	require.NoError(t, store.Put(ctx, "doc.pdf", strings.NewReader("v1"), 2, "application/pdf"))
+	require.NoError(t, store.Put(ctx, "doc.pdf", strings.NewReader("v2!"), 3, "application/pdf"))
+	signed, err = store.SignedURL(ctx, "doc.pdf", time.Hour)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(fetch(store, signed).Body)
+	assert.Equal(t, "v2!", string(body))
+
+	require.NoError(t, store.Delete(ctx, "doc.pdf"))
+	require.NoError(t, store.Delete(ctx, "doc.pdf"), "deleting a missing object is not an error")
+	assert.Equal(t, http.StatusNotFound, fetch(store, signed).Code)
+}
+
+func TestValidKey(t *testing.T) {
+	for _, key := range []string{"a.png", "user/a.png", "user/sub/a.pdf"} {
+		assert.True(t, validKey(key), key)
+	}
+	for _, key := range []string{"", ".", "..", "/a.png", "../a.png", "user/../../a.png", "user//a.png", "user/./a.png", `user\a.png`} {
+		assert.False(t, validKey(key), key)
+	}
+	_, err := newTestLocalStore(t).SignedURL(context.Background(), "../etc/passwd", time.Hour)
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
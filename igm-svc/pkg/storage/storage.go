@@ -0,0 +1,33 @@
+// Package storage keeps attachment bytes in an object store and hands out
+// signed, expiring URLs for them, which is what goes into ONDC payloads.
+// LocalStore writes to a directory and signs its own URLs; S3Store works
+// with any S3-compatible service (AWS, MinIO, R2, GCS interop).
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+var ErrInvalidKey = errors.New("invalid object key")
+
+type Store interface {
+	// Put stores size bytes from r under key, replacing any existing object.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	// SignedURL returns a URL anyone can GET key from until ttl has passed.
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+}
+
+// validKey rejects keys that are empty, absolute or escape the store root,
+// so the same keys are safe for the filesystem and for S3.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, `\`) {
+		return false
+	}
+	return path.Clean(key) == key && key != "." && key != ".." && !strings.HasPrefix(key, "../")
+}
@@ -0,0 +1,30 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"event_id":"e-1"}`)
+	sig := Sign("secret", now.Unix(), body)
+
+	require.NoError(t, Verify("secret", "1700000000", sig, body, 5*time.Minute, now))
+	assert.Error(t, Verify("other", "1700000000", sig, body, 5*time.Minute, now))
+	assert.Error(t, Verify("secret", "1700000000", sig, []byte(`{"event_id":"e-2"}`), 5*time.Minute, now))
+	assert.Error(t, Verify("secret", "1700000000", sig, body, 5*time.Minute, now.Add(10*time.Minute)))
+	assert.Error(t, Verify("secret", "1700000000", "md5=abc", body, 5*time.Minute, now))
+}
+
+func TestNewSecret(t *testing.T) {
+	a, err := NewSecret()
+	require.NoError(t, err)
+	b, err := NewSecret()
+	require.NoError(t, err)
+	assert.Len(t, a, 64)
+	assert.NotEqual(t, a, b)
+}
@@ -3,14 +3,59 @@
 set -e
 PROTO_DIR="api/proto/igm/v1"
 PROTO_FILE="$PROTO_DIR/issue.proto"
+V2_PROTO_FILE="api/proto/igm/v2/issue.proto"
+EVENTS_PROTO_FILE="api/proto/igm/events/v1/events.proto"
+OPENAPI_DIR="api/openapi"
 
 echo "generating prtobuf code for $PROTO_FILE"
 
 protoc \
+    -I . \
+    -I third_party/protovalidate \
+    -I third_party/googleapis \
+    -I third_party/grpc-gateway \
     --go_out=. \
     --go_opt=paths=source_relative \
     --go-grpc_out=. \
     --go-grpc_opt=paths=source_relative \
+    --grpc-gateway_out=. \
+    --grpc-gateway_opt=paths=source_relative \
     $PROTO_FILE
 
-echo "generated"
\ No newline at end of file
+echo "generating prtobuf code for $V2_PROTO_FILE"
+
+protoc \
+    -I . \
+    -I third_party/protovalidate \
+    -I third_party/googleapis \
+    -I third_party/grpc-gateway \
+    --go_out=. \
+    --go_opt=paths=source_relative \
+    --go-grpc_out=. \
+    --go-grpc_opt=paths=source_relative \
+    --grpc-gateway_out=. \
+    --grpc-gateway_opt=paths=source_relative \
+    $V2_PROTO_FILE
+
+echo "generating openapi document in $OPENAPI_DIR"
+
+protoc \
+    -I . \
+    -I third_party/protovalidate \
+    -I third_party/googleapis \
+    -I third_party/grpc-gateway \
+    --openapiv2_out=$OPENAPI_DIR \
+    --openapiv2_opt=allow_merge=true,merge_file_name=igm \
+    --openapiv2_opt=json_names_for_fields=false \
+    --openapiv2_opt=disable_default_errors=true \
+    --openapiv2_opt=include_package_in_tags=true \
+    $PROTO_FILE $V2_PROTO_FILE
+
+echo "generating prtobuf code for $EVENTS_PROTO_FILE"
+
+protoc \
+    --go_out=. \
+    --go_opt=paths=source_relative \
+    $EVENTS_PROTO_FILE
+
+echo "generated"